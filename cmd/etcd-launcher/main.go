@@ -17,6 +17,7 @@ limitations under the License.
 package main
 
 import (
+	"compress/gzip"
 	"context"
 	"errors"
 	"flag"
@@ -52,6 +53,9 @@ import (
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// gzipMagic are the first bytes of every gzip-compressed file.
+var gzipMagic = []byte{0x1f, 0x8b}
+
 const (
 	defaultClusterSize       = 3
 	defaultEtcdctlAPIVersion = "3"
@@ -80,6 +84,7 @@ type etcdCluster struct {
 	initialState          string
 	initialMembers        []string
 	usePeerTLSOnly        bool
+	statusAddress         string
 }
 
 func main() {
@@ -171,6 +176,14 @@ func main() {
 		log.Panicw("manager thread failed to connect to cluster", zap.Error(err))
 	}
 
+	if e.statusAddress != "" {
+		go func() {
+			if err := e.serveStatus(log); err != nil {
+				log.Warnw("status server failed", zap.Error(err))
+			}
+		}()
+	}
+
 	// reconcile dead members continuously. Initially we did this once as a step at the end of start up. We did that because scale up/down operations required a full restart of the ring with each node add/remove. However, this is no longer the case, so we need to separate the reconcile from the start up process and do it continuously.
 	go func() {
 		wait.Forever(func() {
@@ -432,6 +445,7 @@ func (e *etcdCluster) parseConfigFlags() error {
 	flag.StringVar(&e.etcdctlAPIVersion, "api-version", defaultEtcdctlAPIVersion, "etcdctl API version")
 	flag.StringVar(&e.token, "token", "", "etcd database token")
 	flag.BoolVar(&e.enableCorruptionCheck, "enable-corruption-check", false, "enable etcd experimental corruption check")
+	flag.StringVar(&e.statusAddress, "status-address", fmt.Sprintf("127.0.0.1:%d", resources.EtcdLauncherStatusPort), "address to serve the etcd member status on, empty to disable")
 	flag.Parse()
 
 	if e.namespace == "" {
@@ -723,6 +737,11 @@ func (e *etcdCluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, k8cC
 		return fmt.Errorf("failed to download backup (%s/%s): %w", bucketName, objectName, err)
 	}
 
+	snapshotFile, err := decompressSnapshot(downloadedSnapshotFile)
+	if err != nil {
+		return fmt.Errorf("failed to decompress backup (%s/%s): %w", bucketName, objectName, err)
+	}
+
 	if err := os.RemoveAll(e.dataDir); err != nil {
 		return fmt.Errorf("error deleting data directory before restore (%s): %w", e.dataDir, err)
 	}
@@ -730,7 +749,7 @@ func (e *etcdCluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, k8cC
 	sp := snapshot.NewV3(log.Desugar())
 
 	return sp.Restore(snapshot.RestoreConfig{
-		SnapshotPath:        downloadedSnapshotFile,
+		SnapshotPath:        snapshotFile,
 		Name:                e.podName,
 		OutputDataDir:       e.dataDir,
 		OutputWALDir:        filepath.Join(e.dataDir, "member", "wal"),
@@ -741,6 +760,54 @@ func (e *etcdCluster) restoreDatadirFromBackupIfNeeded(ctx context.Context, k8cC
 	})
 }
 
+// decompressSnapshot returns the path to the uncompressed snapshot. Backups can be
+// gzip-compressed; uncompressed backups, like the ones created by older versions,
+// are returned as-is.
+func decompressSnapshot(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(f, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return path, nil
+		}
+		return "", err
+	}
+
+	if header[0] != gzipMagic[0] || header[1] != gzipMagic[1] {
+		return path, nil
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+
+	decompressedPath := path + ".decompressed"
+	out, err := os.Create(decompressedPath)
+	if err != nil {
+		return "", err
+	}
+	defer out.Close()
+
+	// snapshots are created by ourselves, so there is no need to guard against decompression bombs
+	// nolint:gosec
+	if _, err := io.Copy(out, gz); err != nil {
+		return "", err
+	}
+
+	return decompressedPath, out.Close()
+}
+
 func closeClient(c io.Closer, log *zap.SugaredLogger) {
 	err := c.Close()
 	if err != nil {
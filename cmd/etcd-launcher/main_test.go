@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDecompressSnapshot(t *testing.T) {
+	snapshot := []byte("etcd snapshot contents")
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write(snapshot); err != nil {
+		t.Fatalf("Failed to compress snapshot: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("Failed to compress snapshot: %v", err)
+	}
+
+	tests := []struct {
+		name             string
+		content          []byte
+		expectedContent  []byte
+		expectedSamePath bool
+		wantErr          bool
+	}{
+		{
+			name:            "gzip-compressed snapshot is decompressed",
+			content:         compressed.Bytes(),
+			expectedContent: snapshot,
+		},
+		{
+			name:             "uncompressed snapshot is returned as-is",
+			content:          snapshot,
+			expectedContent:  snapshot,
+			expectedSamePath: true,
+		},
+		{
+			name:             "snapshot shorter than the gzip header is returned as-is",
+			content:          []byte{0x1f},
+			expectedContent:  []byte{0x1f},
+			expectedSamePath: true,
+		},
+		{
+			name:    "corrupt gzip-compressed snapshot is rejected",
+			content: append([]byte{gzipMagic[0], gzipMagic[1]}, []byte("not really gzip")...),
+			wantErr: true,
+		},
+		{
+			name:    "truncated gzip-compressed snapshot is rejected",
+			content: compressed.Bytes()[:compressed.Len()-8],
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "snapshot")
+			if err := os.WriteFile(path, test.content, 0600); err != nil {
+				t.Fatalf("Failed to write snapshot: %v", err)
+			}
+
+			result, err := decompressSnapshot(path)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", test.wantErr, err)
+			}
+			if test.wantErr {
+				return
+			}
+
+			if (result == path) != test.expectedSamePath {
+				t.Errorf("Expected the original path to be returned: %v, got %q", test.expectedSamePath, result)
+			}
+
+			content, err := os.ReadFile(result)
+			if err != nil {
+				t.Fatalf("Failed to read decompressed snapshot: %v", err)
+			}
+			if !bytes.Equal(content, test.expectedContent) {
+				t.Errorf("Expected snapshot content %q, got %q", test.expectedContent, content)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const timeoutMemberStatus = time.Second * 5
+
+// clusterStatus is the response of the launcher's status endpoint.
+type clusterStatus struct {
+	// Healthy is true if a leader exists and all members are healthy.
+	Healthy bool           `json:"healthy"`
+	Members []memberStatus `json:"members"`
+}
+
+// memberStatus is the status of a single etcd member, as seen by this launcher.
+type memberStatus struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Endpoint string `json:"endpoint,omitempty"`
+	Leader   bool   `json:"leader"`
+	DBSize   int64  `json:"dbSize"`
+	Healthy  bool   `json:"healthy"`
+	Error    string `json:"error,omitempty"`
+}
+
+// serveStatus serves the status of all etcd members on /status. It blocks until
+// the server fails.
+func (e *etcdCluster) serveStatus(log *zap.SugaredLogger) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		status, err := e.clusterStatus(r.Context(), log)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		code := http.StatusOK
+		if !status.Healthy {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		if err := json.NewEncoder(w).Encode(status); err != nil {
+			log.Warnw("failed to write status response", zap.Error(err))
+		}
+	})
+
+	server := &http.Server{
+		Addr:              e.statusAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return server.ListenAndServe()
+}
+
+func (e *etcdCluster) clusterStatus(ctx context.Context, log *zap.SugaredLogger) (*clusterStatus, error) {
+	members, err := e.listMembers(log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	client, err := e.getClusterClient()
+	if err != nil {
+		return nil, fmt.Errorf("can't find cluster client: %w", err)
+	}
+	defer closeClient(client, log)
+
+	status := &clusterStatus{
+		Healthy: true,
+		Members: []memberStatus{},
+	}
+	hasLeader := false
+
+	for _, member := range members {
+		ms := memberStatus{
+			ID:   fmt.Sprintf("%x", member.ID),
+			Name: member.Name,
+		}
+
+		if len(member.ClientURLs) == 0 {
+			// the member has been added, but not started yet
+			ms.Error = "member has not been started"
+		} else {
+			// we use the cluster FQDN endpoint url here. Using the IP endpoint will
+			// fail because the certificates don't include Pod IP addresses.
+			ms.Endpoint = member.ClientURLs[len(member.ClientURLs)-1]
+
+			memberCtx, cancel := context.WithTimeout(ctx, timeoutMemberStatus)
+			resp, err := client.Status(memberCtx, ms.Endpoint)
+			cancel()
+
+			switch {
+			case err != nil:
+				ms.Error = err.Error()
+			case resp.Leader == 0:
+				ms.Error = "member has no leader"
+			default:
+				ms.Healthy = true
+				ms.Leader = resp.Header.MemberId == resp.Leader
+				ms.DBSize = resp.DbSize
+			}
+		}
+
+		if ms.Leader {
+			hasLeader = true
+		}
+		if !ms.Healthy {
+			status.Healthy = false
+		}
+
+		status.Members = append(status.Members, ms)
+	}
+
+	if !hasLeader {
+		status.Healthy = false
+	}
+
+	return status, nil
+}
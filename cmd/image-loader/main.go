@@ -291,7 +291,7 @@ func getImagesFromCreators(log *zap.SugaredLogger, templateData *resources.Templ
 		kubermaticVersions,
 		templateData.ImageRegistry,
 	))
-	daemonsetCreators = append(daemonsetCreators, nodelocaldns.DaemonSetCreator(templateData.ImageRegistry))
+	daemonsetCreators = append(daemonsetCreators, nodelocaldns.DaemonSetCreator(resources.NodeLocalDNSCacheAddress, templateData.ImageRegistry))
 
 	for _, creatorGetter := range statefulsetCreators {
 		_, creator := creatorGetter()
@@ -251,7 +251,7 @@ func reconcileCluster(ctx context.Context, config *rest.Config, namespace string
 	log.Info("Reconciling ClusterRoleBinding...")
 	if err := reconciling.ReconcileClusterRoleBindings(ctx, []reconciling.NamedClusterRoleBindingCreatorGetter{
 		clusterRoleCreatorGetterFactory(namespace),
-	}, "", client); err != nil {
+	}, client); err != nil {
 		return "", fmt.Errorf("failed to create ClusterRoleBinding: %w", err)
 	}
 
@@ -35,6 +35,7 @@ import (
 	applicationdefinitionvalidation "k8c.io/kubermatic/v2/pkg/webhook/application/applicationdefinition/validation"
 	clustermutation "k8c.io/kubermatic/v2/pkg/webhook/cluster/mutation"
 	clustervalidation "k8c.io/kubermatic/v2/pkg/webhook/cluster/validation"
+	etcdbackupconfigvalidation "k8c.io/kubermatic/v2/pkg/webhook/etcdbackupconfig/validation"
 	kubermaticconfigurationvalidation "k8c.io/kubermatic/v2/pkg/webhook/kubermaticconfiguration/validation"
 	mlaadminsettingmutation "k8c.io/kubermatic/v2/pkg/webhook/mlaadminsetting/mutation"
 	oscvalidation "k8c.io/kubermatic/v2/pkg/webhook/operatingsystemmanager/operatingsystemconfig/validation"
@@ -156,6 +157,14 @@ func main() {
 
 	addonmutation.NewAdmissionHandler(seedGetter, seedClientGetter).SetupWebhookWithManager(mgr)
 
+	// /////////////////////////////////////////
+	// setup EtcdBackupConfig webhook
+
+	etcdBackupConfigValidator := etcdbackupconfigvalidation.NewValidator(seedGetter)
+	if err := builder.WebhookManagedBy(mgr).For(&kubermaticv1.EtcdBackupConfig{}).WithValidator(etcdBackupConfigValidator).Complete(); err != nil {
+		log.Fatalw("Failed to setup EtcdBackupConfig validation webhook", zap.Error(err))
+	}
+
 	// /////////////////////////////////////////
 	// setup MLAAdminSetting webhooks
 
@@ -17,51 +17,73 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
+	"google.golang.org/api/option"
+	"google.golang.org/api/storage/v1"
 
 	"k8c.io/kubermatic/v2/pkg/collectors"
 	"k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	"k8c.io/kubermatic/v2/pkg/util/flagopts"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/clientcmd"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// readinessTimeout is the time the readiness check waits for the object store to respond.
+	readinessTimeout = 5 * time.Second
+
+	// secretAccessKeyIDKey and secretSecretAccessKeyKey are the keys in the
+	// credentials Secret that hold the S3 access keys.
+	secretAccessKeyIDKey     = "accessKeyId"
+	secretSecretAccessKeyKey = "secretAccessKey"
+)
+
 func main() {
 	logOpts := log.NewDefaultOptions()
 	logOpts.AddFlags(flag.CommandLine)
 
+	backend := flag.String("backend", "s3", "The object store backend to use, one of [s3, gcs]")
 	endpointWithProto := flag.String("endpoint", "", "The s3 endpoint, e.G. https://my-s3.com:9000")
 	accessKeyID := flag.String("access-key-id", "", "S3 Access key, defaults to the ACCESS_KEY_ID environment variable")
 	secretAccessKey := flag.String("secret-access-key", "", "S3 Secret Access Key, defaults to the SECRET_ACCESS_KEY evnironment variable")
-	bucket := flag.String("bucket", "kubermatic-etcd-backups", "The bucket to monitor")
+	credentialsSecret := flag.String("credentials-secret", "", "Secret (namespace/name) to read the S3 access keys from (keys accessKeyId and secretAccessKey), takes precedence over -access-key-id and -secret-access-key")
+	gcsCredentialsFile := flag.String("gcs-credentials-file", "", "Path to a GCS service account JSON file, defaults to the GOOGLE_APPLICATION_CREDENTIALS environment variable")
+	buckets := flagopts.StringArray{"kubermatic-etcd-backups"}
+	flag.Var(&buckets, "bucket", "Comma-separated list of buckets to monitor")
 	kubeconfig := flag.String("kubeconfig", "", "Path to a kubeconfig. Only required if out-of-cluster.")
 	listenAddress := flag.String("address", ":9340", "The port to listen on")
 	caBundleFile := flag.String("ca-bundle", "", "Filename of the CA bundle to use (if not given, default system certificates are used)")
+	clientCertFile := flag.String("client-cert", "", "Filename of the client certificate to authenticate against the S3 endpoint (requires -client-key)")
+	clientKeyFile := flag.String("client-key", "", "Filename of the private key for the client certificate (requires -client-cert)")
 	flag.Parse()
 
 	// setup logging
 	rawLog := log.New(logOpts.Debug, logOpts.Format)
 	logger := rawLog.Sugar()
 
-	if *accessKeyID == "" {
-		*accessKeyID = os.Getenv("ACCESS_KEY_ID")
-	}
-	if *secretAccessKey == "" {
-		*secretAccessKey = os.Getenv("SECRET_ACCESS_KEY")
+	if len(buckets) == 0 {
+		logger.Fatal("At least one 'bucket' must be set!")
 	}
 
-	if *endpointWithProto == "" || *accessKeyID == "" || *secretAccessKey == "" {
-		logger.Fatal("All of 'endpoint', 'access-key-id' and 'secret-access-key' must be set!")
+	if (*clientCertFile == "") != (*clientKeyFile == "") {
+		logger.Fatal("Both 'client-cert' and 'client-key' must be set to use client certificate authentication!")
 	}
 
 	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
@@ -74,32 +96,150 @@ func main() {
 		logger.Fatalw("Failed to create kube client", zap.Error(err))
 	}
 
+	var objectStore collectors.ObjectStore
+	switch *backend {
+	case "s3":
+		if *credentialsSecret != "" {
+			*accessKeyID, *secretAccessKey, err = loadS3CredentialsFromSecret(context.Background(), client, *credentialsSecret)
+			if err != nil {
+				logger.Fatalw("Failed to load S3 credentials", zap.Error(err))
+			}
+		}
+		objectStore = newS3ObjectStore(logger, *endpointWithProto, *accessKeyID, *secretAccessKey, *caBundleFile, *clientCertFile, *clientKeyFile)
+	case "gcs":
+		objectStore = newGCSObjectStore(logger, *gcsCredentialsFile)
+	default:
+		logger.Fatalf("Invalid backend %q, must be one of [s3, gcs]", *backend)
+	}
+
+	stopChannel := make(chan struct{})
+	bucketList := sets.NewString(buckets...).List()
+	for _, bucket := range bucketList {
+		collectors.MustRegisterS3Collector(objectStore, client, bucket, logger)
+	}
+
+	// "/" is kept serving metrics for compatibility with existing scrape configs
+	http.Handle("/", promhttp.Handler())
+	http.Handle("/metrics", promhttp.Handler())
+	http.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	http.HandleFunc("/readyz", readinessHandler(logger, objectStore, bucketList))
+	go func() {
+		if err := http.ListenAndServe(*listenAddress, nil); err != nil {
+			logger.Fatalw("Failed to listen", zap.Error(err))
+		}
+	}()
+
+	logger.Infof("Successfully started, listening on %s", *listenAddress)
+	<-stopChannel
+	logger.Info("Shutting down")
+}
+
+// readinessHandler reports the exporter as ready only if all monitored buckets can be reached.
+func readinessHandler(logger *zap.SugaredLogger, objectStore collectors.ObjectStore, buckets []string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+		defer cancel()
+
+		for _, bucket := range buckets {
+			exists, err := objectStore.BucketExists(ctx, bucket)
+			if err != nil {
+				logger.Warnw("Readiness check failed", "bucket", bucket, zap.Error(err))
+				http.Error(w, fmt.Sprintf("failed to check bucket %q: %v", bucket, err), http.StatusServiceUnavailable)
+				return
+			}
+			if !exists {
+				http.Error(w, fmt.Sprintf("bucket %q does not exist", bucket), http.StatusServiceUnavailable)
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// loadS3CredentialsFromSecret reads the S3 access keys from the Secret referenced
+// as "namespace/name".
+func loadS3CredentialsFromSecret(ctx context.Context, client ctrlruntimeclient.Client, secretRef string) (string, string, error) {
+	namespace, name, found := strings.Cut(secretRef, "/")
+	if !found || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf("invalid Secret reference %q, must be of the form namespace/name", secretRef)
+	}
+
+	secret := &corev1.Secret{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		return "", "", fmt.Errorf("failed to get Secret %s: %w", secretRef, err)
+	}
+
+	accessKeyID := string(secret.Data[secretAccessKeyIDKey])
+	if accessKeyID == "" {
+		return "", "", fmt.Errorf("Secret %s has no %q key", secretRef, secretAccessKeyIDKey)
+	}
+
+	secretAccessKey := string(secret.Data[secretSecretAccessKeyKey])
+	if secretAccessKey == "" {
+		return "", "", fmt.Errorf("Secret %s has no %q key", secretRef, secretSecretAccessKeyKey)
+	}
+
+	return accessKeyID, secretAccessKey, nil
+}
+
+func newS3ObjectStore(logger *zap.SugaredLogger, endpointWithProto, accessKeyID, secretAccessKey, caBundleFile, clientCertFile, clientKeyFile string) collectors.ObjectStore {
+	if accessKeyID == "" {
+		accessKeyID = os.Getenv("ACCESS_KEY_ID")
+	}
+	if secretAccessKey == "" {
+		secretAccessKey = os.Getenv("SECRET_ACCESS_KEY")
+	}
+
+	if endpointWithProto == "" || accessKeyID == "" || secretAccessKey == "" {
+		logger.Fatal("All of 'endpoint', 'access-key-id' and 'secret-access-key' must be set!")
+	}
+
 	secure := true
-	if strings.HasPrefix(*endpointWithProto, "http://") {
+	if strings.HasPrefix(endpointWithProto, "http://") {
 		logger.Info("Disabling TLS due to http:// prefix in endpoint")
 		secure = false
 	}
-	endpoint := strings.TrimPrefix(*endpointWithProto, "http://")
+	endpoint := strings.TrimPrefix(endpointWithProto, "http://")
 	endpoint = strings.TrimPrefix(endpoint, "https://")
 
 	options := &minio.Options{
-		Creds:  credentials.NewStaticV4(*accessKeyID, *secretAccessKey, ""),
+		Creds:  credentials.NewStaticV4(accessKeyID, secretAccessKey, ""),
 		Secure: secure,
 	}
 
-	if *caBundleFile != "" {
-		bundle, err := certificates.NewCABundleFromFile(*caBundleFile)
+	var tlsConfig *tls.Config
+
+	if caBundleFile != "" {
+		bundle, err := certificates.NewCABundleFromFile(caBundleFile)
 		if err != nil {
 			logger.Fatalw("Failed to load CA bundle", zap.Error(err))
 		}
 
+		tlsConfig = &tls.Config{RootCAs: bundle.CertPool()}
+	}
+
+	if clientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+		if err != nil {
+			logger.Fatalw("Failed to load client certificate", zap.Error(err))
+		}
+
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if tlsConfig != nil {
 		options.Transport = &http.Transport{
-			TLSClientConfig:    &tls.Config{RootCAs: bundle.CertPool()},
+			TLSClientConfig:    tlsConfig,
 			DisableCompression: true,
 		}
 	}
 
-	stopChannel := make(chan struct{})
 	minioClient, err := minio.New(endpoint, options)
 	if err != nil {
 		logger.Fatalw("Failed to get S3 client", zap.Error(err))
@@ -107,16 +247,22 @@ func main() {
 
 	minioClient.SetAppInfo("kubermatic-exporter", "v0.2")
 
-	collectors.MustRegisterS3Collector(minioClient, client, *bucket, logger)
+	return collectors.NewMinioObjectStore(minioClient)
+}
 
-	http.Handle("/", promhttp.Handler())
-	go func() {
-		if err := http.ListenAndServe(*listenAddress, nil); err != nil {
-			logger.Fatalw("Failed to listen", zap.Error(err))
-		}
-	}()
+func newGCSObjectStore(logger *zap.SugaredLogger, credentialsFile string) collectors.ObjectStore {
+	if credentialsFile == "" {
+		credentialsFile = os.Getenv("GOOGLE_APPLICATION_CREDENTIALS")
+	}
 
-	logger.Infof("Successfully started, listening on %s", *listenAddress)
-	<-stopChannel
-	logger.Info("Shutting down")
+	if credentialsFile == "" {
+		logger.Fatal("'gcs-credentials-file' must be set!")
+	}
+
+	service, err := storage.NewService(context.Background(), option.WithCredentialsFile(credentialsFile), option.WithScopes(storage.DevstorageReadOnlyScope))
+	if err != nil {
+		logger.Fatalw("Failed to get GCS client", zap.Error(err))
+	}
+
+	return collectors.NewGCSObjectStore(service)
 }
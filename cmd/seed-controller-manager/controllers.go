@@ -44,6 +44,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/pvwatcher"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/seedresourcesuptodatecondition"
 	updatecontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/update-controller"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/features"
 )
 
@@ -120,6 +121,8 @@ func createKubernetesController(ctrlCtx *controllerContext) error {
 		return fmt.Errorf("failed to parse %s as duration: %w", ctrlCtx.runOptions.backupInterval, err)
 	}
 
+	kubernetescontroller.MustRegisterMetrics(prometheus.DefaultRegisterer)
+
 	return kubernetescontroller.Add(
 		ctrlCtx.mgr,
 		ctrlCtx.log,
@@ -135,7 +138,11 @@ func createKubernetesController(ctrlCtx *controllerContext) error {
 		userClusterMLAEnabled(ctrlCtx),
 		ctrlCtx.dockerPullConfigJSON,
 		ctrlCtx.runOptions.concurrentClusterUpdate,
+		controllerutil.ConcurrencyPartition(ctrlCtx.runOptions.concurrencyPartition),
+		ctrlCtx.runOptions.clusterErrorGracePeriod,
 		backupInterval,
+		ctrlCtx.runOptions.lbCleanupTimeout,
+		ctrlCtx.runOptions.forceLBCleanup,
 		ctrlCtx.runOptions.oidcIssuerURL,
 		ctrlCtx.runOptions.oidcIssuerClientID,
 		ctrlCtx.runOptions.kubermaticImage,
@@ -221,6 +228,7 @@ func createMonitoringController(ctrlCtx *controllerContext) error {
 		ctrlCtx.runOptions.nodeAccessNetwork,
 		ctrlCtx.dockerPullConfigJSON,
 		ctrlCtx.runOptions.concurrentClusterUpdate,
+		controllerutil.ConcurrencyPartition(ctrlCtx.runOptions.concurrencyPartition),
 		monitoring.Features{
 			VPA:          ctrlCtx.runOptions.featureGates.Enabled(features.VerticalPodAutoscaler),
 			Konnectivity: ctrlCtx.runOptions.featureGates.Enabled(features.KonnectivityService),
@@ -262,6 +270,8 @@ func createClusterPhaseController(ctrlCtx *controllerContext) error {
 }
 
 func createAddonController(ctrlCtx *controllerContext) error {
+	addon.MustRegisterMetrics(prometheus.DefaultRegisterer)
+
 	return addon.Add(
 		ctrlCtx.mgr,
 		ctrlCtx.log,
@@ -275,7 +285,9 @@ func createAddonController(ctrlCtx *controllerContext) error {
 		},
 		ctrlCtx.runOptions.addonsPath,
 		ctrlCtx.runOptions.overwriteRegistry,
+		ctrlCtx.runOptions.addonServerSideApply,
 		ctrlCtx.clientProvider,
+		ctrlCtx.runOptions.caBundle,
 		ctrlCtx.versions,
 	)
 }
@@ -356,6 +368,7 @@ func createMLAController(ctrlCtx *controllerContext) error {
 		ctrlCtx.runOptions.cortexAlertmanagerURL,
 		ctrlCtx.runOptions.cortexRulerURL,
 		ctrlCtx.runOptions.lokiRulerURL,
+		ctrlCtx.runOptions.grafanaOwnerRole,
 		ctrlCtx.runOptions.enableUserClusterMLA,
 	)
 }
@@ -25,6 +25,7 @@ import (
 	"os"
 	"path"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -32,6 +33,8 @@ import (
 	"k8c.io/kubermatic/v2/pkg/cluster/client"
 	"k8c.io/kubermatic/v2/pkg/controller/operator/defaults"
 	backupcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/backup"
+	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/mla"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
@@ -64,7 +67,12 @@ type controllerRunOptions struct {
 	dnatControllerImage      string
 	namespace                string
 	concurrentClusterUpdate  int
+	concurrencyPartition     string
+	clusterErrorGracePeriod  time.Duration
+	lbCleanupTimeout         time.Duration
+	forceLBCleanup           bool
 	addonEnforceInterval     int
+	addonServerSideApply     bool
 	caBundle                 *certificates.CABundle
 
 	// for development purposes, a local configuration file
@@ -90,6 +98,7 @@ type controllerRunOptions struct {
 	cortexAlertmanagerURL string
 	cortexRulerURL        string
 	lokiRulerURL          string
+	grafanaOwnerRole      string
 
 	// Machine Controller configuration
 	machineControllerImageTag        string
@@ -132,7 +141,12 @@ func newControllerRunOptions() (controllerRunOptions, error) {
 	flag.StringVar(&c.dnatControllerImage, "dnatcontroller-image", defaults.DefaultDNATControllerImage, "The location of the dnatcontroller-image")
 	flag.StringVar(&c.namespace, "namespace", "kubermatic", "The namespace kubermatic runs in, uses to determine where to look for Seed resources")
 	flag.IntVar(&c.concurrentClusterUpdate, "max-parallel-reconcile", 10, "The default number of resources updates per cluster")
+	flag.StringVar(&c.concurrencyPartition, "max-parallel-reconcile-partition", "", "Share the max-parallel-reconcile limit fairly among partitions of clusters, either \"project\" or \"worker-name\". By default the limit applies globally to all clusters.")
+	flag.DurationVar(&c.clusterErrorGracePeriod, "cluster-error-grace-period", 2*time.Minute, "Duration a cluster must fail to reconcile before an error is set on the cluster status. Set to 0 to report errors immediately.")
+	flag.DurationVar(&c.lbCleanupTimeout, "lb-cleanup-timeout", 0, "Duration after which a warning is recorded if the LoadBalancers of a deleted cluster have not been cleaned up. Set to 0 to wait indefinitely.")
+	flag.BoolVar(&c.forceLBCleanup, "force-lb-cleanup-after-timeout", false, "Continue deleting a cluster if its LoadBalancers have not been cleaned up within --lb-cleanup-timeout. This can leak LoadBalancers at the cloud provider.")
 	flag.IntVar(&c.addonEnforceInterval, "addon-enforce-interval", 5, "Check and ensure default usercluster addons are deployed every interval in minutes. Set to 0 to disable.")
+	flag.BoolVar(&c.addonServerSideApply, "addon-server-side-apply", false, "Apply addon manifests using server-side apply via the usercluster client instead of running kubectl. Addons relying on kubectl-specific behaviour require this to be disabled.")
 	flag.StringVar(&caBundleFile, "ca-bundle", "", "File containing the PEM-encoded CA bundle for all userclusters")
 	flag.Var(&c.tunnelingAgentIP, "tunneling-agent-ip", "The address used by the tunneling agents.")
 	flag.BoolVar(&c.enableUserClusterMLA, "enable-user-cluster-mla", false, "Enables user cluster MLA (Monitoring, Logging & Alerting) stack in the seed.")
@@ -143,6 +157,7 @@ func newControllerRunOptions() (controllerRunOptions, error) {
 	flag.StringVar(&c.cortexAlertmanagerURL, "cortex-alertmanager-url", "http://cortex-alertmanager.mla.svc.cluster.local:8080", "The URL of cortex alertmanager which is running for MLA stack.")
 	flag.StringVar(&c.cortexRulerURL, "cortex-ruler-url", "http://cortex-ruler.mla.svc.cluster.local:8080", "The URL of cortex ruler which is running for MLA stack.")
 	flag.StringVar(&c.lokiRulerURL, "loki-ruler-url", "http://loki-distributed-ruler.mla.svc.cluster.local:3100", "The URL of loki ruler which is running for MLA stack.")
+	flag.StringVar(&c.grafanaOwnerRole, "grafana-owner-role", string(mla.DefaultGrafanaOwnerRole), "The Grafana org role (Admin, Editor or Viewer) assigned to project owners. Project editors and viewers always get the Editor and Viewer roles.")
 	flag.StringVar(&c.machineControllerImageTag, "machine-controller-image-tag", "", "The Machine Controller image tag.")
 	flag.StringVar(&c.machineControllerImageRepository, "machine-controller-image-repository", "", "The Machine Controller image repository.")
 	flag.StringVar(&configFile, "kubermatic-configuration-file", "", "(for development only) path to a KubermaticConfiguration YAML file")
@@ -201,6 +216,14 @@ func (o controllerRunOptions) validate() error {
 		return fmt.Errorf("seed-name is undefined")
 	}
 
+	if err := mla.ValidateGrafanaOwnerRole(o.grafanaOwnerRole); err != nil {
+		return fmt.Errorf("invalid grafana-owner-role: %w", err)
+	}
+
+	if err := controllerutil.ValidateConcurrencyPartition(controllerutil.ConcurrencyPartition(o.concurrencyPartition)); err != nil {
+		return fmt.Errorf("invalid max-parallel-reconcile-partition: %w", err)
+	}
+
 	return nil
 }
 
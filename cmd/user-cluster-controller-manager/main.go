@@ -27,6 +27,7 @@ import (
 	"go.uber.org/zap"
 
 	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+	gatekeeperconfigv1alpha1 "github.com/open-policy-agent/gatekeeper/apis/config/v1alpha1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/applications"
 	userclustercontrollermanager "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager"
@@ -84,6 +85,7 @@ type controllerRunOptions struct {
 	updateWindowLength           string
 	dnsClusterIP                 string
 	nodeLocalDNSCache            bool
+	nodeLocalDNSCacheAddress     string
 	opaIntegration               bool
 	opaEnableMutation            bool
 	opaWebhookTimeout            int
@@ -120,6 +122,7 @@ func main() {
 	flag.StringVar(&runOp.clusterName, "cluster-name", "", "Cluster name")
 	flag.StringVar(&runOp.dnsClusterIP, "dns-cluster-ip", "", "KubeDNS service IP for the cluster")
 	flag.BoolVar(&runOp.nodeLocalDNSCache, "node-local-dns-cache", false, "Enable NodeLocal DNS Cache in user cluster")
+	flag.StringVar(&runOp.nodeLocalDNSCacheAddress, "node-local-dns-cache-address", resources.NodeLocalDNSCacheAddress, "Link-local address the NodeLocal DNS Cache listens on")
 	flag.IntVar(&runOp.openvpnServerPort, "openvpn-server-port", 0, "OpenVPN server port")
 	flag.IntVar(&runOp.kasSecurePort, "kas-secure-port", 6443, "Secure KAS port")
 	flag.Var(&runOp.tunnelingAgentIP, "tunneling-agent-ip", "If specified the tunneling agent will bind to this IP address, otherwise it will not be deployed.")
@@ -256,6 +259,9 @@ func main() {
 	if err := clusterv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
 		log.Fatalw("Failed to register scheme", zap.Stringer("api", clusterv1alpha1.SchemeGroupVersion), zap.Error(err))
 	}
+	if err := gatekeeperconfigv1alpha1.AddToScheme(mgr.GetScheme()); err != nil {
+		log.Fatalw("Failed to register scheme", zap.Stringer("api", gatekeeperconfigv1alpha1.GroupVersion), zap.Error(err))
+	}
 
 	isPausedChecker := userclustercontrollermanager.NewClusterPausedChecker(seedMgr.GetClient(), runOp.clusterName)
 
@@ -275,6 +281,7 @@ func main() {
 		mgr.AddReadyzCheck,
 		runOp.dnsClusterIP,
 		runOp.nodeLocalDNSCache,
+		runOp.nodeLocalDNSCacheAddress,
 		runOp.opaIntegration,
 		runOp.opaEnableMutation,
 		versions,
@@ -309,7 +316,7 @@ func main() {
 		creators := []reconciling.NamedCustomResourceDefinitionCreatorGetter{
 			machinecontrolerresources.MachineCRDCreator(),
 		}
-		if err := reconciling.ReconcileCustomResourceDefinitions(rootCtx, creators, "", mgr.GetClient()); err != nil {
+		if err := reconciling.ReconcileCustomResourceDefinitions(rootCtx, creators, mgr.GetClient()); err != nil {
 			// The mgr.Client is uninitianlized here and hence always returns a 404, regardless of the object existing or not
 			if !strings.Contains(err.Error(), `customresourcedefinitions.apiextensions.k8s.io "machines.cluster.k8s.io" already exists`) {
 				log.Fatalw("Failed to initially create the Machine CR", zap.Error(err))
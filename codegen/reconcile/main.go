@@ -39,16 +39,19 @@ func main() {
 				ResourceName:       "Namespace",
 				ImportAlias:        "corev1",
 				ResourceImportPath: "k8s.io/api/core/v1",
+				ClusterScoped:      true,
 			},
 			{
 				ResourceName:       "Service",
 				ImportAlias:        "corev1",
 				ResourceImportPath: "k8s.io/api/core/v1",
+				EqualityFunc:       "ServiceEqual",
 			},
 			{
 				ResourceName: "Secret",
 				ImportAlias:  "corev1",
 				// Don't specify ResourceImportPath so this block does not create a new import line in the generated code
+				GenerateDelete: true,
 			},
 			{
 				ResourceName: "ConfigMap",
@@ -104,11 +107,13 @@ func main() {
 				ResourceName:       "ClusterRoleBinding",
 				ImportAlias:        "rbacv1",
 				ResourceImportPath: "k8s.io/api/rbac/v1",
+				ClusterScoped:      true,
 			},
 			{
 				ResourceName: "ClusterRole",
 				ImportAlias:  "rbacv1",
 				// Don't specify ResourceImportPath so this block does not create a new import line in the generated code
+				ClusterScoped: true,
 			},
 			{
 				ResourceName: "Role",
@@ -124,6 +129,7 @@ func main() {
 				ResourceName:       "CustomResourceDefinition",
 				ImportAlias:        "apiextensionsv1",
 				ResourceImportPath: "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1",
+				ClusterScoped:      true,
 			},
 			{
 				ResourceName:       "CronJob",
@@ -135,16 +141,20 @@ func main() {
 				ResourceName:       "MutatingWebhookConfiguration",
 				ImportAlias:        "admissionregistrationv1",
 				ResourceImportPath: "k8s.io/api/admissionregistration/v1",
+				ClusterScoped:      true,
+				GenerateDelete:     true,
 			},
 			{
 				ResourceName: "ValidatingWebhookConfiguration",
 				ImportAlias:  "admissionregistrationv1",
 				// Don't specify ResourceImportPath so this block does not create a new import line in the generated code
+				ClusterScoped: true,
 			},
 			{
 				ResourceName:       "APIService",
 				ImportAlias:        "apiregistrationv1",
 				ResourceImportPath: "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1",
+				ClusterScoped:      true,
 			},
 			{
 				ResourceName:       "Ingress",
@@ -171,21 +181,31 @@ func main() {
 				ResourceName:       "ConstraintTemplate",
 				ImportAlias:        "gatekeeperv1",
 				ResourceImportPath: "github.com/open-policy-agent/frameworks/constraint/pkg/apis/templates/v1",
+				ClusterScoped:      true,
 			},
 			{
 				ResourceName:     "ConstraintTemplate",
 				ImportAlias:      "kubermaticv1",
 				APIVersionPrefix: "KubermaticV1",
+				ClusterScoped:    true,
+			},
+			{
+				ResourceName:       "Config",
+				ImportAlias:        "gatekeeperconfigv1alpha1",
+				ResourceImportPath: "github.com/open-policy-agent/gatekeeper/apis/config/v1alpha1",
+				APIVersionPrefix:   "GatekeeperV1alpha1",
 			},
 			{
 				ResourceName:     "Project",
 				ImportAlias:      "kubermaticv1",
 				APIVersionPrefix: "KubermaticV1",
+				ClusterScoped:    true,
 			},
 			{
 				ResourceName:     "UserProjectBinding",
 				ImportAlias:      "kubermaticv1",
 				APIVersionPrefix: "KubermaticV1",
+				ClusterScoped:    true,
 			},
 			{
 				ResourceName:     "Constraint",
@@ -196,11 +216,14 @@ func main() {
 				ResourceName:     "User",
 				ImportAlias:      "kubermaticv1",
 				APIVersionPrefix: "KubermaticV1",
+				ClusterScoped:    true,
 			},
 			{
 				ResourceName:     "ClusterTemplate",
 				ImportAlias:      "kubermaticv1",
 				APIVersionPrefix: "KubermaticV1",
+				ClusterScoped:    true,
+				GenerateDelete:   true,
 			},
 			{
 				ResourceName:       "NetworkPolicy",
@@ -218,6 +241,7 @@ func main() {
 				ImportAlias:        "appskubermaticv1",
 				ResourceImportPath: "k8c.io/kubermatic/v2/pkg/apis/apps.kubermatic/v1",
 				APIVersionPrefix:   "AppsKubermaticV1",
+				ClusterScoped:      true,
 			},
 			{
 				ResourceName:       "VirtualMachineInstancePreset",
@@ -229,6 +253,7 @@ func main() {
 				ResourceName:     "Preset",
 				ImportAlias:      "kubermaticv1",
 				APIVersionPrefix: "KubermaticV1",
+				ClusterScoped:    true,
 			},
 			{
 				ResourceName:       "DataVolume",
@@ -266,10 +291,9 @@ var (
 package reconciling
 
 import (
-	"fmt"
 	"context"
 
-	"k8s.io/apimachinery/pkg/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 {{ range .Resources }}
 {{- if .ResourceImportPath }}
@@ -279,7 +303,7 @@ import (
 )
 
 {{ range .Resources }}
-{{ namedReconcileFunc .ResourceName .ImportAlias .DefaultingFunc .RequiresRecreate .ResourceNamePlural .APIVersionPrefix}}
+{{ namedReconcileFunc .ResourceName .ImportAlias .DefaultingFunc .EqualityFunc .RequiresRecreate .ResourceNamePlural .APIVersionPrefix .ClusterScoped .GenerateDelete }}
 {{- end }}
 
 `))
@@ -293,15 +317,25 @@ type reconcileFunctionData struct {
 	// Optional: A defaulting func for the given object type
 	// Must be defined inside the resources package
 	DefaultingFunc string
+	// Optional: An equality func for the given object type, used instead of DeepEqual
+	// to decide whether an update is required, e.g. to ignore server-populated fields.
+	// Must be defined inside the resources package
+	EqualityFunc string
 	// Whether the resource must be recreated instead of updated. Required
 	// e.G. for PDBs
 	RequiresRecreate bool
 	// Optional: adds an api version prefix to the generated functions to avoid duplication when different resources
 	// have the same ResourceName
 	APIVersionPrefix string
+	// Whether the resource is cluster-scoped. The generated reconcile function then does
+	// not take a namespace and never sets one on the objects.
+	ClusterScoped bool
+	// Whether to additionally generate a Delete function, which deletes objects by name
+	// and ignores objects that do not exist.
+	GenerateDelete bool
 }
 
-func namedReconcileFunc(resourceName, importAlias, defaultingFunc string, requiresRecreate bool, plural, apiVersionPrefix string) (string, error) {
+func namedReconcileFunc(resourceName, importAlias, defaultingFunc, equalityFunc string, requiresRecreate bool, plural, apiVersionPrefix string, clusterScoped, generateDelete bool) (string, error) {
 	if len(plural) == 0 {
 		plural = fmt.Sprintf("%ss", resourceName)
 	}
@@ -312,15 +346,21 @@ func namedReconcileFunc(resourceName, importAlias, defaultingFunc string, requir
 		ResourceNamePlural string
 		ImportAlias        string
 		DefaultingFunc     string
+		EqualityFunc       string
 		RequiresRecreate   bool
 		APIVersionPrefix   string
+		ClusterScoped      bool
+		GenerateDelete     bool
 	}{
 		ResourceName:       resourceName,
 		ResourceNamePlural: plural,
 		ImportAlias:        importAlias,
 		DefaultingFunc:     defaultingFunc,
+		EqualityFunc:       equalityFunc,
 		RequiresRecreate:   requiresRecreate,
 		APIVersionPrefix:   apiVersionPrefix,
+		ClusterScoped:      clusterScoped,
+		GenerateDelete:     generateDelete,
 	})
 
 	if err != nil {
@@ -354,26 +394,27 @@ func {{ .APIVersionPrefix }}{{ .ResourceName }}ObjectWrapper(create {{ .APIVersi
 }
 
 // Reconcile{{ .APIVersionPrefix }}{{ .ResourceNamePlural }} will create and update the {{ .APIVersionPrefix }}{{ .ResourceNamePlural }} coming from the passed {{ .APIVersionPrefix }}{{ .ResourceName }}Creator slice
+{{- if .ClusterScoped }}
+func Reconcile{{ .APIVersionPrefix }}{{ .ResourceNamePlural }}(ctx context.Context, namedGetters []Named{{ .APIVersionPrefix }}{{ .ResourceName }}CreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &{{ .ImportAlias }}.{{ .ResourceName }}{}, {{ .RequiresRecreate }}, {{ with .DefaultingFunc }}{{ . }}{{ else }}nil{{ end }}, {{ with .EqualityFunc }}{{ . }}{{ else }}nil{{ end }}, objectModifiers...)
+}
+{{- else }}
 func Reconcile{{ .APIVersionPrefix }}{{ .ResourceNamePlural }}(ctx context.Context, namedGetters []Named{{ .APIVersionPrefix }}{{ .ResourceName }}CreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-{{- if .DefaultingFunc }}
-		create = {{ .DefaultingFunc }}(create)
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &{{ .ImportAlias }}.{{ .ResourceName }}{}, {{ .RequiresRecreate }}, {{ with .DefaultingFunc }}{{ . }}{{ else }}nil{{ end }}, {{ with .EqualityFunc }}{{ . }}{{ else }}nil{{ end }}, objectModifiers...)
+}
 {{- end }}
-		createObject := {{ .APIVersionPrefix }}{{ .ResourceName }}ObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &{{ .ImportAlias }}.{{ .ResourceName }}{}, {{ .RequiresRecreate}}); err != nil {
-			return fmt.Errorf("failed to ensure {{ .ResourceName }} %s/%s: %w", namespace, name, err)
-		}
-	}
+{{- if .GenerateDelete }}
 
-	return nil
+// Delete{{ .APIVersionPrefix }}{{ .ResourceNamePlural }} will delete the {{ .APIVersionPrefix }}{{ .ResourceNamePlural }} with the passed names, ignoring those that do not exist
+{{- if .ClusterScoped }}
+func Delete{{ .APIVersionPrefix }}{{ .ResourceNamePlural }}(ctx context.Context, names []string, client ctrlruntimeclient.Client) error {
+	return DeleteObjects(ctx, names, metav1.NamespaceNone, client, &{{ .ImportAlias }}.{{ .ResourceName }}{})
 }
+{{- else }}
+func Delete{{ .APIVersionPrefix }}{{ .ResourceNamePlural }}(ctx context.Context, names []string, namespace string, client ctrlruntimeclient.Client) error {
+	return DeleteObjects(ctx, names, namespace, client, &{{ .ImportAlias }}.{{ .ResourceName }}{})
+}
+{{- end }}
+{{- end }}
 
 `))
@@ -1,6 +1,6 @@
 module k8c.io/kubermatic/v2
 
-go 1.17
+go 1.18
 
 require (
 	code.cloudfoundry.org/go-pubsub v0.0.0-20211215163300-870699d61ec9
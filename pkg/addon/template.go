@@ -19,8 +19,11 @@ package addon
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"path"
 	"strings"
 	"text/template"
@@ -41,6 +44,10 @@ import (
 
 const (
 	ClusterTypeKubernetes = "kubernetes"
+
+	// KustomizationFile marks an addon folder as a kustomization, which is rendered
+	// using kubectl before templating.
+	KustomizationFile = "kustomization.yaml"
 )
 
 func txtFuncMap(overwriteRegistry string) template.FuncMap {
@@ -69,6 +76,7 @@ func NewTemplateData(
 	kubeconfig string,
 	dnsClusterIP string,
 	dnsResolverIP string,
+	caBundle string,
 	variables map[string]interface{},
 ) (*TemplateData, error) {
 	providerName, err := provider.ClusterCloudProviderName(cluster.Spec.Cloud)
@@ -102,6 +110,12 @@ func NewTemplateData(
 		ipvs = *cluster.Spec.ClusterNetwork.IPVS
 	}
 
+	var conntrack Conntrack
+	if c := cluster.Spec.ClusterNetwork.Conntrack; c != nil {
+		conntrack.MaxPerCore = c.MaxPerCore
+		conntrack.Min = c.Min
+	}
+
 	return &TemplateData{
 		DatacenterName: cluster.Spec.Cloud.DatacenterName,
 		Variables:      variables,
@@ -114,6 +128,7 @@ func NewTemplateData(
 			Labels:            cluster.Labels,
 			Annotations:       cluster.Annotations,
 			Kubeconfig:        kubeconfig,
+			CABundle:          caBundle,
 			// nolint:staticcheck
 			OwnerName:         cluster.Status.UserName,
 			OwnerEmail:        cluster.Status.UserEmail,
@@ -130,6 +145,8 @@ func NewTemplateData(
 				ServiceCIDRBlocks:    cluster.Spec.ClusterNetwork.Services.CIDRBlocks,
 				ProxyMode:            cluster.Spec.ClusterNetwork.ProxyMode,
 				StrictArp:            ipvs.StrictArp,
+				IPVSScheduler:        ipvs.Scheduler,
+				Conntrack:            conntrack,
 				DualStack:            cluster.IsDualStack(),
 				PodCIDRIPv4:          cluster.Spec.ClusterNetwork.Pods.GetIPv4CIDR(),
 				PodCIDRIPv6:          cluster.Spec.ClusterNetwork.Pods.GetIPv6CIDR(),
@@ -175,6 +192,10 @@ type ClusterData struct {
 	// inside the user-cluster. The kubeconfig uses the external URL to reach
 	// the apiserver.
 	Kubeconfig string
+	// CABundle is the PEM-encoded global CA bundle configured in the KubermaticConfiguration's
+	// `spec.caBundle`. It is the same for all clusters of a seed and not specific to this
+	// cluster. It can be used to let components trust e.g. a registry with a private CA.
+	CABundle string
 
 	// ClusterAddress stores access and address information of a cluster.
 	Address kubermaticv1.ClusterAddress
@@ -211,6 +232,8 @@ type ClusterNetwork struct {
 	ServiceCIDRBlocks    []string
 	ProxyMode            string
 	StrictArp            *bool
+	IPVSScheduler        string
+	Conntrack            Conntrack
 	DualStack            bool
 	PodCIDRIPv4          string
 	PodCIDRIPv6          string
@@ -218,6 +241,13 @@ type ClusterNetwork struct {
 	NodeCIDRMaskSizeIPv6 int32
 }
 
+// Conntrack contains the kube-proxy conntrack settings. Unset values are nil, so
+// templates can fall back to their own defaults.
+type Conntrack struct {
+	MaxPerCore *int32
+	Min        *int32
+}
+
 type CNIPlugin struct {
 	Type    string
 	Version string
@@ -281,34 +311,76 @@ func ParseFromFolder(log *zap.SugaredLogger, overwriteRegistry string, manifestP
 			return nil, fmt.Errorf("failed to read file %s: %w", filename, err)
 		}
 
-		tpl, err := template.New(info.Name()).Funcs(txtFuncMap(overwriteRegistry)).Parse(string(fbytes))
+		manifests, err := parseManifests(infoLog, overwriteRegistry, filename, fbytes, data)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse file %s: %w", filename, err)
+			return nil, err
 		}
+		allManifests = append(allManifests, manifests...)
+	}
 
-		bufferAll := bytes.NewBuffer([]byte{})
-		if err := tpl.Execute(bufferAll, data); err != nil {
-			return nil, fmt.Errorf("failed to execute templating on file %s: %w", filename, err)
-		}
+	return allManifests, nil
+}
 
-		sd := strings.TrimSpace(bufferAll.String())
-		if len(sd) == 0 {
-			infoLog.Debug("Skipping file as its empty after parsing")
-			continue
-		}
+// IsKustomization returns true if the given addon folder contains a kustomization.
+func IsKustomization(manifestPath string) (bool, error) {
+	_, err := os.Stat(path.Join(manifestPath, KustomizationFile))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return false, err
+}
 
-		addonManifests, err := yaml.ParseMultipleDocuments(bufio.NewReader(bufferAll))
-		if err != nil {
-			return nil, fmt.Errorf("decoding failed for file %s: %w", filename, err)
-		}
+// ParseFromKustomization renders the kustomization in the given folder using the given kubectl
+// binary and then templates the result, like ParseFromFolder does for each file of a regular addon.
+func ParseFromKustomization(ctx context.Context, log *zap.SugaredLogger, overwriteRegistry string, kubectlBinary string, manifestPath string, data *TemplateData) ([]Manifest, error) {
+	filename := path.Join(manifestPath, KustomizationFile)
+	log.With("file", filename).Debug("Rendering kustomization")
 
-		for _, m := range addonManifests {
-			allManifests = append(allManifests, Manifest{
-				Content:    m,
-				SourceFile: filename,
-			})
-		}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, kubectlBinary, "kustomize", manifestPath)
+	cmd.Stderr = stderr
+
+	rendered, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to render kustomization %s: %w (stderr: %s)", filename, err, strings.TrimSpace(stderr.String()))
 	}
 
-	return allManifests, nil
+	return parseManifests(log.With("file", filename), overwriteRegistry, filename, rendered, data)
+}
+
+// parseManifests templates the given content and decodes the resulting YAML documents.
+func parseManifests(log *zap.SugaredLogger, overwriteRegistry string, filename string, content []byte, data *TemplateData) ([]Manifest, error) {
+	tpl, err := template.New(path.Base(filename)).Funcs(txtFuncMap(overwriteRegistry)).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse file %s: %w", filename, err)
+	}
+
+	bufferAll := bytes.NewBuffer([]byte{})
+	if err := tpl.Execute(bufferAll, data); err != nil {
+		return nil, fmt.Errorf("failed to execute templating on file %s: %w", filename, err)
+	}
+
+	sd := strings.TrimSpace(bufferAll.String())
+	if len(sd) == 0 {
+		log.Debug("Skipping file as its empty after parsing")
+		return nil, nil
+	}
+
+	addonManifests, err := yaml.ParseMultipleDocuments(bufio.NewReader(bufferAll))
+	if err != nil {
+		return nil, fmt.Errorf("decoding failed for file %s: %w", filename, err)
+	}
+
+	manifests := make([]Manifest, 0, len(addonManifests))
+	for _, m := range addonManifests {
+		manifests = append(manifests, Manifest{
+			Content:    m,
+			SourceFile: filename,
+		})
+	}
+
+	return manifests, nil
 }
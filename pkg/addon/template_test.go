@@ -17,15 +17,18 @@ limitations under the License.
 package addon
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"go.uber.org/zap"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	"k8c.io/kubermatic/v2/pkg/semver"
 	"k8c.io/kubermatic/v2/pkg/version/cni"
 
@@ -89,7 +92,7 @@ func testRenderAddonsForOrchestrator(t *testing.T, orchestrator string) {
 
 	for _, cluster := range clusters {
 		for _, addon := range addons {
-			data, err := NewTemplateData(&cluster, credentials, "kubeconfig", "1.2.3.4", "5.6.7.8", variables)
+			data, err := NewTemplateData(&cluster, credentials, "kubeconfig", "1.2.3.4", "5.6.7.8", "", variables)
 			if err != nil {
 				t.Fatalf("Rendering %s addon %s for cluster %s failed: %v", orchestrator, addon.Name, cluster.Name, err)
 			}
@@ -112,6 +115,10 @@ func TestNewTemplateData(t *testing.T) {
 			ClusterNetwork: kubermaticv1.ClusterNetworkingConfig{
 				IPVS: &kubermaticv1.IPVSConfiguration{
 					StrictArp: pointer.BoolPtr(true),
+					Scheduler: "lc",
+				},
+				Conntrack: &kubermaticv1.ConntrackConfiguration{
+					MaxPerCore: pointer.Int32(32768),
 				},
 			},
 			CNIPlugin: &kubermaticv1.CNIPluginSettings{
@@ -132,7 +139,9 @@ func TestNewTemplateData(t *testing.T) {
 
 	credentials := resources.Credentials{}
 
-	templateData, err := NewTemplateData(&cluster, credentials, "", "", "", nil)
+	caBundle := certificates.NewFakeCABundle().String()
+
+	templateData, err := NewTemplateData(&cluster, credentials, "", "", "", caBundle, nil)
 	if err != nil {
 		t.Fatalf("Failed to create template data: %v", err)
 	}
@@ -140,4 +149,65 @@ func TestNewTemplateData(t *testing.T) {
 	if !templateData.Cluster.Features.Has(feature) {
 		t.Fatalf("Expected cluster features to contain %q, but does not.", feature)
 	}
+
+	if templateData.Cluster.Network.IPVSScheduler != "lc" {
+		t.Fatalf("Expected IPVS scheduler to be %q, got %q.", "lc", templateData.Cluster.Network.IPVSScheduler)
+	}
+
+	if maxPerCore := templateData.Cluster.Network.Conntrack.MaxPerCore; maxPerCore == nil || *maxPerCore != 32768 {
+		t.Fatalf("Expected conntrack maxPerCore to be 32768, got %v.", maxPerCore)
+	}
+
+	if templateData.Cluster.CABundle != caBundle {
+		t.Fatalf("Expected CA bundle to be %q, got %q.", caBundle, templateData.Cluster.CABundle)
+	}
+}
+
+func TestParseFromKustomization(t *testing.T) {
+	addonDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(addonDir, KustomizationFile), []byte("resources: []\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	isKustomization, err := IsKustomization(addonDir)
+	if err != nil {
+		t.Fatalf("Failed to check for kustomization: %v", err)
+	}
+	if !isKustomization {
+		t.Fatal("Expected addon folder to be detected as kustomization")
+	}
+
+	// a fake kubectl that prints a rendered kustomization containing template directives,
+	// which must be templated afterwards
+	rendered := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Cluster.Name }}\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: second\n"
+	kubectlBinary := filepath.Join(t.TempDir(), "kubectl")
+	script := fmt.Sprintf("#!/bin/sh\n[ \"$1\" = kustomize ] && [ \"$2\" = %q ] || exit 1\ncat <<'EOF'\n%sEOF\n", addonDir, rendered)
+	if err := os.WriteFile(kubectlBinary, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	data := &TemplateData{Cluster: ClusterData{Name: "cluster-a"}}
+
+	manifests, err := ParseFromKustomization(context.Background(), zap.NewNop().Sugar(), "", kubectlBinary, addonDir, data)
+	if err != nil {
+		t.Fatalf("Failed to parse kustomization: %v", err)
+	}
+
+	if len(manifests) != 2 {
+		t.Fatalf("Expected 2 manifests, got %d", len(manifests))
+	}
+
+	if !strings.Contains(string(manifests[0].Content.Raw), `"name":"cluster-a"`) {
+		t.Errorf("Expected rendered kustomization to be templated, got %s", string(manifests[0].Content.Raw))
+	}
+}
+
+func TestIsKustomization(t *testing.T) {
+	isKustomization, err := IsKustomization(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to check for kustomization: %v", err)
+	}
+	if isKustomization {
+		t.Error("Expected empty addon folder to not be detected as kustomization")
+	}
 }
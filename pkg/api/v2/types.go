@@ -436,6 +436,8 @@ const (
 type ExternalClusterStatus struct {
 	State         ExternalClusterState `json:"state"`
 	StatusMessage string               `json:"statusMessage,omitempty"`
+	// ProviderState is the unmodified state reported by the cloud provider.
+	ProviderState string `json:"providerState,omitempty"`
 }
 
 // ExternalClusterSpec defines the external cluster specification.
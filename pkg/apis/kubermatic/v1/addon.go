@@ -67,8 +67,20 @@ type AddonSpec struct {
 	// apiserver must be installed before this addon can be installed. The addon will not
 	// be installed until that resource is served.
 	RequiredResourceTypes []GroupVersionKind `json:"requiredResourceTypes,omitempty"`
+	// Requires is a list of names of other addons in the same cluster that must have been
+	// installed successfully before this addon can be installed. This can be used to
+	// install an addon only after another addon providing its CRDs has been applied.
+	Requires []string `json:"requires,omitempty"`
 	// IsDefault indicates whether the addon is default
 	IsDefault bool `json:"isDefault,omitempty"`
+	// OverwriteRegistry is the registry to use for all images referenced by this addon's
+	// manifests. It takes precedence over the controller-wide registry override. If empty,
+	// the controller-wide setting is used.
+	OverwriteRegistry string `json:"overwriteRegistry,omitempty"`
+	// Namespace optionally restricts the addon to a single namespace in the user cluster. If set,
+	// the addon's manifests are applied to and pruned in this namespace only, and only namespaced
+	// resource types are pruned. Addons with a namespace must not contain cluster-scoped resources.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -48,6 +48,18 @@ const (
 
 	// PresetInvalidatedAnnotation is key of the annotation used to indicate why the preset was invalidated.
 	PresetInvalidatedAnnotation = "presetInvalidated"
+
+	// PauseReconcileAnnotation is the key of the annotation used to temporarily stop the cluster
+	// controller from reconciling the cluster's control plane, e.g. while debugging. It is only
+	// honored if set to "true".
+	PauseReconcileAnnotation = "kubermatic.k8c.io/pause-reconcile"
+
+	// DryRunReconcileAnnotation is the key of the annotation used to make the cluster controller
+	// only compute and log the changes it would make to the cluster's control plane, without
+	// applying them or updating the cluster status. While it is set, the regular reconciling of
+	// the cluster is skipped entirely, so no changes are rolled out until it is removed again.
+	// It is only honored if set to "true" and ignored for clusters that are being deleted.
+	DryRunReconcileAnnotation = "kubermatic.k8c.io/dry-run-reconcile"
 )
 
 const (
@@ -157,7 +169,8 @@ type ClusterSpec struct {
 	Features map[string]bool `json:"features,omitempty"`
 
 	// Optional: UpdateWindow configures automatic update systems to respect a maintenance window for
-	// applying OS updates to nodes. This is only respected on Flatcar nodes currently.
+	// applying OS updates to nodes (only respected on Flatcar nodes currently) and for rolling out
+	// control plane version updates.
 	UpdateWindow *UpdateWindow `json:"updateWindow,omitempty"`
 
 	// Enables the admission plugin `PodSecurityPolicy`. This plugin is deprecated by Kubernetes.
@@ -210,10 +223,19 @@ type ClusterSpec struct {
 	// Optional: MLA contains monitoring, logging and alerting related settings for the user cluster.
 	MLA *MLASettings `json:"mla,omitempty"`
 
+	// Optional: MetricsServer configures the metrics-server that serves the resource metrics API
+	// for this cluster.
+	MetricsServer *MetricsServerSettings `json:"metricsServer,omitempty"`
+
 	// Optional: Configures encryption-at-rest for Kubernetes API data. This needs the `encryptionAtRest` feature gate.
 	// THIS IS A PLACEHOLDER AND NOT FUNCTIONAL YET.
 	EncryptionConfiguration *EncryptionConfiguration `json:"encryptionConfiguration,omitempty"`
 
+	// Optional: ProxySettings configures the HTTP proxy used by the control plane components
+	// (e.g. kube-apiserver and kube-controller-manager) of this cluster. Settings that are
+	// not set are inherited from the Seed's proxy settings.
+	ProxySettings *ProxySettings `json:"proxySettings,omitempty"`
+
 	// If this is set to true, the cluster will not be reconciled by KKP.
 	// This indicates that the user needs to do some action to resolve the pause.
 	// +kubebuilder:default=false
@@ -301,7 +323,7 @@ const (
 	ClusterFeatureEncryptionAtRest = "encryptionAtRest"
 )
 
-// +kubebuilder:validation:Enum="";SeedResourcesUpToDate;ClusterControllerReconciledSuccessfully;AddonControllerReconciledSuccessfully;AddonInstallerControllerReconciledSuccessfully;BackupControllerReconciledSuccessfully;CloudControllerReconcilledSuccessfully;UpdateControllerReconciledSuccessfully;MonitoringControllerReconciledSuccessfully;MachineDeploymentReconciledSuccessfully;MLAControllerReconciledSuccessfully;ClusterInitialized;EtcdClusterInitialized;CSIKubeletMigrationCompleted;ClusterUpdateSuccessful;ClusterUpdateInProgress;CSIKubeletMigrationSuccess;CSIKubeletMigrationInProgress;EncryptionControllerReconciledSuccessfully;
+// +kubebuilder:validation:Enum="";SeedResourcesUpToDate;ClusterControllerReconciledSuccessfully;AddonControllerReconciledSuccessfully;AddonInstallerControllerReconciledSuccessfully;BackupControllerReconciledSuccessfully;CloudControllerReconcilledSuccessfully;UpdateControllerReconciledSuccessfully;MonitoringControllerReconciledSuccessfully;MachineDeploymentReconciledSuccessfully;MLAControllerReconciledSuccessfully;ClusterInitialized;EtcdClusterInitialized;CSIKubeletMigrationCompleted;ClusterUpdateSuccessful;ClusterUpdateInProgress;CSIKubeletMigrationSuccess;CSIKubeletMigrationInProgress;EncryptionControllerReconciledSuccessfully;ReconcilingEnabled;ControlPlaneUpdateAllowed;
 
 // ClusterConditionType is used to indicate the type of a cluster condition. For all condition
 // types, the `true` value must indicate success. All condition types must be registered within
@@ -309,9 +331,11 @@ const (
 type ClusterConditionType string
 
 // UpdateWindow allows defining windows for maintenance tasks related to OS updates.
-// This is only applied to cluster nodes using Flatcar Linux.
-// The reference time for this is the node system time and might differ from
-// the user's timezone, which needs to be considered when configuring a window.
+// This is applied to cluster nodes using Flatcar Linux and to control plane version
+// updates, which are deferred until the window opens.
+// The reference time for this is the node system time (or the seed system time for the
+// control plane) and might differ from the user's timezone, which needs to be considered
+// when configuring a window.
 type UpdateWindow struct {
 	// Sets the start time of the update window. This can be a time of day in 24h format, e.g. `22:30`,
 	// or a day of week plus a time of day, for example `Mon 21:00`. Only short names for week days are supported,
@@ -383,6 +407,14 @@ const (
 
 	ClusterConditionUpdateProgress ClusterConditionType = "UpdateProgress"
 
+	// ClusterConditionReconcilingEnabled is false while reconciling the cluster is paused via the
+	// PauseReconcileAnnotation.
+	ClusterConditionReconcilingEnabled ClusterConditionType = "ReconcilingEnabled"
+
+	// ClusterConditionControlPlaneUpdateAllowed is false while a control plane version update
+	// is deferred until the cluster's UpdateWindow opens.
+	ClusterConditionControlPlaneUpdateAllowed ClusterConditionType = "ControlPlaneUpdateAllowed"
+
 	// ClusterConditionNone is a special value indicating that no cluster condition should be set.
 	ClusterConditionNone ClusterConditionType = ""
 	// This condition is met when a CSI migration is ongoing and the CSI
@@ -634,6 +666,20 @@ type OPAIntegrationSettings struct {
 	ControllerResources *corev1.ResourceRequirements `json:"controllerResources,omitempty"`
 	// Optional: AuditResources is the resource requirements for user cluster gatekeeper audit.
 	AuditResources *corev1.ResourceRequirements `json:"auditResources,omitempty"`
+	// Optional: SyncResources lists the resources which Gatekeeper replicates into OPA's cache, so that
+	// constraints can evaluate rules spanning multiple objects. If empty, the Gatekeeper Config is not
+	// managed by KKP.
+	SyncResources []OPASyncResource `json:"syncResources,omitempty"`
+}
+
+// OPASyncResource identifies a resource kind which Gatekeeper syncs into OPA's cache.
+type OPASyncResource struct {
+	// Group is the API group of the resource, empty for the core group.
+	Group string `json:"group,omitempty"`
+	// Version is the API version of the resource.
+	Version string `json:"version"`
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
 }
 
 type ServiceAccountSettings struct {
@@ -644,6 +690,10 @@ type ServiceAccountSettings struct {
 	// APIAudiences are the Identifiers of the API
 	// If this is not specified, it will be set to a single element list containing the issuer URL
 	APIAudiences []string `json:"apiAudiences,omitempty"`
+	// Optional: JWKSURI overrides the URI of the JSON Web Key Set in the discovery document served by
+	// the apiserver. Set this when the JWKS is served externally, e.g. for workload identity federation.
+	// The JWKS is provided in the "service-account-jwks" ConfigMap in the cluster namespace.
+	JWKSURI string `json:"jwksURI,omitempty"`
 }
 
 type MLASettings struct {
@@ -659,6 +709,16 @@ type MLASettings struct {
 	MonitoringReplicas *int32 `json:"monitoringReplicas,omitempty"`
 }
 
+// MetricsServerSettings contains settings for the metrics-server of a cluster.
+type MetricsServerSettings struct {
+	// KubeletInsecureTLS disables the verification of the kubelet serving certificates, which is
+	// required for nodes with self-signed kubelet certificates. Defaults to true.
+	KubeletInsecureTLS *bool `json:"kubeletInsecureTLS,omitempty"`
+	// MetricResolution is the interval in which metrics are scraped from the kubelets, e.g. `30s`.
+	// Must be between 10s and 5m. Defaults to 15s.
+	MetricResolution *metav1.Duration `json:"metricResolution,omitempty"`
+}
+
 type ComponentSettings struct {
 	// Apiserver configures kube-apiserver settings.
 	Apiserver APIServerSettings `json:"apiserver"`
@@ -676,6 +736,9 @@ type ComponentSettings struct {
 	NodePortProxyEnvoy NodeportProxyComponent `json:"nodePortProxyEnvoy"`
 	// KonnectivityProxy configures resources limits/requests for konnectivity-server sidecar.
 	KonnectivityProxy KonnectvityProxySettings `json:"konnectivityProxy,omitempty"`
+	// OperatingSystemManager configures the operating-system-manager controller. This is only
+	// effective if the operating-system-manager is enabled for the cluster.
+	OperatingSystemManager OperatingSystemManagerSettings `json:"operatingSystemManager,omitempty"`
 }
 
 type APIServerSettings struct {
@@ -694,6 +757,19 @@ type ControllerSettings struct {
 	LeaderElectionSettings `json:"leaderElection,omitempty"`
 }
 
+// OperatingSystemManagerSettings configures the operating-system-manager controller
+// running in the cluster namespace.
+type OperatingSystemManagerSettings struct {
+	DeploymentSettings `json:",inline"`
+
+	// LogLevel is the klog verbosity of the operating-system-manager. Defaults to 4.
+	// +kubebuilder:validation:Minimum=0
+	LogLevel *int32 `json:"logLevel,omitempty"`
+	// LeaderElection enables leader election for the operating-system-manager. Defaults
+	// to true. Leader election is always enabled if more than one replica is configured.
+	LeaderElection *bool `json:"leaderElection,omitempty"`
+}
+
 type DeploymentSettings struct {
 	Replicas    *int32                       `json:"replicas,omitempty"`
 	Resources   *corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -710,6 +786,9 @@ type EtcdStatefulSetSettings struct {
 	DiskSize     *resource.Quantity           `json:"diskSize,omitempty"`
 	Resources    *corev1.ResourceRequirements `json:"resources,omitempty"`
 	Tolerations  []corev1.Toleration          `json:"tolerations,omitempty"`
+	// DefragmentationSchedule is a cron expression (e.g. "0 3 * * *") that controls
+	// when the etcd defragmentation job runs. Defaults to every 3 hours if not set.
+	DefragmentationSchedule string `json:"defragmentationSchedule,omitempty"`
 }
 
 type LeaderElectionSettings struct {
@@ -783,12 +862,22 @@ type ClusterNetworkingConfig struct {
 	// IPVS defines kube-proxy ipvs configuration options
 	IPVS *IPVSConfiguration `json:"ipvs,omitempty"`
 
+	// Conntrack defines kube-proxy conntrack configuration options
+	Conntrack *ConntrackConfiguration `json:"conntrack,omitempty"`
+
 	// +kubebuilder:default=true
 
 	// NodeLocalDNSCacheEnabled controls whether the NodeLocal DNS Cache feature is enabled.
 	// Defaults to true.
 	NodeLocalDNSCacheEnabled *bool `json:"nodeLocalDNSCacheEnabled,omitempty"`
 
+	// NodeLocalDNSCacheAddress is the IPv4 link-local address the NodeLocal DNS Cache listens on
+	// and that is configured as the cluster DNS server on all nodes. Only used if the NodeLocal
+	// DNS Cache is enabled. If not set, 169.254.20.10 is used. Cannot be changed later on, as
+	// existing nodes keep the address they were provisioned with.
+	// +optional
+	NodeLocalDNSCacheAddress string `json:"nodeLocalDNSCacheAddress,omitempty"`
+
 	// CoreDNSReplicas is the number of desired pods of user cluster coredns deployment.
 	CoreDNSReplicas *int32 `json:"coreDNSReplicas,omitempty"`
 
@@ -831,6 +920,30 @@ type IPVSConfiguration struct {
 	// StrictArp configure arp_ignore and arp_announce to avoid answering ARP queries from kube-ipvs0 interface.
 	// defaults to true.
 	StrictArp *bool `json:"strictArp,omitempty"`
+
+	// +kubebuilder:validation:Enum=rr;lc;dh;sh;sed;nq
+
+	// Scheduler is the IPVS scheduler used by kube-proxy, one of "rr" (round-robin), "lc" (least connection),
+	// "dh" (destination hashing), "sh" (source hashing), "sed" (shortest expected delay) or "nq" (never queue).
+	// Defaults to "rr" if empty.
+	Scheduler string `json:"scheduler,omitempty"`
+}
+
+// ConntrackConfiguration contains conntrack-related configuration details for kube-proxy.
+type ConntrackConfiguration struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1048576
+
+	// MaxPerCore is the maximum number of NAT connections to track per CPU core.
+	// 0 leaves the limit as-is and ignores min. Defaults to 0.
+	MaxPerCore *int32 `json:"maxPerCore,omitempty"`
+
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16777216
+
+	// Min is the minimum number of conntrack entries to allocate, regardless of maxPerCore.
+	// Defaults to 524288.
+	Min *int32 `json:"min,omitempty"`
 }
 
 // CloudSpec stores configuration options for a given cloud provider. Provider specs are mutually exclusive.
@@ -863,6 +976,8 @@ type CloudSpec struct {
 
 // FakeCloudSpec specifies access data for a fake cloud.
 type FakeCloudSpec struct {
+	CredentialsReference *providerconfig.GlobalSecretKeySelector `json:"credentialsReference,omitempty"`
+
 	Token string `json:"token,omitempty"`
 }
 
@@ -923,6 +1038,9 @@ type AzureCloudSpec struct {
 	// If set to empty string at cluster creation, a new route table will be created and this field will be updated to
 	// the generated route table's name. If no subnet is defined at cluster creation, this field should be empty as well.
 	RouteTableName string `json:"routeTable"`
+	// Optional: RouteTableResourceGroup optionally defines a second resource group that the route table referenced by `routeTable` lives in.
+	// If left empty, the route table uses the resource group defined by `resourceGroup`.
+	RouteTableResourceGroup string `json:"routeTableResourceGroup,omitempty"`
 	// The name of a security group associated with the subnet referenced by `subnet`.
 	// If set to empty string at cluster creation, a new security group will be created and this field will be updated to
 	// the generated security group's name. If no subnet is defined at cluster creation, this field should be empty as well.
@@ -1111,6 +1229,20 @@ type OpenstackCloudSpec struct {
 	// level if both are specified.
 	// +optional
 	UseOctavia *bool `json:"useOctavia,omitempty"`
+	// FloatingNetworkID is the ID of the external network the cloud controller
+	// manager allocates floating IPs for LoadBalancer services from.
+	//
+	// Takes precedence over the 'floatingNetworkID' provided at datacenter
+	// level if both are specified.
+	// +optional
+	FloatingNetworkID string `json:"floatingNetworkID,omitempty"`
+	// LoadBalancerProvider is the Octavia provider used for LoadBalancer
+	// services, for example "amphora" or "ovn".
+	//
+	// Takes precedence over the 'loadBalancerProvider' provided at datacenter
+	// level if both are specified.
+	// +optional
+	LoadBalancerProvider string `json:"loadBalancerProvider,omitempty"`
 }
 
 // PacketCloudSpec specifies access data to a Packet cloud.
@@ -1148,10 +1280,21 @@ type KubevirtCloudSpec struct {
 }
 
 type PreAllocatedDataVolume struct {
-	Name         string `json:"name"`
-	URL          string `json:"url"`
-	Size         string `json:"size"`
-	StorageClass string `json:"storageClass"`
+	Name string `json:"name"`
+	// URL is the HTTP(S) URL of the image to import into the DataVolume.
+	// Exactly one of URL and SourcePVC must be set.
+	URL string `json:"url,omitempty"`
+	// SourcePVC is an existing PVC in the KubeVirt infra cluster that is cloned into the DataVolume.
+	// Exactly one of URL and SourcePVC must be set.
+	SourcePVC    *PreAllocatedDataVolumeSourcePVC `json:"sourcePVC,omitempty"`
+	Size         string                           `json:"size"`
+	StorageClass string                           `json:"storageClass"`
+}
+
+// PreAllocatedDataVolumeSourcePVC references a PVC in the KubeVirt infra cluster.
+type PreAllocatedDataVolumeSourcePVC struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
 }
 
 // AlibabaCloudSpec specifies the access data to Alibaba.
@@ -222,8 +222,14 @@ type KubermaticUserClusterConfiguration struct {
 	EtcdVolumeSize string `json:"etcdVolumeSize,omitempty"`
 	// APIServerReplicas configures the replica count for the API-Server deployment inside user clusters.
 	APIServerReplicas *int32 `json:"apiserverReplicas,omitempty"`
+	// MinNodeCapacity is the minimum number of nodes the pod network of a user cluster must
+	// be able to accommodate, based on its pod CIDRs and node CIDR mask sizes. Clusters that
+	// cannot fit this many nodes are rejected. Set to 0 (the default) to disable this check.
+	MinNodeCapacity int32 `json:"minNodeCapacity,omitempty"`
 	// MachineController configures the Machine Controller
 	MachineController MachineControllerConfiguration `json:"machineController,omitempty"`
+	// OperatingSystemManager configures the image of the Operating System Manager.
+	OperatingSystemManager OperatingSystemManagerConfiguration `json:"operatingSystemManager,omitempty"`
 }
 
 // KubermaticUserClusterMonitoringConfiguration can be used to fine-tune to in-cluster Prometheus.
@@ -261,6 +267,16 @@ type MachineControllerConfiguration struct {
 	ImageTag string `json:"imageTag,omitempty"`
 }
 
+// OperatingSystemManagerConfiguration configures the Operating System Manager.
+type OperatingSystemManagerConfiguration struct {
+	// ImageRepository is used to override the Operating System Manager image repository.
+	// It is only for development, tests and PoC purposes. This field must not be set in production environments.
+	ImageRepository string `json:"imageRepository,omitempty"`
+	// ImageTag is used to override the Operating System Manager image.
+	// It is only for development, tests and PoC purposes. This field must not be set in production environments.
+	ImageTag string `json:"imageTag,omitempty"`
+}
+
 // KubermaticAddonConfiguration describes the addons for a given cluster runtime.
 type KubermaticAddonsConfiguration struct {
 	// Default is the list of addons to be installed by default into each cluster.
@@ -459,6 +459,12 @@ type DatacenterSpecOpenstack struct {
 	// use-octavia is enabled by default in CCM since v1.17.0, and disabled by
 	// default with the in-tree cloud provider.
 	UseOctavia *bool `json:"useOctavia,omitempty"`
+	// Optional: Gets mapped to the "floating-network-id" setting in the cloud config.
+	// ID of the external network the CCM allocates floating IPs for load balancers from.
+	FloatingNetworkID string `json:"floatingNetworkID,omitempty"`
+	// Optional: Gets mapped to the "lb-provider" setting in the cloud config,
+	// for example "amphora" or "ovn".
+	LoadBalancerProvider string `json:"loadBalancerProvider,omitempty"`
 	// Optional: Gets mapped to the "trust-device-path" setting in the cloud config.
 	// See https://kubernetes.io/docs/concepts/cluster-administration/cloud-providers/#block-storage
 	// This setting defaults to false.
@@ -584,6 +590,11 @@ type DatacenterSpecKubevirt struct {
 	// DNSConfig represents the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS
 	// configuration based on DNSPolicy.
 	DNSConfig *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+
+	// Optional: DisableVolumeDeletion removes the permission to delete DataVolumes from the Role used by the
+	// KubeVirt CSI driver in the infra cluster. Volumes of deleted user cluster PVCs then have to be cleaned
+	// up manually. Defaults to false.
+	DisableVolumeDeletion bool `json:"disableVolumeDeletion,omitempty"`
 }
 
 // DatacenterSpecNutanix describes a Nutanix datacenter.
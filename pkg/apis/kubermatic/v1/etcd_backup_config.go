@@ -73,6 +73,9 @@ type EtcdBackupConfigSpec struct {
 	// Destination indicates where the backup will be stored. The destination name must correspond to a destination in
 	// the cluster's Seed.Spec.EtcdBackupRestore.
 	Destination string `json:"destination"`
+	// Compress enables gzip compression of the etcd snapshot before it is stored.
+	// Restores handle both compressed and uncompressed backups.
+	Compress bool `json:"compress,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -292,6 +292,11 @@ func (in *AddonSpec) DeepCopyInto(out *AddonSpec) {
 		*out = make([]GroupVersionKind, len(*in))
 		copy(*out, *in)
 	}
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonSpec.
@@ -827,7 +832,7 @@ func (in *CloudSpec) DeepCopyInto(out *CloudSpec) {
 	if in.Fake != nil {
 		in, out := &in.Fake, &out.Fake
 		*out = new(FakeCloudSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Digitalocean != nil {
 		in, out := &in.Digitalocean, &out.Digitalocean
@@ -1043,6 +1048,11 @@ func (in *ClusterNetworkingConfig) DeepCopyInto(out *ClusterNetworkingConfig) {
 		*out = new(IPVSConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Conntrack != nil {
+		in, out := &in.Conntrack, &out.Conntrack
+		*out = new(ConntrackConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeLocalDNSCacheEnabled != nil {
 		in, out := &in.NodeLocalDNSCacheEnabled, &out.NodeLocalDNSCacheEnabled
 		*out = new(bool)
@@ -1145,11 +1155,21 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(MLASettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MetricsServer != nil {
+		in, out := &in.MetricsServer, &out.MetricsServer
+		*out = new(MetricsServerSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EncryptionConfiguration != nil {
 		in, out := &in.EncryptionConfiguration, &out.EncryptionConfiguration
 		*out = new(EncryptionConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxySettings != nil {
+		in, out := &in.ProxySettings, &out.ProxySettings
+		*out = new(ProxySettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSpec.
@@ -1414,6 +1434,7 @@ func (in *ComponentSettings) DeepCopyInto(out *ComponentSettings) {
 	in.Prometheus.DeepCopyInto(&out.Prometheus)
 	in.NodePortProxyEnvoy.DeepCopyInto(&out.NodePortProxyEnvoy)
 	in.KonnectivityProxy.DeepCopyInto(&out.KonnectivityProxy)
+	in.OperatingSystemManager.DeepCopyInto(&out.OperatingSystemManager)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentSettings.
@@ -1426,6 +1447,31 @@ func (in *ComponentSettings) DeepCopy() *ComponentSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConntrackConfiguration) DeepCopyInto(out *ConntrackConfiguration) {
+	*out = *in
+	if in.MaxPerCore != nil {
+		in, out := &in.MaxPerCore, &out.MaxPerCore
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConntrackConfiguration.
+func (in *ConntrackConfiguration) DeepCopy() *ConntrackConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ConntrackConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Constraint) DeepCopyInto(out *Constraint) {
 	*out = *in
@@ -2906,6 +2952,11 @@ func (in *Fake) DeepCopy() *Fake {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FakeCloudSpec) DeepCopyInto(out *FakeCloudSpec) {
 	*out = *in
+	if in.CredentialsReference != nil {
+		in, out := &in.CredentialsReference, &out.CredentialsReference
+		*out = new(types.GlobalSecretKeySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FakeCloudSpec.
@@ -3647,6 +3698,7 @@ func (in *KubermaticUserClusterConfiguration) DeepCopyInto(out *KubermaticUserCl
 		**out = **in
 	}
 	out.MachineController = in.MachineController
+	out.OperatingSystemManager = in.OperatingSystemManager
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubermaticUserClusterConfiguration.
@@ -3815,7 +3867,9 @@ func (in *KubevirtCloudSpec) DeepCopyInto(out *KubevirtCloudSpec) {
 	if in.PreAllocatedDataVolumes != nil {
 		in, out := &in.PreAllocatedDataVolumes, &out.PreAllocatedDataVolumes
 		*out = make([]PreAllocatedDataVolume, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -4121,6 +4175,31 @@ func (in *MeteringReportConfiguration) DeepCopy() *MeteringReportConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsServerSettings) DeepCopyInto(out *MetricsServerSettings) {
+	*out = *in
+	if in.KubeletInsecureTLS != nil {
+		in, out := &in.KubeletInsecureTLS, &out.KubeletInsecureTLS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MetricResolution != nil {
+		in, out := &in.MetricResolution, &out.MetricResolution
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsServerSettings.
+func (in *MetricsServerSettings) DeepCopy() *MetricsServerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsServerSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MlaOptions) DeepCopyInto(out *MlaOptions) {
 	*out = *in
@@ -4359,6 +4438,11 @@ func (in *OPAIntegrationSettings) DeepCopyInto(out *OPAIntegrationSettings) {
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncResources != nil {
+		in, out := &in.SyncResources, &out.SyncResources
+		*out = make([]OPASyncResource, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OPAIntegrationSettings.
@@ -4371,6 +4455,21 @@ func (in *OPAIntegrationSettings) DeepCopy() *OPAIntegrationSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OPASyncResource) DeepCopyInto(out *OPASyncResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OPASyncResource.
+func (in *OPASyncResource) DeepCopy() *OPASyncResource {
+	if in == nil {
+		return nil
+	}
+	out := new(OPASyncResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpaOptions) DeepCopyInto(out *OpaOptions) {
 	*out = *in
@@ -4447,6 +4546,47 @@ func (in *OpenstackNodeSizeRequirements) DeepCopy() *OpenstackNodeSizeRequiremen
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemManagerConfiguration) DeepCopyInto(out *OperatingSystemManagerConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemManagerConfiguration.
+func (in *OperatingSystemManagerConfiguration) DeepCopy() *OperatingSystemManagerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemManagerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemManagerSettings) DeepCopyInto(out *OperatingSystemManagerSettings) {
+	*out = *in
+	in.DeploymentSettings.DeepCopyInto(&out.DeploymentSettings)
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LeaderElection != nil {
+		in, out := &in.LeaderElection, &out.LeaderElection
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemManagerSettings.
+func (in *OperatingSystemManagerSettings) DeepCopy() *OperatingSystemManagerSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemManagerSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Packet) DeepCopyInto(out *Packet) {
 	*out = *in
@@ -4515,6 +4655,11 @@ func (in Parameters) DeepCopy() Parameters {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreAllocatedDataVolume) DeepCopyInto(out *PreAllocatedDataVolume) {
 	*out = *in
+	if in.SourcePVC != nil {
+		in, out := &in.SourcePVC, &out.SourcePVC
+		*out = new(PreAllocatedDataVolumeSourcePVC)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreAllocatedDataVolume.
@@ -4527,6 +4672,21 @@ func (in *PreAllocatedDataVolume) DeepCopy() *PreAllocatedDataVolume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreAllocatedDataVolumeSourcePVC) DeepCopyInto(out *PreAllocatedDataVolumeSourcePVC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreAllocatedDataVolumeSourcePVC.
+func (in *PreAllocatedDataVolumeSourcePVC) DeepCopy() *PreAllocatedDataVolumeSourcePVC {
+	if in == nil {
+		return nil
+	}
+	out := new(PreAllocatedDataVolumeSourcePVC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Preset) DeepCopyInto(out *Preset) {
 	*out = *in
@@ -90,7 +90,7 @@ func (a *ApplicationManager) reconcileNamespace(ctx context.Context, log *zap.Su
 			},
 		}
 
-		if err := reconciling.ReconcileNamespaces(ctx, creators, "", userClient); err != nil {
+		if err := reconciling.ReconcileNamespaces(ctx, creators, userClient); err != nil {
 			return fmt.Errorf("failed to reconcile namespace: %w", err)
 		}
 	}
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"sync"
+
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// maxConcurrentDeletions limits the number of requests that are sent
+// concurrently to a user cluster while cleaning it up.
+const maxConcurrentDeletions = 10
+
+// forEachConcurrently calls f for every index in [0, n), with at most maxConcurrentDeletions
+// calls running at the same time. All calls are made, even if some of them fail; the
+// returned error aggregates all failures.
+func forEachConcurrently(n int, f func(i int) error) error {
+	var (
+		wg   sync.WaitGroup
+		lock sync.Mutex
+		errs []error
+	)
+
+	semaphore := make(chan struct{}, maxConcurrentDeletions)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := f(i); err != nil {
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	return utilerrors.NewAggregate(errs)
+}
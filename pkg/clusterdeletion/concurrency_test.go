@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func TestForEachConcurrently(t *testing.T) {
+	const items = 50
+
+	var (
+		calls   int32
+		running int32
+		maxSeen int32
+	)
+
+	err := forEachConcurrently(items, func(i int) error {
+		atomic.AddInt32(&calls, 1)
+
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			seen := atomic.LoadInt32(&maxSeen)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+
+		if i%10 == 0 {
+			return fmt.Errorf("item %d failed", i)
+		}
+		return nil
+	})
+
+	if calls != items {
+		t.Errorf("Expected %d calls, got %d", items, calls)
+	}
+
+	if maxSeen > maxConcurrentDeletions {
+		t.Errorf("Expected at most %d concurrent calls, got %d", maxConcurrentDeletions, maxSeen)
+	}
+
+	aggregate, ok := err.(utilerrors.Aggregate)
+	if !ok {
+		t.Fatalf("Expected an aggregated error, got: %v", err)
+	}
+
+	if len(aggregate.Errors()) != items/10 {
+		t.Errorf("Expected %d errors, got %d: %v", items/10, len(aggregate.Errors()), err)
+	}
+}
+
+func TestForEachConcurrentlyWithoutErrors(t *testing.T) {
+	if err := forEachConcurrently(3, func(int) error { return nil }); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+}
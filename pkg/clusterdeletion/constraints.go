@@ -32,26 +32,30 @@ func (d *Deletion) cleanupConstraints(ctx context.Context, cluster *kubermaticv1
 		return nil
 	}
 
-	if err := d.seedClient.DeleteAllOf(ctx, &kubermaticv1.Constraint{}, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
-		return err
-	}
-
 	// `apiv1.GatekeeperConstraintCleanupFinalizer` is added by user-cluster-controller-manager/constraints-syncer.
 	// It could be the case that during cluster deletion, user-cluster-controller-manager is deleted before it removes
 	// the finalizer from constraints object, in this case, the user-cluster namespace will get stuck on deletion.
 	// So here we just remove the finalizer from constraints so that user-cluster namespace can be garbage-collected.
 	// Ref:https://github.com/kubermatic/kubermatic/issues/6934
+	// This is also required for clusters that had the OPA integration enabled in the past, so the
+	// constraints are always listed and only clusters without any constraints skip the cleanup.
 	constraintList := &kubermaticv1.ConstraintList{}
 	if err := d.seedClient.List(ctx, constraintList, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
 		return err
 	}
 
-	for _, constraint := range constraintList.Items {
-		err := kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, &constraint, apiv1.GatekeeperConstraintCleanupFinalizer)
-		if err != nil {
-			return fmt.Errorf("failed to remove constraint finalizer %s: %w", constraint.Name, err)
+	if len(constraintList.Items) > 0 {
+		if err := d.seedClient.DeleteAllOf(ctx, &kubermaticv1.Constraint{}, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+			return err
+		}
+
+		for _, constraint := range constraintList.Items {
+			err := kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, &constraint, apiv1.GatekeeperConstraintCleanupFinalizer)
+			if err != nil {
+				return fmt.Errorf("failed to remove constraint finalizer %s: %w", constraint.Name, err)
+			}
 		}
 	}
 
-	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, apiv1.KubermaticConstraintCleanupFinalizer)
+	return d.finishPhase(ctx, cluster, phaseConstraints, apiv1.KubermaticConstraintCleanupFinalizer)
 }
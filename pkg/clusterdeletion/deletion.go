@@ -19,6 +19,7 @@ package clusterdeletion
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -26,6 +27,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 
+	"k8s.io/client-go/tools/record"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,16 +35,31 @@ const (
 	deletedLBAnnotationName = "kubermatic.k8c.io/cleaned-up-loadbalancers"
 )
 
-func New(seedClient ctrlruntimeclient.Client, userClusterClientGetter func() (ctrlruntimeclient.Client, error)) *Deletion {
+func New(seedClient ctrlruntimeclient.Client, recorder record.EventRecorder, userClusterClientGetter func() (ctrlruntimeclient.Client, error)) *Deletion {
 	return &Deletion{
 		seedClient:              seedClient,
+		recorder:                recorder,
 		userClusterClientGetter: userClusterClientGetter,
 	}
 }
 
 type Deletion struct {
 	seedClient              ctrlruntimeclient.Client
+	recorder                record.EventRecorder
 	userClusterClientGetter func() (ctrlruntimeclient.Client, error)
+
+	lbCleanupTimeout time.Duration
+	forceLBCleanup   bool
+}
+
+// WithLBCleanupTimeout configures how long the cleanup of LoadBalancers inside the user cluster may
+// take before a warning event is recorded on the cluster. If force is true, the
+// InClusterLBCleanupFinalizer is removed once the timeout has passed, so that the cluster deletion can
+// continue; this may leak LoadBalancers at the cloud provider. A timeout of 0 waits indefinitely.
+func (d *Deletion) WithLBCleanupTimeout(timeout time.Duration, force bool) *Deletion {
+	d.lbCleanupTimeout = timeout
+	d.forceLBCleanup = force
+	return d
 }
 
 // CleanupCluster is responsible for cleaning up a cluster.
@@ -110,26 +127,51 @@ func (d *Deletion) cleanupInClusterResources(ctx context.Context, log *zap.Sugar
 		return nil
 	}
 
+	if shouldDeleteLBs && d.lbCleanupTimedOut(cluster, time.Now()) {
+		removed, err := d.handleLBCleanupTimeout(ctx, log, cluster)
+		if err != nil {
+			return err
+		}
+		shouldDeleteLBs = !removed
+	}
+
+	if !shouldDeleteLBs && !shouldDeletePVs {
+		return nil
+	}
+
 	// We'll set this to true in case we deleted something. This is meant to requeue as long as all resources are really gone
 	// We'll use it for LB's and PV's as well, so the Kubernetes controller manager does the cleanup of all resources in parallel
-	var deletedSomeResource bool
+	var deletedSomeLBs, deletedSomeVolumes bool
 
+	// LBs and PVs are cleaned up concurrently, as both can take a while for clusters with many resources
+	cleanups := []func() error{}
 	if shouldDeleteLBs {
-		deletedSomeLBs, err := d.cleanupLBs(ctx, log, cluster)
-		if err != nil {
-			return fmt.Errorf("failed to cleanup LBs: %w", err)
-		}
-		deletedSomeResource = deletedSomeResource || deletedSomeLBs
+		cleanups = append(cleanups, func() (err error) {
+			deletedSomeLBs, err = d.cleanupLBs(ctx, log, cluster)
+			if err != nil {
+				return fmt.Errorf("failed to cleanup LBs: %w", err)
+			}
+			return nil
+		})
 	}
-
 	if shouldDeletePVs {
-		deletedSomeVolumes, err := d.cleanupVolumes(ctx, cluster)
-		if err != nil {
-			return fmt.Errorf("failed to cleanup PVs: %w", err)
-		}
-		deletedSomeResource = deletedSomeResource || deletedSomeVolumes
+		// the LB cleanup updates the cluster object, so the volume cleanup must work on its own copy
+		volumesCluster := cluster.DeepCopy()
+		cleanups = append(cleanups, func() (err error) {
+			deletedSomeVolumes, err = d.cleanupVolumes(ctx, volumesCluster)
+			if err != nil {
+				return fmt.Errorf("failed to cleanup PVs: %w", err)
+			}
+			return nil
+		})
 	}
 
+	if err := forEachConcurrently(len(cleanups), func(i int) error { return cleanups[i]() }); err != nil {
+		return err
+	}
+
+	deletedSomeResource := deletedSomeLBs || deletedSomeVolumes
+
 	// If we deleted something it is implied that there was still something left. Just return
 	// here so the finalizers stay, it will make the cluster controller requeue us after a delay
 	// This also means that we may end up issuing multiple DELETE calls against the same resource
@@ -140,14 +182,16 @@ func (d *Deletion) cleanupInClusterResources(ctx context.Context, log *zap.Sugar
 		return nil
 	}
 
-	lbsAreGone, err := d.checkIfAllLoadbalancersAreGone(ctx, cluster)
-	if err != nil {
-		return fmt.Errorf("failed to check if all Loadbalancers are gone: %w", err)
-	}
-	// Return so we check again later
-	if !lbsAreGone {
-		return nil
+	if shouldDeleteLBs {
+		lbsAreGone, err := d.checkIfAllLoadbalancersAreGone(ctx, cluster)
+		if err != nil {
+			return fmt.Errorf("failed to check if all Loadbalancers are gone: %w", err)
+		}
+		// Return so we check again later
+		if !lbsAreGone {
+			return nil
+		}
 	}
 
-	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, apiv1.InClusterLBCleanupFinalizer, apiv1.InClusterPVCleanupFinalizer)
+	return d.finishPhase(ctx, cluster, phaseInClusterResources, apiv1.InClusterLBCleanupFinalizer, apiv1.InClusterPVCleanupFinalizer)
 }
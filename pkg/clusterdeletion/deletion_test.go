@@ -18,12 +18,15 @@ package clusterdeletion
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +35,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -185,6 +189,226 @@ func TestNodesRemainUntilInClusterResourcesAreGone(t *testing.T) {
 	}
 }
 
+func TestLBCleanupTimeout(t *testing.T) {
+	testCases := []struct {
+		name             string
+		timeout          time.Duration
+		force            bool
+		expectErr        bool
+		expectFinalizer  bool
+		expectEventCount int
+	}{
+		{
+			name:            "no timeout configured",
+			expectErr:       true,
+			expectFinalizer: true,
+		},
+		{
+			name:            "timeout not yet reached",
+			timeout:         3 * time.Hour,
+			force:           true,
+			expectErr:       true,
+			expectFinalizer: true,
+		},
+		{
+			name:             "timeout reached without force",
+			timeout:          time.Hour,
+			expectErr:        true,
+			expectFinalizer:  true,
+			expectEventCount: 1,
+		},
+		{
+			name:             "timeout reached with force",
+			timeout:          time.Hour,
+			force:            true,
+			expectFinalizer:  false,
+			expectEventCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deletionTimestamp := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+			cluster := getClusterWithFinalizer("cluster", apiv1.InClusterLBCleanupFinalizer, apiv1.NodeDeletionFinalizer)
+			cluster.DeletionTimestamp = &deletionTimestamp
+
+			seedClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+			recorder := record.NewFakeRecorder(10)
+
+			// simulate an unreachable user cluster, which blocks the LoadBalancer cleanup
+			userClusterClientGetter := func() (ctrlruntimeclient.Client, error) {
+				return nil, errors.New("user cluster is unreachable")
+			}
+
+			deletion := New(seedClient, recorder, userClusterClientGetter).WithLBCleanupTimeout(tc.timeout, tc.force)
+
+			ctx := context.Background()
+			err := deletion.cleanupInClusterResources(ctx, kubermaticlog.Logger, cluster)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.expectErr, err)
+			}
+
+			updatedCluster := &kubermaticv1.Cluster{}
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: cluster.Name}, updatedCluster); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if hasFinalizer := kuberneteshelper.HasFinalizer(updatedCluster, apiv1.InClusterLBCleanupFinalizer); hasFinalizer != tc.expectFinalizer {
+				t.Errorf("Expected LoadBalancer cleanup finalizer to exist: %v, got: %v", tc.expectFinalizer, hasFinalizer)
+			}
+
+			if len(recorder.Events) != tc.expectEventCount {
+				t.Errorf("Expected %d events, got %d", tc.expectEventCount, len(recorder.Events))
+			}
+		})
+	}
+}
+
+func TestCheckIfAllLoadbalancersAreGone(t *testing.T) {
+	testCases := []struct {
+		name          string
+		events        []ctrlruntimeclient.Object
+		expectAllGone bool
+	}{
+		{
+			name:          "LoadBalancers still being removed",
+			expectAllGone: false,
+		},
+		{
+			name: "LoadBalancers removed",
+			events: []ctrlruntimeclient.Object{
+				&corev1.Event{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testNS,
+						Name:      "lb-deleted",
+					},
+					InvolvedObject: corev1.ObjectReference{UID: "lb-uid"},
+					Reason:         eventReasonDeletedLoadBalancer,
+				},
+			},
+			expectAllGone: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deletionTimestamp := metav1.Now()
+			cluster := getClusterWithFinalizer("cluster", apiv1.InClusterLBCleanupFinalizer)
+			cluster.DeletionTimestamp = &deletionTimestamp
+			cluster.Annotations = map[string]string{deletedLBAnnotationName: "lb-uid"}
+			cluster.Spec.Cloud.AWS = &kubermaticv1.AWSCloudSpec{}
+
+			seedClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+			userClusterClient := fake.NewClientBuilder().WithObjects(tc.events...).Build()
+			userClusterClientGetter := func() (ctrlruntimeclient.Client, error) {
+				return userClusterClient, nil
+			}
+
+			deletion := New(seedClient, nil, userClusterClientGetter)
+
+			allGone, err := deletion.checkIfAllLoadbalancersAreGone(context.Background(), cluster)
+			if err != nil {
+				t.Fatalf("Failed to check LoadBalancers: %v", err)
+			}
+
+			if allGone != tc.expectAllGone {
+				t.Errorf("Expected all LoadBalancers to be gone: %v, got: %v", tc.expectAllGone, allGone)
+			}
+		})
+	}
+}
+
+func TestFinishPhaseRecordsEventOnce(t *testing.T) {
+	cluster := getClusterWithFinalizer("cluster", apiv1.EtcdBackupConfigCleanupFinalizer, apiv1.NodeDeletionFinalizer)
+	seedClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+	recorder := record.NewFakeRecorder(10)
+
+	deletion := New(seedClient, recorder, nil)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		if err := deletion.finishPhase(ctx, cluster, phaseEtcdBackupConfigs, apiv1.EtcdBackupConfigCleanupFinalizer); err != nil {
+			t.Fatalf("Failed to finish phase: %v", err)
+		}
+	}
+
+	if kuberneteshelper.HasFinalizer(cluster, apiv1.EtcdBackupConfigCleanupFinalizer) {
+		t.Error("Expected finalizer to be removed")
+	}
+
+	if len(recorder.Events) != 1 {
+		t.Fatalf("Expected exactly one event, got %d", len(recorder.Events))
+	}
+
+	expected := "Normal ClusterCleanupFinished Finished cleaning up etcd backup configs"
+	if event := <-recorder.Events; event != expected {
+		t.Errorf("Expected event %q, got %q", expected, event)
+	}
+}
+
+func TestCleanupConstraints(t *testing.T) {
+	testCases := []struct {
+		name           string
+		opaIntegration *kubermaticv1.OPAIntegrationSettings
+		constraints    []ctrlruntimeclient.Object
+	}{
+		{
+			name:           "constraints are cleaned up with OPA integration enabled",
+			opaIntegration: &kubermaticv1.OPAIntegrationSettings{Enabled: true},
+			constraints:    []ctrlruntimeclient.Object{getConstraintWithFinalizer("constraint")},
+		},
+		{
+			name:           "leftover constraints are cleaned up after OPA integration was disabled",
+			opaIntegration: &kubermaticv1.OPAIntegrationSettings{Enabled: false},
+			constraints:    []ctrlruntimeclient.Object{getConstraintWithFinalizer("constraint")},
+		},
+		{
+			name:        "leftover constraints are cleaned up without OPA integration settings",
+			constraints: []ctrlruntimeclient.Object{getConstraintWithFinalizer("constraint")},
+		},
+		{
+			name: "cleanup finishes without any constraints",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := getClusterWithFinalizer("cluster", apiv1.KubermaticConstraintCleanupFinalizer)
+			cluster.Spec.OPAIntegration = tc.opaIntegration
+			cluster.Status.NamespaceName = testNS
+
+			ctx := context.Background()
+			seedClient := fake.NewClientBuilder().WithObjects(append(tc.constraints, cluster)...).Build()
+			deletion := New(seedClient, nil, nil)
+
+			if err := deletion.cleanupConstraints(ctx, cluster); err != nil {
+				t.Fatalf("Failed to clean up constraints: %v", err)
+			}
+
+			if kuberneteshelper.HasFinalizer(cluster, apiv1.KubermaticConstraintCleanupFinalizer) {
+				t.Error("Expected constraint cleanup finalizer to be removed")
+			}
+
+			for _, constraint := range tc.constraints {
+				err := seedClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(constraint), &kubermaticv1.Constraint{})
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Expected constraint %s to be deleted, got error: %v", constraint.GetName(), err)
+				}
+			}
+		})
+	}
+}
+
+func getConstraintWithFinalizer(name string) *kubermaticv1.Constraint {
+	return &kubermaticv1.Constraint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  testNS,
+			Finalizers: []string{apiv1.GatekeeperConstraintCleanupFinalizer},
+		},
+	}
+}
+
 func getClusterWithFinalizer(name string, finalizers ...string) *kubermaticv1.Cluster {
 	return &kubermaticv1.Cluster{
 		ObjectMeta: metav1.ObjectMeta{
@@ -39,6 +39,7 @@ func (d *Deletion) cleanupEtcdBackupConfigs(ctx context.Context, cluster *kuberm
 	}
 
 	if len(backupConfigs.Items) > 0 {
+		d.recordProgress(cluster, phaseEtcdBackupConfigs, "deleting %d EtcdBackupConfigs", len(backupConfigs.Items))
 		for _, backupConfig := range backupConfigs.Items {
 			if err := d.seedClient.Delete(ctx, &backupConfig); err != nil {
 				return fmt.Errorf("failed to delete EtcdBackupConfig %q: %w", backupConfig.Name, err)
@@ -48,5 +49,5 @@ func (d *Deletion) cleanupEtcdBackupConfigs(ctx context.Context, cluster *kuberm
 		return nil
 	}
 
-	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, apiv1.EtcdBackupConfigCleanupFinalizer)
+	return d.finishPhase(ctx, cluster, phaseEtcdBackupConfigs, apiv1.EtcdBackupConfigCleanupFinalizer)
 }
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterdeletion
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	eventReasonCleanupInProgress = "ClusterCleanupInProgress"
+	eventReasonCleanupFinished   = "ClusterCleanupFinished"
+
+	phaseConstraints        = "constraints"
+	phaseInClusterResources = "in-cluster resources"
+	phaseEtcdBackupConfigs  = "etcd backup configs"
+	phaseNodes              = "nodes"
+	phaseCredentials        = "credentials"
+)
+
+// recordProgress records an event about the progress of a cleanup phase, so that
+// users can follow long-running cluster deletions.
+func (d *Deletion) recordProgress(cluster *kubermaticv1.Cluster, phase string, messageFmt string, args ...interface{}) {
+	if d.recorder == nil {
+		return
+	}
+
+	d.recorder.Eventf(cluster, corev1.EventTypeNormal, eventReasonCleanupInProgress, "Cleaning up "+phase+": "+messageFmt, args...)
+}
+
+// finishPhase removes the finalizers guarding a cleanup phase and records an event
+// if the phase was not finished before.
+func (d *Deletion) finishPhase(ctx context.Context, cluster *kubermaticv1.Cluster, phase string, finalizers ...string) error {
+	pending := kuberneteshelper.HasAnyFinalizer(cluster, finalizers...)
+
+	if err := kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, finalizers...); err != nil {
+		return err
+	}
+
+	if pending && d.recorder != nil {
+		d.recorder.Eventf(cluster, corev1.EventTypeNormal, eventReasonCleanupFinished, "Finished cleaning up %s", phase)
+	}
+
+	return nil
+}
@@ -24,7 +24,9 @@ import (
 
 	"go.uber.org/zap"
 
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -36,8 +38,39 @@ import (
 
 const (
 	eventReasonDeletedLoadBalancer = "DeletedLoadBalancer"
+	eventReasonLBCleanupTimedOut   = "LoadBalancerCleanupTimedOut"
 )
 
+// lbCleanupTimedOut returns true if a LoadBalancer cleanup timeout is configured and the
+// cluster has been in deletion for longer than that.
+func (d *Deletion) lbCleanupTimedOut(cluster *kubermaticv1.Cluster, now time.Time) bool {
+	if d.lbCleanupTimeout <= 0 || cluster.DeletionTimestamp == nil {
+		return false
+	}
+
+	return cluster.DeletionTimestamp.Add(d.lbCleanupTimeout).Before(now)
+}
+
+// handleLBCleanupTimeout records that the LoadBalancer cleanup is stuck and, if configured, removes
+// the InClusterLBCleanupFinalizer. It returns true if the finalizer was removed.
+func (d *Deletion) handleLBCleanupTimeout(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (bool, error) {
+	if !d.forceLBCleanup {
+		d.recorder.Eventf(cluster, corev1.EventTypeWarning, eventReasonLBCleanupTimedOut,
+			"LoadBalancers have not been cleaned up within %v, LoadBalancers of the cluster might need to be removed manually.", d.lbCleanupTimeout)
+		return false, nil
+	}
+
+	log.Warnw("LoadBalancer cleanup timed out, removing finalizer", "finalizer", apiv1.InClusterLBCleanupFinalizer, "timeout", d.lbCleanupTimeout)
+	d.recorder.Eventf(cluster, corev1.EventTypeWarning, eventReasonLBCleanupTimedOut,
+		"LoadBalancers have not been cleaned up within %v, skipping their cleanup. LoadBalancers at the cloud provider might have been leaked.", d.lbCleanupTimeout)
+
+	if err := kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, apiv1.InClusterLBCleanupFinalizer); err != nil {
+		return false, fmt.Errorf("failed to remove LoadBalancer cleanup finalizer: %w", err)
+	}
+
+	return true, nil
+}
+
 func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (deletedSomeLBs bool, err error) {
 	log = log.Named("lb-cleanup")
 	log.Debug("Cleaning up LoadBalancers...")
@@ -52,6 +85,7 @@ func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, clust
 		return false, fmt.Errorf("failed to list Service's from user cluster: %w", err)
 	}
 
+	var deletedLBs int
 	for _, service := range serviceList.Items {
 		serviceName := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
 		slog := log.With("service", serviceName)
@@ -66,6 +100,11 @@ func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, clust
 			return deletedSomeLBs, fmt.Errorf("failed to delete service %q inside user cluster: %w", serviceName, err)
 		}
 		deletedSomeLBs = true
+		deletedLBs++
+	}
+
+	if deletedLBs > 0 {
+		d.recordProgress(cluster, phaseInClusterResources, "deleted %d LoadBalancer Services", deletedLBs)
 	}
 
 	return deletedSomeLBs, nil
@@ -159,7 +198,12 @@ func (d *Deletion) checkIfAllLoadbalancersAreGone(ctx context.Context, cluster *
 		return false, fmt.Errorf("failed to update cluster: %w", err)
 	}
 
-	return deletedLoadBalancers.Len() > 0, nil
+	if remaining := deletedLoadBalancers.Len(); remaining > 0 {
+		d.recordProgress(cluster, phaseInClusterResources, "waiting for the cloud provider to remove %d LoadBalancers", remaining)
+		return false, nil
+	}
+
+	return true, nil
 }
 
 func parseStringSet(list string) sets.String {
@@ -48,9 +48,10 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 	}
 
 	// If we delete a cluster, we should disable the eviction on the nodes
-	for _, node := range nodes.Items {
+	if err := forEachConcurrently(len(nodes.Items), func(i int) error {
+		node := &nodes.Items[i]
 		if node.Annotations[eviction.SkipEvictionAnnotationKey] == "true" {
-			continue
+			return nil
 		}
 
 		oldNode := node.DeepCopy()
@@ -58,9 +59,12 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 			node.Annotations = map[string]string{}
 		}
 		node.Annotations[eviction.SkipEvictionAnnotationKey] = "true"
-		if err := userClusterClient.Patch(ctx, &node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
+		if err := userClusterClient.Patch(ctx, node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
 			return fmt.Errorf("failed to add the annotation '%s=true' to node '%s': %w", eviction.SkipEvictionAnnotationKey, node.Name, err)
 		}
+		return nil
+	}); err != nil {
+		return err
 	}
 
 	machineDeploymentList := &clusterv1alpha1.MachineDeploymentList{}
@@ -69,11 +73,16 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 		return fmt.Errorf("failed to list MachineDeployments: %w", err)
 	}
 	if len(machineDeploymentList.Items) > 0 {
+		d.recordProgress(cluster, phaseNodes, "deleting %d MachineDeployments, %d Nodes remaining", len(machineDeploymentList.Items), len(nodes.Items))
 		// TODO: Use DeleteCollection once https://github.com/kubernetes-sigs/controller-runtime/issues/344 is resolved
-		for _, machineDeployment := range machineDeploymentList.Items {
-			if err := userClusterClient.Delete(ctx, &machineDeployment); err != nil {
+		if err := forEachConcurrently(len(machineDeploymentList.Items), func(i int) error {
+			machineDeployment := &machineDeploymentList.Items[i]
+			if err := userClusterClient.Delete(ctx, machineDeployment); err != nil {
 				return fmt.Errorf("failed to delete MachineDeployment %q: %w", machineDeployment.Name, err)
 			}
+			return nil
+		}); err != nil {
+			return err
 		}
 		// Return here to make sure we don't attempt to delete MachineSets until the MachineDeployment is actually gone
 		return nil
@@ -84,11 +93,16 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 		return fmt.Errorf("failed to list MachineSets: %w", err)
 	}
 	if len(machineSetList.Items) > 0 {
+		d.recordProgress(cluster, phaseNodes, "deleting %d MachineSets, %d Nodes remaining", len(machineSetList.Items), len(nodes.Items))
 		// TODO: Use DeleteCollection once https://github.com/kubernetes-sigs/controller-runtime/issues/344 is resolved
-		for _, machineSet := range machineSetList.Items {
-			if err := userClusterClient.Delete(ctx, &machineSet); err != nil {
+		if err := forEachConcurrently(len(machineSetList.Items), func(i int) error {
+			machineSet := &machineSetList.Items[i]
+			if err := userClusterClient.Delete(ctx, machineSet); err != nil {
 				return fmt.Errorf("failed to delete MachineSet %q: %w", machineSet.Name, err)
 			}
+			return nil
+		}); err != nil {
+			return err
 		}
 		// Return here to make sure we don't attempt to delete Machines until the MachineSet is actually gone
 		return nil
@@ -99,15 +113,20 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 		return fmt.Errorf("failed to get Machines: %w", err)
 	}
 	if len(machineList.Items) > 0 {
+		d.recordProgress(cluster, phaseNodes, "deleting %d Machines, %d Nodes remaining", len(machineList.Items), len(nodes.Items))
 		// TODO: Use DeleteCollection once https://github.com/kubernetes-sigs/controller-runtime/issues/344 is resolved
-		for _, machine := range machineList.Items {
-			if err := userClusterClient.Delete(ctx, &machine); err != nil {
+		if err := forEachConcurrently(len(machineList.Items), func(i int) error {
+			machine := &machineList.Items[i]
+			if err := userClusterClient.Delete(ctx, machine); err != nil {
 				return fmt.Errorf("failed to delete Machine %q: %w", machine.Name, err)
 			}
+			return nil
+		}); err != nil {
+			return err
 		}
 
 		return nil
 	}
 
-	return kuberneteshelper.TryRemoveFinalizer(ctx, d.seedClient, cluster, apiv1.NodeDeletionFinalizer)
+	return d.finishPhase(ctx, cluster, phaseNodes, apiv1.NodeDeletionFinalizer)
 }
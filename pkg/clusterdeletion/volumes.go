@@ -78,14 +78,24 @@ func (d *Deletion) cleanupVolumes(ctx context.Context, cluster *kubermaticv1.Clu
 	}
 
 	// Delete PVC's
-	for _, pvc := range pvcList.Items {
-		if err := userClusterClient.Delete(ctx, &pvc); err != nil && !apierrors.IsNotFound(err) {
-			return deletedSomeResource, fmt.Errorf("failed to delete PVC '%s/%s' from user cluster: %w", pvc.Namespace, pvc.Name, err)
+	if err := forEachConcurrently(len(pvcList.Items), func(i int) error {
+		pvc := &pvcList.Items[i]
+		if err := userClusterClient.Delete(ctx, pvc); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete PVC '%s/%s' from user cluster: %w", pvc.Namespace, pvc.Name, err)
 		}
+		return nil
+	}); err != nil {
+		return true, err
+	}
+
+	if len(pvcList.Items) > 0 {
 		deletedSomeResource = true
+		d.recordProgress(cluster, phaseInClusterResources, "deleted %d PersistentVolumeClaims", len(pvcList.Items))
 	}
 
 	if len(pvList.Items) > 0 {
+		d.recordProgress(cluster, phaseInClusterResources, "waiting for %d PersistentVolumes to be released", len(pvList.Items))
+
 		// We don't delete PVs but we want to wait for provisioners to cleanup dynamically provisioned PVs
 		// pretend we need to requeue to avoid removing finalizer prematurely
 		deletedSomeResource = true
@@ -99,7 +109,7 @@ func (d *Deletion) disablePVCreation(ctx context.Context, userClusterClient ctrl
 	creatorGetters := []reconciling.NamedValidatingWebhookConfigurationCreatorGetter{
 		creationPreventingWebhook("", []string{"persistentvolumes", "persistentvolumeclaims"}),
 	}
-	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, creatorGetters, "", userClusterClient); err != nil {
+	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, creatorGetters, userClusterClient); err != nil {
 		return fmt.Errorf("failed to create ValidatingWebhookConfiguration to prevent creation of PVs/PVCs: %w", err)
 	}
 
@@ -120,13 +130,13 @@ func (d *Deletion) cleanupPVCUsingPods(ctx context.Context, userClusterClient ct
 		}
 	}
 
-	for _, pod := range pvUsingPods {
+	return forEachConcurrently(len(pvUsingPods), func(i int) error {
+		pod := pvUsingPods[i]
 		if err := userClusterClient.Delete(ctx, pod); err != nil && !apierrors.IsNotFound(err) {
 			return fmt.Errorf("failed to delete pod %s/%s: %w", pod.Namespace, pod.Name, err)
 		}
-	}
-
-	return nil
+		return nil
+	})
 }
 
 func podUsesPV(p *corev1.Pod) bool {
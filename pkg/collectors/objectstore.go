@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+// listPageSize is the number of objects requested per page when listing a bucket.
+const listPageSize = 1000
+
+// ObjectStore gives read access to the buckets of an object store. Objects are returned as
+// minio.ObjectInfo regardless of the backend, so the metrics can be computed the same way.
+type ObjectStore interface {
+	// BucketExists checks whether the bucket exists. It is a cheap call that can be
+	// used to determine whether the object store is reachable.
+	BucketExists(ctx context.Context, bucket string) (bool, error)
+
+	// ListObjects lists the objects page by page, so that large buckets do not
+	// have to be returned in a single response. Pages are requested until the
+	// store reports that the listing is no longer truncated.
+	ListObjects(ctx context.Context, bucket string) ([]minio.ObjectInfo, error)
+}
+
+type minioObjectStore struct {
+	client *minio.Client
+}
+
+// NewMinioObjectStore returns an ObjectStore for S3-compatible object stores.
+func NewMinioObjectStore(client *minio.Client) ObjectStore {
+	return &minioObjectStore{client: client}
+}
+
+func (l *minioObjectStore) BucketExists(ctx context.Context, bucket string) (bool, error) {
+	return l.client.BucketExists(ctx, bucket)
+}
+
+// ListObjects uses ListObjectsV2, which sends the NextContinuationToken of each
+// page with the next request for as long as IsTruncated is set.
+func (l *minioObjectStore) ListObjects(ctx context.Context, bucket string) ([]minio.ObjectInfo, error) {
+	listOpts := minio.ListObjectsOptions{
+		Recursive: true,
+		MaxKeys:   listPageSize,
+	}
+
+	var objects []minio.ObjectInfo
+	for listerObject := range l.client.ListObjects(ctx, bucket, listOpts) {
+		if listerObject.Err != nil {
+			return nil, fmt.Errorf("error on object %q: %w", listerObject.Key, listerObject.Err)
+		}
+		objects = append(objects, listerObject)
+	}
+
+	return objects, nil
+}
+
+type gcsObjectStore struct {
+	service *storage.Service
+}
+
+// NewGCSObjectStore returns an ObjectStore for Google Cloud Storage.
+func NewGCSObjectStore(service *storage.Service) ObjectStore {
+	return &gcsObjectStore{service: service}
+}
+
+func (l *gcsObjectStore) BucketExists(ctx context.Context, bucket string) (bool, error) {
+	_, err := l.service.Buckets.Get(bucket).Fields("name").Context(ctx).Do()
+	if err != nil {
+		var apiErr *googleapi.Error
+		if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (l *gcsObjectStore) ListObjects(ctx context.Context, bucket string) ([]minio.ObjectInfo, error) {
+	var objects []minio.ObjectInfo
+
+	call := l.service.Objects.List(bucket).MaxResults(listPageSize).Fields("nextPageToken", googleapi.Field("items(name,size,updated)"))
+	err := call.Pages(ctx, func(page *storage.Objects) error {
+		for _, item := range page.Items {
+			lastModified, err := time.Parse(time.RFC3339, item.Updated)
+			if err != nil {
+				return fmt.Errorf("invalid modification time on object %q: %w", item.Name, err)
+			}
+
+			objects = append(objects, minio.ObjectInfo{
+				Key:          item.Name,
+				Size:         int64(item.Size),
+				LastModified: lastModified,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
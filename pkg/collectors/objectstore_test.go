@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+func TestMinioObjectStoreListObjectsFollowsContinuationTokens(t *testing.T) {
+	pages := map[string]string{
+		"":       `<Contents><Key>a</Key><Size>1</Size></Contents><IsTruncated>true</IsTruncated><NextContinuationToken>page-2</NextContinuationToken>`,
+		"page-2": `<Contents><Key>b</Key><Size>2</Size></Contents><IsTruncated>true</IsTruncated><NextContinuationToken>page-3</NextContinuationToken>`,
+		"page-3": `<Contents><Key>c</Key><Size>3</Size></Contents><IsTruncated>false</IsTruncated>`,
+	}
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		page, ok := pages[r.URL.Query().Get("continuation-token")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?><ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>backups</Name>%s</ListBucketResult>`, page)
+	}))
+	defer server.Close()
+
+	client, err := minio.New(strings.TrimPrefix(server.URL, "http://"), &minio.Options{
+		Creds:  credentials.NewStaticV4("access-key", "secret-key", ""),
+		Region: "us-east-1",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	objects, err := NewMinioObjectStore(client).ListObjects(context.Background(), "backups")
+	if err != nil {
+		t.Fatalf("Failed to list objects: %v", err)
+	}
+
+	var keys []string
+	for _, object := range objects {
+		keys = append(keys, object.Key)
+	}
+
+	if got := strings.Join(keys, ","); got != "a,b,c" {
+		t.Errorf("Expected objects a,b,c, got %s", got)
+	}
+
+	if requests != len(pages) {
+		t.Errorf("Expected %d list requests, got %d", len(pages), requests)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/prometheus/client_golang/prometheus"
@@ -30,41 +31,58 @@ import (
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// backupSizeBuckets are the histogram buckets for backup object sizes, ranging from 1 MiB to 16 GiB.
+var backupSizeBuckets = prometheus.ExponentialBuckets(1<<20, 4, 8)
+
 type s3Collector struct {
 	ObjectCount            *prometheus.Desc
 	ObjectLastModifiedDate *prometheus.Desc
 	EmptyObjectCount       *prometheus.Desc
 	QuerySuccess           *prometheus.Desc
+	LastBackupAge          *prometheus.Desc
+	BackupSize             *prometheus.Desc
 	client                 ctrlruntimeclient.Reader
 	bucket                 string
-	minioClient            *minio.Client
+	objectStore            ObjectStore
 	logger                 *zap.SugaredLogger
 }
 
-// MustRegisterS3Collector registers the S3 collector.
-func MustRegisterS3Collector(minioClient *minio.Client, client ctrlruntimeclient.Reader, bucket string, logger *zap.SugaredLogger) {
+// MustRegisterS3Collector registers the S3 collector for the given bucket. It can be called once
+// per bucket, all metrics are labeled with the bucket name. Despite its name, the collector works
+// with any object store the given ObjectStore supports.
+func MustRegisterS3Collector(objectStore ObjectStore, client ctrlruntimeclient.Reader, bucket string, logger *zap.SugaredLogger) {
 	collector := s3Collector{}
-	collector.minioClient = minioClient
+	collector.objectStore = objectStore
 	collector.client = client
 	collector.bucket = bucket
 	collector.logger = logger
 
+	bucketLabel := prometheus.Labels{"bucket": bucket}
+
 	collector.ObjectCount = prometheus.NewDesc(
 		"kubermatic_s3_object_count",
 		"The amount of objects partitioned by cluster",
-		[]string{"cluster"}, nil)
+		[]string{"cluster"}, bucketLabel)
 	collector.ObjectLastModifiedDate = prometheus.NewDesc(
 		"kubermatic_s3_object_last_modified_time_seconds",
 		"Modification time of the last modified object",
-		[]string{"cluster"}, nil)
+		[]string{"cluster"}, bucketLabel)
 	collector.EmptyObjectCount = prometheus.NewDesc(
 		"kubermatic_s3_empty_object_count",
 		"The amount of empty objects (size=0) partitioned by cluster",
-		[]string{"cluster"}, nil)
+		[]string{"cluster"}, bucketLabel)
 	collector.QuerySuccess = prometheus.NewDesc(
 		"kubermatic_s3_query_success",
 		"Whether querying the S3 was successful",
-		nil, nil)
+		nil, bucketLabel)
+	collector.LastBackupAge = prometheus.NewDesc(
+		"kkp_s3_last_backup_age_seconds",
+		"The age of the most recent backup object partitioned by cluster",
+		[]string{"cluster"}, bucketLabel)
+	collector.BackupSize = prometheus.NewDesc(
+		"kkp_s3_backup_size_bytes",
+		"The size of backup objects partitioned by cluster",
+		[]string{"cluster"}, bucketLabel)
 
 	prometheus.MustRegister(&collector)
 }
@@ -74,6 +92,8 @@ func (e *s3Collector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- e.ObjectLastModifiedDate
 	ch <- e.EmptyObjectCount
 	ch <- e.QuerySuccess
+	ch <- e.LastBackupAge
+	ch <- e.BackupSize
 }
 
 func (e *s3Collector) Collect(ch chan<- prometheus.Metric) {
@@ -87,22 +107,14 @@ func (e *s3Collector) Collect(ch chan<- prometheus.Metric) {
 		return
 	}
 
-	logger := e.logger.With("bucket", e.bucket)
-	listOpts := minio.ListObjectsOptions{
-		Recursive: true,
-	}
-
-	var objects []minio.ObjectInfo
-	for listerObject := range e.minioClient.ListObjects(context.Background(), e.bucket, listOpts) {
-		if listerObject.Err != nil {
-			logger.Errorw("Error on object", "object", listerObject.Key, zap.Error(listerObject.Err))
-			ch <- prometheus.MustNewConstMetric(
-				e.QuerySuccess,
-				prometheus.GaugeValue,
-				float64(1))
-			return
-		}
-		objects = append(objects, listerObject)
+	objects, err := e.objectStore.ListObjects(context.Background(), e.bucket)
+	if err != nil {
+		e.logger.Errorw("Failed to list objects", "bucket", e.bucket, zap.Error(err))
+		ch <- prometheus.MustNewConstMetric(
+			e.QuerySuccess,
+			prometheus.GaugeValue,
+			float64(1))
+		return
 	}
 
 	for _, cluster := range clusterList.Items {
@@ -133,4 +145,43 @@ func (e *s3Collector) setMetricsForCluster(ch chan<- prometheus.Metric, allObjec
 		prometheus.GaugeValue,
 		float64(getEmptyObjectCount(clusterObjects)),
 		clusterName)
+
+	// without any backups there is no meaningful age, so the metric is left out
+	if lastModified := getLastModifiedTimestamp(clusterObjects); !lastModified.IsZero() {
+		ch <- prometheus.MustNewConstMetric(
+			e.LastBackupAge,
+			prometheus.GaugeValue,
+			time.Since(lastModified).Seconds(),
+			clusterName)
+	}
+
+	count, sum, buckets := getSizeHistogram(clusterObjects, backupSizeBuckets)
+	ch <- prometheus.MustNewConstHistogram(
+		e.BackupSize,
+		count,
+		sum,
+		buckets,
+		clusterName)
+}
+
+// getSizeHistogram returns the sample count, sum and cumulative bucket counts of the object sizes.
+func getSizeHistogram(objects []minio.ObjectInfo, upperBounds []float64) (count uint64, sum float64, buckets map[float64]uint64) {
+	buckets = make(map[float64]uint64, len(upperBounds))
+	for _, bound := range upperBounds {
+		buckets[bound] = 0
+	}
+
+	for _, object := range objects {
+		size := float64(object.Size)
+		count++
+		sum += size
+
+		for _, bound := range upperBounds {
+			if size <= bound {
+				buckets[bound]++
+			}
+		}
+	}
+
+	return count, sum, buckets
 }
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/minio/minio-go/v7"
+)
+
+func TestGetSizeHistogram(t *testing.T) {
+	upperBounds := []float64{10, 100, 1000}
+
+	tests := []struct {
+		name            string
+		sizes           []int64
+		expectedCount   uint64
+		expectedSum     float64
+		expectedBuckets map[float64]uint64
+	}{
+		{
+			name:            "no objects",
+			expectedBuckets: map[float64]uint64{10: 0, 100: 0, 1000: 0},
+		},
+		{
+			name:            "buckets are cumulative",
+			sizes:           []int64{5, 10, 50, 500},
+			expectedCount:   4,
+			expectedSum:     565,
+			expectedBuckets: map[float64]uint64{10: 2, 100: 3, 1000: 4},
+		},
+		{
+			name:            "objects above the largest bound are only counted",
+			sizes:           []int64{1, 5000},
+			expectedCount:   2,
+			expectedSum:     5001,
+			expectedBuckets: map[float64]uint64{10: 1, 100: 1, 1000: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var objects []minio.ObjectInfo
+			for _, size := range test.sizes {
+				objects = append(objects, minio.ObjectInfo{Size: size})
+			}
+
+			count, sum, buckets := getSizeHistogram(objects, upperBounds)
+			if count != test.expectedCount {
+				t.Errorf("Expected count %d, got %d", test.expectedCount, count)
+			}
+			if sum != test.expectedSum {
+				t.Errorf("Expected sum %v, got %v", test.expectedSum, sum)
+			}
+			if diff := deep.Equal(buckets, test.expectedBuckets); diff != nil {
+				t.Errorf("Buckets differ from expected: %v", diff)
+			}
+		})
+	}
+}
@@ -29,7 +29,6 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 
 	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -118,7 +117,7 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, requ
 	}
 
 	err := r.syncAllSeeds(log, clusterTemplate, func(seedClient ctrlruntimeclient.Client, template *kubermaticv1.ClusterTemplate) error {
-		return reconciling.ReconcileKubermaticV1ClusterTemplates(ctx, clusterTemplateCreatorGetters, "", seedClient)
+		return reconciling.ReconcileKubermaticV1ClusterTemplates(ctx, clusterTemplateCreatorGetters, seedClient)
 	})
 	if err != nil {
 		r.recorder.Eventf(clusterTemplate, corev1.EventTypeWarning, "ReconcilingError", err.Error())
@@ -130,13 +129,7 @@ func (r *reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, requ
 func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger, template *kubermaticv1.ClusterTemplate) error {
 	if kuberneteshelper.HasFinalizer(template, apiv1.ClusterTemplateSeedCleanupFinalizer) {
 		if err := r.syncAllSeeds(log, template, func(seedClient ctrlruntimeclient.Client, template *kubermaticv1.ClusterTemplate) error {
-			err := seedClient.Delete(ctx, &kubermaticv1.ClusterTemplate{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: template.Name,
-				},
-			})
-
-			return ctrlruntimeclient.IgnoreNotFound(err)
+			return reconciling.DeleteKubermaticV1ClusterTemplates(ctx, []string{template.Name}, seedClient)
 		}); err != nil {
 			return err
 		}
@@ -148,13 +141,7 @@ func (r *reconciler) handleDeletion(ctx context.Context, log *zap.SugaredLogger,
 
 	if kuberneteshelper.HasFinalizer(template, apiv1.CredentialsSecretsCleanupFinalizer) {
 		if err := r.syncAllSeeds(log, template, func(seedClient ctrlruntimeclient.Client, template *kubermaticv1.ClusterTemplate) error {
-			err := seedClient.Delete(ctx, &corev1.Secret{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      template.Credential,
-					Namespace: resources.KubermaticNamespace,
-				},
-			})
-			return ctrlruntimeclient.IgnoreNotFound(err)
+			return reconciling.DeleteSecrets(ctx, []string{template.Credential}, resources.KubermaticNamespace, seedClient)
 		}); err != nil {
 			return err
 		}
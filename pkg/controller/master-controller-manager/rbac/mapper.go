@@ -526,8 +526,10 @@ func generateVerbsForNamedResource(groupName, resourceKind string) ([]string, er
 	if strings.HasPrefix(groupName, ViewerGroupNamePrefix) && resourceKind == kubermaticv1.UserKindName {
 		return nil, nil
 	}
+	// viewers have read-only access; since the rules are restricted to the named resource,
+	// list and watch only return this very resource
 	if strings.HasPrefix(groupName, ViewerGroupNamePrefix) {
-		return []string{"get"}, nil
+		return []string{"get", "list", "watch"}, nil
 	}
 
 	// verbs for projectmanagers
@@ -585,6 +587,10 @@ func generateVerbsForResource(groupName, resourceKind string) ([]string, error)
 	// verbs for readers
 	//
 	// viewers cannot create resources
+	//
+	// note: viewers are intentionally not granted "list" or "watch" here, the generated ClusterRole is
+	// not scoped to a project, so it would expose the resources of all projects. Read access for viewers
+	// (get, list and watch) is granted per named resource, see generateVerbsForNamedResource.
 	if strings.HasPrefix(groupName, ViewerGroupNamePrefix) {
 		return nil, nil
 	}
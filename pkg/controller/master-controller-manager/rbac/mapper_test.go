@@ -45,7 +45,7 @@ func TestGenerateVerbsForNamedResources(t *testing.T) {
 		{
 			name:          "scenario 3: viewers of a project can view any named resource",
 			groupName:     "viewers-projectID",
-			expectedVerbs: []string{"get"},
+			expectedVerbs: []string{"get", "list", "watch"},
 			resourceKind:  "",
 		},
 		{
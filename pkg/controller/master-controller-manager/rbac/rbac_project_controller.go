@@ -24,6 +24,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -44,6 +45,7 @@ type projectController struct {
 	metrics      *Metrics
 
 	log              *zap.SugaredLogger
+	recorder         record.EventRecorder
 	projectResources []projectResource
 	client           ctrlruntimeclient.Client
 	restMapper       meta.RESTMapper
@@ -64,6 +66,7 @@ func newProjectRBACController(ctx context.Context, metrics *Metrics, mgr manager
 	c := &projectController{
 		projectQueue:     workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "rbac_generator_for_project"),
 		log:              log,
+		recorder:         mgr.GetEventRecorderFor("rbac_generator_for_project"),
 		metrics:          metrics,
 		projectResources: resources,
 		client:           mgr.GetClient(),
@@ -25,11 +25,14 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -61,6 +64,15 @@ func (c *projectController) sync(ctx context.Context, key ctrlruntimeclient.Obje
 		}
 	}
 
+	if err := c.reconcile(ctx, project); err != nil {
+		c.recorder.Event(project, corev1.EventTypeWarning, "ReconcilingError", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (c *projectController) reconcile(ctx context.Context, project *kubermaticv1.Project) error {
 	if err := c.ensureCleanupFinalizerExists(ctx, project); err != nil {
 		return fmt.Errorf("failed to ensure that the cleanup finalizer exists on the project: %w", err)
 	}
@@ -135,6 +147,11 @@ func (c *projectController) ensureClusterRBACRoleForResources(ctx context.Contex
 }
 
 func (c *projectController) ensureClusterRBACRoleBindingForResources(ctx context.Context, projectName string) error {
+	// collect the desired bindings for the master and the seed clusters first, so that
+	// every binding is read and written at most once per cluster during a single sync
+	masterBindings := map[string]*rbacv1.ClusterRoleBinding{}
+	seedBindings := map[string]*rbacv1.ClusterRoleBinding{}
+
 	for _, projectResource := range c.projectResources {
 		if len(projectResource.namespace) > 0 {
 			continue
@@ -155,30 +172,39 @@ func (c *projectController) ensureClusterRBACRoleBindingForResources(ctx context
 				return err
 			}
 
+			// binding names are unique per resource and group, so no binding is generated twice
+			generatedClusterRoleBinding := generateClusterRBACRoleBindingForResource(rmapping.Resource.Resource, groupName)
 			if projectResource.destination == destinationSeed {
-				for _, seedClusterRESTClient := range c.seedClientMap {
-					err := ensureClusterRBACRoleBindingForResource(
-						ctx,
-						seedClusterRESTClient,
-						groupName,
-						rmapping.Resource.Resource)
-					if err != nil {
-						return err
-					}
-				}
+				seedBindings[generatedClusterRoleBinding.Name] = generatedClusterRoleBinding
 			} else {
-				err := ensureClusterRBACRoleBindingForResource(
-					ctx,
-					c.client,
-					groupName,
-					rmapping.Resource.Resource)
-				if err != nil {
-					return err
-				}
+				masterBindings[generatedClusterRoleBinding.Name] = generatedClusterRoleBinding
 			}
 		}
 	}
-	return nil
+
+	// a failing seed must not prevent the bindings on the remaining clusters from being reconciled
+	var errs []error
+	for _, seedName := range sets.StringKeySet(c.seedClientMap).List() {
+		if err := ensureClusterRBACRoleBindings(ctx, c.seedClientMap[seedName], seedBindings); err != nil {
+			errs = append(errs, fmt.Errorf("seed %q: %w", seedName, err))
+		}
+	}
+
+	if err := ensureClusterRBACRoleBindings(ctx, c.client, masterBindings); err != nil {
+		errs = append(errs, fmt.Errorf("master: %w", err))
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+func ensureClusterRBACRoleBindings(ctx context.Context, c ctrlruntimeclient.Client, bindings map[string]*rbacv1.ClusterRoleBinding) error {
+	var errs []error
+	for _, name := range sets.StringKeySet(bindings).List() {
+		if err := ensureClusterRBACRoleBinding(ctx, c, bindings[name].DeepCopy()); err != nil {
+			errs = append(errs, fmt.Errorf("ClusterRoleBinding %q: %w", name, err))
+		}
+	}
+	return kerrors.NewAggregate(errs)
 }
 
 func ensureClusterRBACRoleForResource(ctx context.Context, log *zap.SugaredLogger, c ctrlruntimeclient.Client, groupName, resource, kind string) error {
@@ -210,9 +236,9 @@ func ensureClusterRBACRoleForResource(ctx context.Context, log *zap.SugaredLogge
 	return c.Update(ctx, existingClusterRole)
 }
 
-func ensureClusterRBACRoleBindingForResource(ctx context.Context, c ctrlruntimeclient.Client, groupName, resource string) error {
-	generatedClusterRoleBinding := generateClusterRBACRoleBindingForResource(resource, groupName)
-
+// ensureClusterRBACRoleBinding creates the given binding or adds its subjects to the existing
+// binding. The existing binding is only updated if subjects are actually missing.
+func ensureClusterRBACRoleBinding(ctx context.Context, c ctrlruntimeclient.Client, generatedClusterRoleBinding *rbacv1.ClusterRoleBinding) error {
 	var sharedExistingClusterRoleBinding rbacv1.ClusterRoleBinding
 	key := types.NamespacedName{Name: generatedClusterRoleBinding.Name}
 	if err := c.Get(ctx, key, &sharedExistingClusterRoleBinding); err != nil {
@@ -223,21 +249,7 @@ func ensureClusterRBACRoleBindingForResource(ctx context.Context, c ctrlruntimec
 		return err
 	}
 
-	subjectsToAdd := []rbacv1.Subject{}
-
-	for _, generatedRoleBindingSubject := range generatedClusterRoleBinding.Subjects {
-		shouldAdd := true
-		for _, existingRoleBindingSubject := range sharedExistingClusterRoleBinding.Subjects {
-			if equality.Semantic.DeepEqual(existingRoleBindingSubject, generatedRoleBindingSubject) {
-				shouldAdd = false
-				break
-			}
-		}
-		if shouldAdd {
-			subjectsToAdd = append(subjectsToAdd, generatedRoleBindingSubject)
-		}
-	}
-
+	subjectsToAdd := missingSubjects(sharedExistingClusterRoleBinding.Subjects, generatedClusterRoleBinding.Subjects)
 	if len(subjectsToAdd) == 0 {
 		return nil
 	}
@@ -247,6 +259,26 @@ func ensureClusterRBACRoleBindingForResource(ctx context.Context, c ctrlruntimec
 	return c.Update(ctx, existingClusterRoleBinding)
 }
 
+// missingSubjects returns all desired subjects that are not contained in existing.
+func missingSubjects(existing, desired []rbacv1.Subject) []rbacv1.Subject {
+	missing := []rbacv1.Subject{}
+
+	for _, desiredSubject := range desired {
+		found := false
+		for _, existingSubject := range existing {
+			if equality.Semantic.DeepEqual(existingSubject, desiredSubject) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, desiredSubject)
+		}
+	}
+
+	return missing
+}
+
 func (c *projectController) ensureRBACRoleForResources(ctx context.Context) error {
 	for _, projectResource := range c.projectResources {
 		if len(projectResource.namespace) == 0 {
@@ -18,6 +18,7 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"strconv"
 	"testing"
@@ -2219,3 +2220,60 @@ func TestEnsureProjectCleanUpForRoleBindings(t *testing.T) {
 		})
 	}
 }
+
+// failingClient is a client whose reads always fail, used to simulate an unreachable seed.
+type failingClient struct {
+	ctrlruntimeclient.Client
+}
+
+func (c *failingClient) Get(_ context.Context, _ ctrlruntimeclient.ObjectKey, _ ctrlruntimeclient.Object) error {
+	return errors.New("seed is unreachable")
+}
+
+func TestEnsureProjectClusterRBACRoleBindingForResourcesAggregatesErrors(t *testing.T) {
+	ctx := context.Background()
+
+	fakeMasterClient := fakectrlruntimeclient.NewClientBuilder().Build()
+	healthySeedClient := fakectrlruntimeclient.NewClientBuilder().Build()
+
+	target := projectController{
+		client:     fakeMasterClient,
+		restMapper: getFakeRestMapper(t),
+		seedClientMap: map[string]ctrlruntimeclient.Client{
+			"broken":  &failingClient{},
+			"healthy": healthySeedClient,
+		},
+		projectResources: []projectResource{
+			{
+				object: &kubermaticv1.Cluster{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+						Kind:       kubermaticv1.ClusterKindName,
+					},
+				},
+				destination: destinationSeed,
+			},
+			{
+				object: &kubermaticv1.UserSSHKey{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+						Kind:       kubermaticv1.SSHKeyKind,
+					},
+				},
+			},
+		},
+		log: zap.NewNop().Sugar(),
+	}
+
+	err := target.ensureClusterRBACRoleBindingForResources(ctx, "thunderball")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `seed "broken"`)
+
+	var seedBindings rbacv1.ClusterRoleBindingList
+	assert.NoError(t, healthySeedClient.List(ctx, &seedBindings))
+	assert.Len(t, seedBindings.Items, 2, "bindings on the healthy seed must still be reconciled")
+
+	var masterBindings rbacv1.ClusterRoleBindingList
+	assert.NoError(t, fakeMasterClient.List(ctx, &masterBindings))
+	assert.Len(t, masterBindings.Items, 2, "bindings on the master must still be reconciled")
+}
@@ -133,7 +133,7 @@ func TestSyncProjectResourcesClusterWide(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{kubermaticv1.ClusterResourceName},
 							ResourceNames: []string{"abcd"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -156,7 +156,7 @@ func TestSyncProjectResourcesClusterWide(t *testing.T) {
 							APIGroups:     []string{""},
 							Resources:     []string{"configmaps"},
 							ResourceNames: []string{"cluster-abcd-ca-bundle"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -407,7 +407,7 @@ func TestSyncProjectResourcesClusterWide(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{kubermaticv1.SSHKeyResourceName},
 							ResourceNames: []string{"abcd"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -754,7 +754,7 @@ func TestSyncProjectResourcesClusterWide(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{kubermaticv1.ExternalClusterResourceName},
 							ResourceNames: []string{"abcd"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -1863,7 +1863,7 @@ func TestEnsureProjectClusterRBACRoleForNamedResource(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{"projects"},
 							ResourceNames: []string{"thunderball"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -1935,7 +1935,7 @@ func TestEnsureProjectClusterRBACRoleForNamedResource(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{"projects"},
 							ResourceNames: []string{"thunderball"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -2023,7 +2023,7 @@ func TestEnsureProjectClusterRBACRoleForNamedResource(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{"projects"},
 							ResourceNames: []string{"thunderball"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -2139,7 +2139,7 @@ func TestEnsureProjectClusterRBACRoleForNamedResource(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{"projects"},
 							ResourceNames: []string{"thunderball"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -2246,7 +2246,7 @@ func TestEnsureProjectClusterRBACRoleForNamedResource(t *testing.T) {
 							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
 							Resources:     []string{"projects"},
 							ResourceNames: []string{"thunderball"},
-							Verbs:         []string{"get"},
+							Verbs:         []string{"get", "list", "watch"},
 						},
 					},
 				},
@@ -207,7 +207,7 @@ func (r *Reconciler) reconcileNamespaces(ctx context.Context, config *kubermatic
 		common.NamespaceCreator(config),
 	}
 
-	if err := reconciling.ReconcileNamespaces(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileNamespaces(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile Namespaces: %w", err)
 	}
 
@@ -298,7 +298,7 @@ func (r *Reconciler) reconcileClusterRoles(ctx context.Context, config *kubermat
 		common.WebhookClusterRoleCreator(config),
 	}
 
-	if err := reconciling.ReconcileClusterRoles(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoles(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoles: %w", err)
 	}
 
@@ -313,7 +313,7 @@ func (r *Reconciler) reconcileClusterRoleBindings(ctx context.Context, config *k
 		common.WebhookClusterRoleBindingCreator(config),
 	}
 
-	if err := reconciling.ReconcileClusterRoleBindings(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoleBindings(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoleBindings: %w", err)
 	}
 
@@ -429,7 +429,7 @@ func (r *Reconciler) reconcileValidatingWebhooks(ctx context.Context, config *ku
 		common.ApplicationDefinitionValidatingWebhookConfigurationCreator(ctx, config, r.Client),
 	}
 
-	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile Validating Webhooks: %w", err)
 	}
 
@@ -443,7 +443,7 @@ func (r *Reconciler) reconcileMutatingWebhooks(ctx context.Context, config *kube
 		kubermatic.UserSSHKeyMutatingWebhookConfigurationCreator(ctx, config, r.Client),
 	}
 
-	if err := reconciling.ReconcileMutatingWebhookConfigurations(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileMutatingWebhookConfigurations(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile Mutating Webhooks: %w", err)
 	}
 
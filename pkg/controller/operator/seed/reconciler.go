@@ -233,6 +233,10 @@ func (r *Reconciler) cleanupDeletedSeed(ctx context.Context, cfg *kubermaticv1.K
 		return fmt.Errorf("failed to clean up Cluster MutatingWebhookConfiguration: %w", err)
 	}
 
+	if err := common.CleanupClusterResource(ctx, client, &admissionregistrationv1.ValidatingWebhookConfiguration{}, kubermaticseed.EtcdBackupConfigAdmissionWebhookName); err != nil {
+		return fmt.Errorf("failed to clean up EtcdBackupConfig ValidatingWebhookConfiguration: %w", err)
+	}
+
 	if err := common.CleanupClusterResource(ctx, client, &admissionregistrationv1.MutatingWebhookConfiguration{}, kubermaticseed.AddonAdmissionWebhookName); err != nil {
 		return fmt.Errorf("failed to clean up Cluster MutatingWebhookConfiguration: %w", err)
 	}
@@ -386,7 +390,7 @@ func (r *Reconciler) reconcileCRDs(ctx context.Context, cfg *kubermaticv1.Kuberm
 		}
 	}
 
-	if err := reconciling.ReconcileCustomResourceDefinitions(ctx, creators, "", client); err != nil {
+	if err := reconciling.ReconcileCustomResourceDefinitions(ctx, creators, client); err != nil {
 		return fmt.Errorf("failed to reconcile CRDs: %w", err)
 	}
 
@@ -400,7 +404,7 @@ func (r *Reconciler) reconcileNamespaces(ctx context.Context, cfg *kubermaticv1.
 		common.NamespaceCreator(cfg),
 	}
 
-	if err := reconciling.ReconcileNamespaces(ctx, creators, "", client); err != nil {
+	if err := reconciling.ReconcileNamespaces(ctx, creators, client); err != nil {
 		return fmt.Errorf("failed to reconcile Namespaces: %w", err)
 	}
 
@@ -490,7 +494,7 @@ func (r *Reconciler) reconcileClusterRoles(ctx context.Context, cfg *kubermaticv
 		creators = append(creators, vpa.ClusterRoleCreators()...)
 	}
 
-	if err := reconciling.ReconcileClusterRoles(ctx, creators, "", client); err != nil {
+	if err := reconciling.ReconcileClusterRoles(ctx, creators, client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoles: %w", err)
 	}
 
@@ -513,7 +517,7 @@ func (r *Reconciler) reconcileClusterRoleBindings(ctx context.Context, cfg *kube
 		creators = append(creators, vpa.ClusterRoleBindingCreators()...)
 	}
 
-	if err := reconciling.ReconcileClusterRoleBindings(ctx, creators, "", client); err != nil {
+	if err := reconciling.ReconcileClusterRoleBindings(ctx, creators, client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoleBindings: %w", err)
 	}
 
@@ -679,6 +683,7 @@ func (r *Reconciler) reconcileAdmissionWebhooks(ctx context.Context, cfg *kuberm
 		common.SeedAdmissionWebhookCreator(ctx, cfg, client),
 		common.KubermaticConfigurationAdmissionWebhookCreator(ctx, cfg, client),
 		kubermaticseed.ClusterValidatingWebhookConfigurationCreator(ctx, cfg, client),
+		kubermaticseed.EtcdBackupConfigValidatingWebhookConfigurationCreator(ctx, cfg, client),
 		common.ApplicationDefinitionValidatingWebhookConfigurationCreator(ctx, cfg, client),
 	}
 
@@ -690,7 +695,7 @@ func (r *Reconciler) reconcileAdmissionWebhooks(ctx context.Context, cfg *kuberm
 		)
 	}
 
-	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, validatingWebhookCreators, "", client); err != nil {
+	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, validatingWebhookCreators, client); err != nil {
 		return fmt.Errorf("failed to reconcile validating Admission Webhooks: %w", err)
 	}
 
@@ -700,7 +705,7 @@ func (r *Reconciler) reconcileAdmissionWebhooks(ctx context.Context, cfg *kuberm
 		kubermaticseed.MLAAdminSettingMutatingWebhookConfigurationCreator(ctx, cfg, client),
 	}
 
-	if err := reconciling.ReconcileMutatingWebhookConfigurations(ctx, mutatingWebhookCreators, "", client); err != nil {
+	if err := reconciling.ReconcileMutatingWebhookConfigurations(ctx, mutatingWebhookCreators, client); err != nil {
 		return fmt.Errorf("failed to reconcile mutating Admission Webhooks: %w", err)
 	}
 
@@ -38,6 +38,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/semver"
 	"k8c.io/kubermatic/v2/pkg/util/kubectl"
+	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	corev1 "k8s.io/api/core/v1"
@@ -48,6 +49,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -68,6 +70,26 @@ const (
 	addonEnsureLabelKey  = "addons.kubermatic.io/ensure"
 )
 
+// namespacedPruneAllowlist are the namespaced types from kubectl's default prune allowlist.
+// Addons restricted to a namespace only prune these, so that pruning can never reach
+// cluster-scoped resources like Namespaces or PersistentVolumes.
+var namespacedPruneAllowlist = []string{
+	"core/v1/ConfigMap",
+	"core/v1/Endpoints",
+	"core/v1/PersistentVolumeClaim",
+	"core/v1/Pod",
+	"core/v1/ReplicationController",
+	"core/v1/Secret",
+	"core/v1/Service",
+	"batch/v1/Job",
+	"batch/v1/CronJob",
+	"networking.k8s.io/v1/Ingress",
+	"apps/v1/DaemonSet",
+	"apps/v1/Deployment",
+	"apps/v1/ReplicaSet",
+	"apps/v1/StatefulSet",
+}
+
 // KubeconfigProvider provides functionality to get a clusters admin kubeconfig.
 type KubeconfigProvider interface {
 	GetAdminKubeconfig(ctx context.Context, c *kubermaticv1.Cluster) ([]byte, error)
@@ -84,9 +106,12 @@ type Reconciler struct {
 	addonVariables       map[string]interface{}
 	kubernetesAddonDir   string
 	overwriteRegistry    string
+	serverSideApply      bool
+	caBundle             resources.CABundle
 	recorder             record.EventRecorder
 	KubeconfigProvider   KubeconfigProvider
 	versions             kubermatic.Versions
+	manifestCache        manifestCache
 }
 
 // Add creates a new Addon controller that is responsible for
@@ -100,7 +125,9 @@ func Add(
 	addonCtxVariables map[string]interface{},
 	kubernetesAddonDir,
 	overwriteRegistry string,
+	serverSideApply bool,
 	kubeconfigProvider KubeconfigProvider,
+	caBundle resources.CABundle,
 	versions kubermatic.Versions,
 ) error {
 	log = log.Named(ControllerName)
@@ -117,6 +144,8 @@ func Add(
 		workerName:           workerName,
 		recorder:             mgr.GetEventRecorderFor(ControllerName),
 		overwriteRegistry:    overwriteRegistry,
+		serverSideApply:      serverSideApply,
+		caBundle:             caBundle,
 		versions:             versions,
 	}
 
@@ -165,7 +194,7 @@ func Add(
 			return false
 		},
 	}
-	if err := c.Watch(&source.Kind{Type: &kubermaticv1.Cluster{}}, enqueueClusterAddons, clusterPredicate); err != nil {
+	if err := c.Watch(&source.Kind{Type: &kubermaticv1.Cluster{}}, enqueueClusterAddons, workerlabel.Predicates(workerName), clusterPredicate); err != nil {
 		return err
 	}
 
@@ -179,6 +208,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	addon := &kubermaticv1.Addon{}
 	if err := r.Get(ctx, request.NamespacedName, addon); err != nil {
 		if apierrors.IsNotFound(err) {
+			r.manifestCache.forget(request.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, err
@@ -259,6 +289,15 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, addo
 		}
 		return nil, nil
 	}
+
+	reqeueAfter, err = r.ensureRequiredAddonsInstalled(ctx, log, addon)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if all required addons are installed: %w", err)
+	}
+	if reqeueAfter != nil {
+		return reqeueAfter, nil
+	}
+
 	// This is true when the addon: 1) is fully deployed, 2) doesn't have a `addonEnsureLabelKey` set to true.
 	// we do this to allow users to "edit/delete" resources deployed by unlabeled addons,
 	// while we enfornce the labeled ones
@@ -292,7 +331,7 @@ func (r *Reconciler) getAddonManifests(ctx context.Context, log *zap.SugaredLogg
 	dnsResolverIP := clusterIP
 	if cluster.Spec.ClusterNetwork.NodeLocalDNSCacheEnabled == nil || *cluster.Spec.ClusterNetwork.NodeLocalDNSCacheEnabled {
 		// NOTE: even if NodeLocalDNSCacheEnabled is nil, we assume it is enabled (backward compatibility for already existing clusters)
-		dnsResolverIP = resources.NodeLocalDNSCacheAddress
+		dnsResolverIP = resources.UserClusterNodeLocalDNSCacheAddress(cluster)
 	}
 
 	kubeconfig, err := r.KubeconfigProvider.GetAdminKubeconfig(ctx, cluster)
@@ -318,24 +357,70 @@ func (r *Reconciler) getAddonManifests(ctx context.Context, log *zap.SugaredLogg
 		}
 	}
 
+	caBundle := ""
+	if r.caBundle != nil {
+		caBundle = r.caBundle.String()
+	}
+
 	data, err := addonutils.NewTemplateData(
 		cluster,
 		credentials,
 		string(kubeconfig),
 		clusterIP,
 		dnsResolverIP,
+		caBundle,
 		variables,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create template data for addon manifests: %w", err)
 	}
 
+	overwriteRegistry := r.overwriteRegistry
+	if addon.Spec.OverwriteRegistry != "" {
+		overwriteRegistry = addon.Spec.OverwriteRegistry
+	}
+
+	cacheKey := types.NamespacedName{Namespace: addon.Namespace, Name: addon.Name}
+	inputHash, err := hashManifestInputs(addon.Spec.Name, overwriteRegistry, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifests, ok := r.manifestCache.get(cacheKey, inputHash); ok {
+		log.Debug("Reusing cached addon manifests")
+		return manifests, nil
+	}
+
 	manifestPath := path.Join(addonDir, addon.Spec.Name)
-	allManifests, err := addonutils.ParseFromFolder(log, r.overwriteRegistry, manifestPath, data)
+
+	isKustomization, err := addonutils.IsKustomization(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for kustomization in %s: %w", manifestPath, err)
+	}
+
+	if isKustomization {
+		binary, err := kubectl.BinaryForClusterVersion(&cluster.Status.Versions.ControlPlane)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine kubectl binary to use: %w", err)
+		}
+
+		allManifests, err := addonutils.ParseFromKustomization(ctx, log, overwriteRegistry, binary, manifestPath, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse addon kustomization in %s: %w", manifestPath, err)
+		}
+
+		r.manifestCache.set(cacheKey, inputHash, allManifests)
+
+		return allManifests, nil
+	}
+
+	allManifests, err := addonutils.ParseFromFolder(log, overwriteRegistry, manifestPath, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse addon templates in %s: %w", manifestPath, err)
 	}
 
+	r.manifestCache.set(cacheKey, inputHash, allManifests)
+
 	return allManifests, nil
 }
 
@@ -357,26 +442,14 @@ func (r *Reconciler) combineManifests(manifests []*bytes.Buffer) *bytes.Buffer {
 func (r *Reconciler) ensureAddonLabelOnManifests(addon *kubermaticv1.Addon, manifests []addon.Manifest) ([]*bytes.Buffer, error) {
 	var rawManifests []*bytes.Buffer
 
-	wantLabels := r.getAddonLabel(addon)
-	for _, m := range manifests {
-		parsedUnstructuredObj := &metav1unstructured.Unstructured{}
-		if _, _, err := metav1unstructured.UnstructuredJSONScheme.Decode(m.Content.Raw, nil, parsedUnstructuredObj); err != nil {
-			return nil, fmt.Errorf("parsing unstructured failed: %w", err)
-		}
-
-		existingLabels := parsedUnstructuredObj.GetLabels()
-		if existingLabels == nil {
-			existingLabels = map[string]string{}
-		}
-
-		// Apply the wanted labels
-		for k, v := range wantLabels {
-			existingLabels[k] = v
-		}
-		parsedUnstructuredObj.SetLabels(existingLabels)
+	objects, err := r.parseAddonManifests(addon, manifests)
+	if err != nil {
+		return nil, err
+	}
 
+	for _, obj := range objects {
 		jsonBuffer := &bytes.Buffer{}
-		if err := metav1unstructured.UnstructuredJSONScheme.Encode(parsedUnstructuredObj, jsonBuffer); err != nil {
+		if err := metav1unstructured.UnstructuredJSONScheme.Encode(obj, jsonBuffer); err != nil {
 			return nil, fmt.Errorf("encoding json failed: %w", err)
 		}
 
@@ -409,30 +482,30 @@ func getFileDeleteFinalizer(log *zap.SugaredLogger, filename string) fileHandlin
 	}
 }
 
-func (r *Reconciler) writeCombinedManifest(log *zap.SugaredLogger, manifest *bytes.Buffer, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, fileHandlingDone, error) {
+func (r *Reconciler) writeCombinedManifest(log *zap.SugaredLogger, dir string, manifest *bytes.Buffer, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, error) {
 	// Write combined Manifest to disk
-	manifestFilename := path.Join("/tmp", fmt.Sprintf("cluster-%s-%s.yaml", cluster.Name, addon.Name))
+	manifestFilename := path.Join(dir, fmt.Sprintf("cluster-%s-%s.yaml", cluster.Name, addon.Name))
 	if err := os.WriteFile(manifestFilename, manifest.Bytes(), 0644); err != nil {
-		return "", nil, fmt.Errorf("failed to write combined manifest to %s: %w", manifestFilename, err)
+		return "", fmt.Errorf("failed to write combined manifest to %s: %w", manifestFilename, err)
 	}
 	log.Debugw("Wrote combined manifest", "file", manifestFilename)
 
-	return manifestFilename, getFileDeleteFinalizer(log, manifestFilename), nil
+	return manifestFilename, nil
 }
 
-func (r *Reconciler) writeAdminKubeconfig(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, fileHandlingDone, error) {
+func (r *Reconciler) writeAdminKubeconfig(ctx context.Context, log *zap.SugaredLogger, dir string, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, error) {
 	// Write kubeconfig to disk
 	kubeconfig, err := r.KubeconfigProvider.GetAdminKubeconfig(ctx, cluster)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to get admin kubeconfig for cluster %s: %w", cluster.Name, err)
+		return "", fmt.Errorf("failed to get admin kubeconfig for cluster %s: %w", cluster.Name, err)
 	}
-	kubeconfigFilename := path.Join("/tmp", fmt.Sprintf("cluster-%s-addon-%s-kubeconfig", cluster.Name, addon.Name))
-	if err := os.WriteFile(kubeconfigFilename, kubeconfig, 0644); err != nil {
-		return "", nil, fmt.Errorf("failed to write admin kubeconfig for cluster %s: %w", cluster.Name, err)
+	kubeconfigFilename := path.Join(dir, fmt.Sprintf("cluster-%s-addon-%s-kubeconfig", cluster.Name, addon.Name))
+	if err := os.WriteFile(kubeconfigFilename, kubeconfig, 0600); err != nil {
+		return "", fmt.Errorf("failed to write admin kubeconfig for cluster %s: %w", cluster.Name, err)
 	}
 	log.Debugw("Wrote admin kubeconfig", "file", kubeconfigFilename)
 
-	return kubeconfigFilename, getFileDeleteFinalizer(log, kubeconfigFilename), nil
+	return kubeconfigFilename, nil
 }
 
 func (r *Reconciler) setupManifestInteraction(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) (string, string, fileHandlingDone, error) {
@@ -441,30 +514,78 @@ func (r *Reconciler) setupManifestInteraction(ctx context.Context, log *zap.Suga
 		return "", "", nil, fmt.Errorf("failed to get addon manifests: %w", err)
 	}
 
+	return r.writeManifestInteractionFiles(ctx, log, addon, cluster, manifests)
+}
+
+// writeManifestInteractionFiles writes the given, already rendered manifests and the admin
+// kubeconfig into a temporary directory for use with kubectl.
+func (r *Reconciler) writeManifestInteractionFiles(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster, manifests []addon.Manifest) (string, string, fileHandlingDone, error) {
 	rawManifests, err := r.ensureAddonLabelOnManifests(addon, manifests)
 	if err != nil {
 		return "", "", nil, fmt.Errorf("failed to add the addon specific label to all addon resources: %w", err)
 	}
 
+	// Every reconciliation gets its own directory (below $TMPDIR), so concurrent
+	// reconciliations of the same addon do not overwrite each other's files.
+	tempDir, err := os.MkdirTemp("", fmt.Sprintf("cluster-%s-addon-%s-", cluster.Name, addon.Name))
+	if err != nil {
+		return "", "", nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	done := getFileDeleteFinalizer(log, tempDir)
+
 	rawManifest := r.combineManifests(rawManifests)
-	manifestFilename, manifestDone, err := r.writeCombinedManifest(log, rawManifest, addon, cluster)
+	manifestFilename, err := r.writeCombinedManifest(log, tempDir, rawManifest, addon, cluster)
 	if err != nil {
+		done()
 		return "", "", nil, fmt.Errorf("failed to write all addon resources into a combined manifest file: %w", err)
 	}
 
-	kubeconfigFilename, kubeconfigDone, err := r.writeAdminKubeconfig(ctx, log, addon, cluster)
+	kubeconfigFilename, err := r.writeAdminKubeconfig(ctx, log, tempDir, addon, cluster)
 	if err != nil {
+		done()
 		return "", "", nil, fmt.Errorf("failed to write the admin kubeconfig to the local filesystem: %w", err)
 	}
 
-	done := func() {
-		kubeconfigDone()
-		manifestDone()
-	}
 	return kubeconfigFilename, manifestFilename, done, nil
 }
 
-func (r *Reconciler) getApplyCommand(ctx context.Context, kubeconfigFilename, manifestFilename string, selector fmt.Stringer, clusterVersion semver.Semver) (*exec.Cmd, error) {
+func (r *Reconciler) validateNamespacedAddon(ctx context.Context, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster, manifests []addon.Manifest) error {
+	userClusterClient, err := r.KubeconfigProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get client for usercluster: %w", err)
+	}
+
+	return validateNamespacedManifests(userClusterClient.RESTMapper(), addon.Spec.Namespace, manifests)
+}
+
+// validateNamespacedManifests ensures that the manifests of an addon that is restricted to the
+// given namespace neither contain cluster-scoped resources nor resources in other namespaces.
+func validateNamespacedManifests(mapper meta.RESTMapper, namespace string, manifests []addon.Manifest) error {
+	for _, m := range manifests {
+		obj := &metav1unstructured.Unstructured{}
+		if _, _, err := metav1unstructured.UnstructuredJSONScheme.Decode(m.Content.Raw, nil, obj); err != nil {
+			return fmt.Errorf("parsing unstructured failed: %w", err)
+		}
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return fmt.Errorf("failed to determine scope of %s %q: %w", gvk.Kind, obj.GetName(), err)
+		}
+
+		if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+			return fmt.Errorf("addon is restricted to namespace %q, but contains cluster-scoped %s %q", namespace, gvk.Kind, obj.GetName())
+		}
+
+		if ns := obj.GetNamespace(); ns != "" && ns != namespace {
+			return fmt.Errorf("addon is restricted to namespace %q, but contains %s %q in namespace %q", namespace, gvk.Kind, obj.GetName(), ns)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) getApplyCommand(ctx context.Context, kubeconfigFilename, manifestFilename string, selector fmt.Stringer, namespace string, clusterVersion semver.Semver) (*exec.Cmd, error) {
 	binary, err := kubectl.BinaryForClusterVersion(&clusterVersion)
 	if err != nil {
 		return nil, fmt.Errorf("failed to determine kubectl binary to use: %w", err)
@@ -479,11 +600,36 @@ func (r *Reconciler) getApplyCommand(ctx context.Context, kubeconfigFilename, ma
 		"--filename", manifestFilename,
 		"--selector", selector.String(),
 	)
+
+	// restrict applying and pruning to the namespace and never prune cluster-scoped types
+	if namespace != "" {
+		cmd.Args = append(cmd.Args, "--namespace", namespace)
+		for _, resource := range namespacedPruneAllowlist {
+			cmd.Args = append(cmd.Args, "--prune-whitelist", resource)
+		}
+	}
+
 	return cmd, nil
 }
 
 func (r *Reconciler) ensureIsInstalled(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) error {
-	kubeconfigFilename, manifestFilename, done, err := r.setupManifestInteraction(ctx, log, addon, cluster)
+	// render the manifests only once, they are both validated and applied
+	manifests, err := r.getAddonManifests(ctx, log, addon, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get addon manifests: %w", err)
+	}
+
+	if addon.Spec.Namespace != "" {
+		if err := r.validateNamespacedAddon(ctx, addon, cluster, manifests); err != nil {
+			return err
+		}
+	}
+
+	if r.serverSideApply {
+		return r.ensureIsInstalledServerSide(ctx, log, addon, cluster, manifests)
+	}
+
+	kubeconfigFilename, manifestFilename, done, err := r.writeManifestInteractionFiles(ctx, log, addon, cluster, manifests)
 	if err != nil {
 		return err
 	}
@@ -501,7 +647,7 @@ func (r *Reconciler) ensureIsInstalled(ctx context.Context, log *zap.SugaredLogg
 
 	// We delete all resources with this label which are not in the combined manifest
 	selector := labels.SelectorFromSet(r.getAddonLabel(addon))
-	cmd, err := r.getApplyCommand(ctx, kubeconfigFilename, manifestFilename, selector, cluster.Status.Versions.ControlPlane)
+	cmd, err := r.getApplyCommand(ctx, kubeconfigFilename, manifestFilename, selector, addon.Spec.Namespace, cluster.Status.Versions.ControlPlane)
 	if err != nil {
 		return fmt.Errorf("failed to create command: %w", err)
 	}
@@ -509,9 +655,12 @@ func (r *Reconciler) ensureIsInstalled(ctx context.Context, log *zap.SugaredLogg
 	cmdLog := log.With("cmd", strings.Join(cmd.Args, " "))
 
 	cmdLog.Debug("Applying manifest...")
+	start := time.Now()
 	out, err := cmd.CombinedOutput()
+	applyDuration.WithLabelValues(addon.Name).Observe(time.Since(start).Seconds())
 	cmdLog.Debugw("Finished executing command", "output", string(out))
 	if err != nil {
+		applyFailures.WithLabelValues(addon.Name).Inc()
 		return fmt.Errorf("failed to execute '%s' for addon %s of cluster %s: %w\n%s", strings.Join(cmd.Args, " "), addon.Name, cluster.Name, err, string(out))
 	}
 
@@ -533,6 +682,10 @@ func (r *Reconciler) ensureResourcesCreatedConditionIsSet(ctx context.Context, a
 }
 
 func (r *Reconciler) cleanupManifests(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) error {
+	if r.serverSideApply {
+		return r.cleanupManifestsServerSide(ctx, log, addon, cluster)
+	}
+
 	kubeconfigFilename, manifestFilename, done, err := r.setupManifestInteraction(ctx, log, addon, cluster)
 	if err != nil {
 		// FIXME: use a dedicated error type and proper error unwrapping when we have the technology to do it
@@ -550,12 +703,16 @@ func (r *Reconciler) cleanupManifests(ctx context.Context, log *zap.SugaredLogge
 	}
 
 	cmd := exec.CommandContext(ctx, binary, "--kubeconfig", kubeconfigFilename, "delete", "-f", manifestFilename, "--ignore-not-found")
+	if addon.Spec.Namespace != "" {
+		cmd.Args = append(cmd.Args, "--namespace", addon.Spec.Namespace)
+	}
 	cmdLog := log.With("cmd", strings.Join(cmd.Args, " "))
 
 	cmdLog.Debug("Deleting resources...")
 	out, err := cmd.CombinedOutput()
 	cmdLog.Debugw("Finished executing command", "output", string(out))
 	if err != nil {
+		cleanupFailures.WithLabelValues(addon.Name).Inc()
 		return fmt.Errorf("failed to execute '%s' for addon %s of cluster %s: %w\n%s", strings.Join(cmd.Args, " "), addon.Name, cluster.Name, err, string(out))
 	}
 	return nil
@@ -592,6 +749,80 @@ func (r *Reconciler) ensureRequiredResourceTypesExist(ctx context.Context, log *
 	return nil, nil
 }
 
+// ensureRequiredAddonsInstalled checks that all addons listed in the addon's Requires field
+// have their resources created. If one of them is missing or not yet installed, a requeue
+// is returned. Cyclic dependencies between addons are reported as an error, as they could
+// never be resolved.
+func (r *Reconciler) ensureRequiredAddonsInstalled(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon) (*reconcile.Result, error) {
+	if len(addon.Spec.Requires) == 0 {
+		return nil, nil
+	}
+
+	addonList := &kubermaticv1.AddonList{}
+	if err := r.List(ctx, addonList, ctrlruntimeclient.InNamespace(addon.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list addons: %w", err)
+	}
+
+	clusterAddons := map[string]*kubermaticv1.Addon{}
+	for i := range addonList.Items {
+		clusterAddons[addonList.Items[i].Name] = &addonList.Items[i]
+	}
+	// make sure we check the current state of the addon we are reconciling
+	clusterAddons[addon.Name] = addon
+
+	if cycle := findAddonDependencyCycle(addon.Name, clusterAddons); cycle != nil {
+		return nil, fmt.Errorf("addon dependencies form a cycle: %s", strings.Join(cycle, " -> "))
+	}
+
+	for _, required := range addon.Spec.Requires {
+		requiredAddon, ok := clusterAddons[required]
+		if !ok {
+			log.Infow("Required addon does not exist, trying again in 10 seconds", "required", required)
+			return &reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+
+		if !addonResourcesCreated(requiredAddon) {
+			log.Infow("Required addon is not installed yet, trying again in 10 seconds", "required", required)
+			return &reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// findAddonDependencyCycle returns the chain of addon names leading from the
+// given addon back to itself, or nil if the addon is not part of a cycle.
+// Addons that do not exist are ignored.
+func findAddonDependencyCycle(name string, addons map[string]*kubermaticv1.Addon) []string {
+	visited := sets.NewString()
+
+	var visit func(current string, chain []string) []string
+	visit = func(current string, chain []string) []string {
+		addon, ok := addons[current]
+		if !ok {
+			return nil
+		}
+
+		for _, required := range addon.Spec.Requires {
+			if required == name {
+				return append(chain, required)
+			}
+			if visited.Has(required) {
+				continue
+			}
+			visited.Insert(required)
+
+			if cycle := visit(required, append(chain, required)); cycle != nil {
+				return cycle
+			}
+		}
+
+		return nil
+	}
+
+	return visit(name, []string{name})
+}
+
 func formatGVK(gvk kubermaticv1.GroupVersionKind) string {
 	return fmt.Sprintf("%s/%s %s", gvk.Group, gvk.Version, gvk.Kind)
 }
@@ -23,9 +23,12 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/go-test/deep"
+
 	"k8c.io/kubermatic/v2/pkg/addon"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
@@ -36,11 +39,19 @@ import (
 	"k8c.io/kubermatic/v2/pkg/util/kubectl"
 	"k8c.io/kubermatic/v2/pkg/version/cni"
 
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	kyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var testManifests = []string{
@@ -297,6 +308,112 @@ func TestController_getAddonDeploymentManifests(t *testing.T) {
 	}
 }
 
+func TestController_getAddonManifestsCache(t *testing.T) {
+	cluster := setupTestCluster("10.240.16.0/20")
+	addon := setupTestAddon("cached")
+
+	addonDir, err := os.MkdirTemp("/tmp", "kubermatic-tests-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(addonDir)
+
+	manifestFile := path.Join(addonDir, addon.Spec.Name, "testManifest.yaml")
+	writeManifest := func(value string) {
+		manifest := fmt.Sprintf(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  namespace: kube-system
+data:
+  token: "{{ .Credentials.Digitalocean.Token }}"
+  value: %s
+`, value)
+		if err := os.WriteFile(manifestFile, []byte(manifest), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := os.Mkdir(path.Join(addonDir, addon.Spec.Name), 0777); err != nil {
+		t.Fatal(err)
+	}
+	writeManifest("first")
+
+	log := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
+	ctx := context.Background()
+
+	controller := &Reconciler{
+		kubernetesAddonDir: addonDir,
+		KubeconfigProvider: &fakeKubeconfigProvider{},
+	}
+
+	assertManifestContains := func(expected string) {
+		t.Helper()
+
+		manifests, err := controller.getAddonManifests(ctx, log, addon, cluster)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(manifests) != 1 {
+			t.Fatalf("invalid number of manifests returned. Expected 1, Got %d", len(manifests))
+		}
+		if !strings.Contains(string(manifests[0].Content.Raw), expected) {
+			t.Fatalf("manifest does not contain %q: %s", expected, manifests[0].Content.String())
+		}
+	}
+
+	assertManifestContains("first")
+
+	// unchanged inputs must reuse the previously rendered manifests
+	writeManifest("second")
+	assertManifestContains("first")
+
+	// changed credentials must invalidate the cache
+	cluster.Spec.Cloud.Digitalocean.Token = "0987654321"
+	assertManifestContains("second")
+	assertManifestContains("0987654321")
+}
+
+func TestController_getAddonDeploymentManifestsAddonRegistry(t *testing.T) {
+	cluster := setupTestCluster("10.240.16.0/20")
+	addon := setupTestAddon("test")
+	addon.Spec.OverwriteRegistry = "baz.io"
+
+	addonDir, err := os.MkdirTemp("/tmp", "kubermatic-tests-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(addonDir)
+
+	if err := os.Mkdir(path.Join(addonDir, addon.Spec.Name), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(addonDir, addon.Spec.Name, "testManifest.yaml"), []byte(testManifest1WithDeployment), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	log := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
+
+	controller := &Reconciler{
+		kubernetesAddonDir: addonDir,
+		overwriteRegistry:  "bar.io",
+		KubeconfigProvider: &fakeKubeconfigProvider{},
+	}
+	manifests, err := controller.getAddonManifests(context.Background(), log, addon, cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(manifests) != 1 {
+		t.Fatalf("invalid number of manifests returned. Expected 1, Got %d", len(manifests))
+	}
+
+	expectedRegURL := "baz.io/test:1.2.3"
+	if !strings.Contains(string(manifests[0].Content.Raw), expectedRegURL) {
+		t.Fatalf("invalid registryURI returned. Expected \n%s, Got \n%s", expectedRegURL, manifests[0].Content.String())
+	}
+}
+
 func TestController_getAddonDeploymentManifestsDefault(t *testing.T) {
 	cluster := setupTestCluster("10.240.16.0/20")
 	addon := setupTestAddon("test")
@@ -429,7 +546,7 @@ func TestController_getApplyCommand(t *testing.T) {
 		t.Fatalf("Should be able to determine a kubectl binary for %q, but got %v", clusterVersion, err)
 	}
 
-	cmd, err := controller.getApplyCommand(context.Background(), "/opt/kubeconfig", "/opt/manifest.yaml", labels.SelectorFromSet(map[string]string{"foo": "bar"}), *clusterVersion)
+	cmd, err := controller.getApplyCommand(context.Background(), "/opt/kubeconfig", "/opt/manifest.yaml", labels.SelectorFromSet(map[string]string{"foo": "bar"}), "", *clusterVersion)
 	if err != nil {
 		t.Fatalf("Should be able to determine the command, but got %v", err)
 	}
@@ -439,6 +556,93 @@ func TestController_getApplyCommand(t *testing.T) {
 	if got != expected {
 		t.Fatalf("invalid apply command returned. Expected \n%s, Got \n%s", expected, got)
 	}
+
+	cmd, err = controller.getApplyCommand(context.Background(), "/opt/kubeconfig", "/opt/manifest.yaml", labels.SelectorFromSet(map[string]string{"foo": "bar"}), "monitoring", *clusterVersion)
+	if err != nil {
+		t.Fatalf("Should be able to determine the command, but got %v", err)
+	}
+
+	got = strings.Join(cmd.Args, " ")
+	if !strings.HasSuffix(got, "--selector foo=bar --namespace monitoring --prune-whitelist core/v1/ConfigMap --prune-whitelist core/v1/Endpoints --prune-whitelist core/v1/PersistentVolumeClaim --prune-whitelist core/v1/Pod --prune-whitelist core/v1/ReplicationController --prune-whitelist core/v1/Secret --prune-whitelist core/v1/Service --prune-whitelist batch/v1/Job --prune-whitelist batch/v1/CronJob --prune-whitelist networking.k8s.io/v1/Ingress --prune-whitelist apps/v1/DaemonSet --prune-whitelist apps/v1/Deployment --prune-whitelist apps/v1/ReplicaSet --prune-whitelist apps/v1/StatefulSet") {
+		t.Fatalf("invalid namespaced apply command returned: %s", got)
+	}
+}
+
+func TestValidateNamespacedManifests(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+
+	toManifest := func(obj string) addon.Manifest {
+		raw, err := kyaml.ToJSON([]byte(obj))
+		if err != nil {
+			t.Fatalf("failed to convert manifest: %v", err)
+		}
+		return addon.Manifest{Content: runtime.RawExtension{Raw: raw}}
+	}
+
+	testCases := []struct {
+		name      string
+		manifests []string
+		wantErr   bool
+	}{
+		{
+			name:      "namespaced resources in the addon namespace",
+			manifests: testManifests,
+			wantErr:   false,
+		},
+		{
+			name: "namespaced resource without namespace",
+			manifests: []string{`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+`},
+			wantErr: false,
+		},
+		{
+			name: "namespaced resource in another namespace",
+			manifests: []string{`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  namespace: default
+`},
+			wantErr: true,
+		},
+		{
+			name: "cluster-scoped resource",
+			manifests: []string{`apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: test
+`},
+			wantErr: true,
+		},
+		{
+			name: "unknown resource type",
+			manifests: []string{`apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: test
+`},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			manifests := []addon.Manifest{}
+			for _, m := range tc.manifests {
+				manifests = append(manifests, toManifest(m))
+			}
+
+			err := validateNamespacedManifests(mapper, "kube-system", manifests)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+		})
+	}
 }
 
 func TestHugeManifest(t *testing.T) {
@@ -453,3 +657,367 @@ func TestHugeManifest(t *testing.T) {
 		t.Fatalf("failed to setup manifest interaction: %v", err)
 	}
 }
+
+func TestSetupManifestInteractionUsesTempDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("TMPDIR", tmpDir)
+
+	log := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
+	cluster := setupTestCluster("10.240.16.0/20")
+	addon := setupTestAddon("istio")
+	r := &Reconciler{
+		kubernetesAddonDir: "./testdata",
+		KubeconfigProvider: &fakeKubeconfigProvider{},
+	}
+
+	kubeconfigA, manifestA, doneA, err := r.setupManifestInteraction(context.Background(), log, addon, cluster)
+	if err != nil {
+		t.Fatalf("failed to setup manifest interaction: %v", err)
+	}
+	kubeconfigB, manifestB, doneB, err := r.setupManifestInteraction(context.Background(), log, addon, cluster)
+	if err != nil {
+		t.Fatalf("failed to setup manifest interaction: %v", err)
+	}
+	defer doneB()
+
+	if kubeconfigA == kubeconfigB || manifestA == manifestB {
+		t.Fatal("expected concurrent reconciliations to use distinct files")
+	}
+
+	dirA := filepath.Dir(manifestA)
+	if filepath.Dir(dirA) != tmpDir {
+		t.Fatalf("expected files to be written below %q, got %q", tmpDir, dirA)
+	}
+	if filepath.Dir(kubeconfigA) != dirA {
+		t.Fatalf("expected kubeconfig to be written to %q, got %q", dirA, kubeconfigA)
+	}
+
+	info, err := os.Stat(kubeconfigA)
+	if err != nil {
+		t.Fatalf("failed to stat kubeconfig: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("expected kubeconfig to have mode 0600, got %o", perm)
+	}
+
+	doneA()
+
+	if _, err := os.Stat(dirA); !os.IsNotExist(err) {
+		t.Errorf("expected %q to be removed, got: %v", dirA, err)
+	}
+	if _, err := os.Stat(manifestB); err != nil {
+		t.Errorf("expected files of the other reconciliation to be kept, got: %v", err)
+	}
+}
+
+func TestDefaultObjectNamespace(t *testing.T) {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "ClusterRole"}, meta.RESTScopeRoot)
+
+	newObject := func(apiVersion, kind, namespace string) *metav1unstructured.Unstructured {
+		obj := &metav1unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName("test")
+		obj.SetNamespace(namespace)
+		return obj
+	}
+
+	testCases := []struct {
+		name              string
+		object            *metav1unstructured.Unstructured
+		addonNamespace    string
+		expectedNamespace string
+		wantErr           bool
+	}{
+		{
+			name:              "namespace is kept",
+			object:            newObject("v1", "ConfigMap", "kube-system"),
+			expectedNamespace: "kube-system",
+		},
+		{
+			name:              "namespaced object defaults to the default namespace",
+			object:            newObject("v1", "ConfigMap", ""),
+			expectedNamespace: metav1.NamespaceDefault,
+		},
+		{
+			name:              "namespaced object defaults to the addon namespace",
+			object:            newObject("v1", "ConfigMap", ""),
+			addonNamespace:    "kube-system",
+			expectedNamespace: "kube-system",
+		},
+		{
+			name:              "cluster-scoped object has no namespace",
+			object:            newObject("rbac.authorization.k8s.io/v1", "ClusterRole", ""),
+			expectedNamespace: "",
+		},
+		{
+			name:    "unknown resource type",
+			object:  newObject("example.com/v1", "Unknown", ""),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := defaultObjectNamespace(mapper, tc.object, tc.addonNamespace)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+
+			if !tc.wantErr && tc.object.GetNamespace() != tc.expectedNamespace {
+				t.Errorf("Expected namespace %q, got %q", tc.expectedNamespace, tc.object.GetNamespace())
+			}
+		})
+	}
+}
+
+// applyTestClient records the applied objects, as the fake client does not support
+// server-side apply, and only serves custom resources after their CRD was applied.
+type applyTestClient struct {
+	ctrlruntimeclient.Client
+
+	mapper  *meta.DefaultRESTMapper
+	applied []string
+}
+
+func (c *applyTestClient) RESTMapper() meta.RESTMapper {
+	return c.mapper
+}
+
+func (c *applyTestClient) Patch(_ context.Context, obj ctrlruntimeclient.Object, _ ctrlruntimeclient.Patch, _ ...ctrlruntimeclient.PatchOption) error {
+	u := obj.(*metav1unstructured.Unstructured)
+	c.applied = append(c.applied, objectKey(u))
+
+	if u.GroupVersionKind().GroupKind() == crdGroupKind {
+		c.mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Tenant"}, meta.RESTScopeNamespace)
+	}
+
+	return nil
+}
+
+func TestApplyObjects(t *testing.T) {
+	newObject := func(apiVersion, kind, name string) *metav1unstructured.Unstructured {
+		obj := &metav1unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		return obj
+	}
+
+	testCases := []struct {
+		name            string
+		objects         []*metav1unstructured.Unstructured
+		expectedApplied []string
+		wantErr         bool
+	}{
+		{
+			name: "CRD is applied before its custom resource",
+			objects: []*metav1unstructured.Unstructured{
+				newObject("example.com/v1", "Tenant", "tenant"),
+				newObject("v1", "ConfigMap", "config"),
+				newObject("apiextensions.k8s.io/v1", "CustomResourceDefinition", "tenants.example.com"),
+			},
+			expectedApplied: []string{
+				"CustomResourceDefinition.apiextensions.k8s.io/tenants.example.com",
+				"Tenant.example.com/kube-system/tenant",
+				"ConfigMap/kube-system/config",
+			},
+		},
+		{
+			name: "custom resource without CRD",
+			objects: []*metav1unstructured.Unstructured{
+				newObject("example.com/v1", "Tenant", "tenant"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+			mapper.Add(schema.GroupVersionKind{Group: "apiextensions.k8s.io", Version: "v1", Kind: "CustomResourceDefinition"}, meta.RESTScopeRoot)
+
+			client := &applyTestClient{mapper: mapper}
+
+			err := applyObjects(context.Background(), client, tc.objects, "kube-system")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			if diff := deep.Equal(client.applied, tc.expectedApplied); diff != nil {
+				t.Errorf("Objects were not applied as expected. Diff: %v", diff)
+			}
+		})
+	}
+}
+
+func TestPruneObjects(t *testing.T) {
+	addonLabels := map[string]string{addonLabelKey: "test"}
+
+	newConfigMap := func(namespace, name string, labels map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    labels,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		addonNamespace string
+		expectedPruned []string
+	}{
+		{
+			name:           "cluster-wide addon",
+			expectedPruned: []string{"kube-system/stale", "default/stale"},
+		},
+		{
+			name:           "namespaced addon",
+			addonNamespace: "kube-system",
+			expectedPruned: []string{"kube-system/stale"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			existing := []ctrlruntimeclient.Object{
+				newConfigMap("kube-system", "wanted", addonLabels),
+				newConfigMap("kube-system", "stale", addonLabels),
+				newConfigMap("kube-system", "unrelated", nil),
+				newConfigMap("default", "stale", addonLabels),
+			}
+
+			client := fakectrlruntimeclient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(existing...).Build()
+
+			wanted := &metav1unstructured.Unstructured{}
+			wanted.SetAPIVersion("v1")
+			wanted.SetKind("ConfigMap")
+			wanted.SetNamespace("kube-system")
+			wanted.SetName("wanted")
+
+			log := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
+			selector := labels.SelectorFromSet(addonLabels)
+
+			if err := pruneObjects(ctx, log, client, []*metav1unstructured.Unstructured{wanted}, selector, tc.addonNamespace); err != nil {
+				t.Fatalf("Failed to prune objects: %v", err)
+			}
+
+			pruned := sets.NewString(tc.expectedPruned...)
+			for _, obj := range existing {
+				key := ctrlruntimeclient.ObjectKeyFromObject(obj)
+				err := client.Get(ctx, key, &corev1.ConfigMap{})
+
+				switch {
+				case pruned.Has(key.String()) && !apierrors.IsNotFound(err):
+					t.Errorf("Expected ConfigMap %s to be pruned, but got: %v", key, err)
+				case !pruned.Has(key.String()) && err != nil:
+					t.Errorf("Expected ConfigMap %s to be kept, but got: %v", key, err)
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureRequiredAddonsInstalled(t *testing.T) {
+	installed := kubermaticv1.AddonStatus{
+		Conditions: map[kubermaticv1.AddonConditionType]kubermaticv1.AddonCondition{
+			kubermaticv1.AddonResourcesCreated: {
+				Status: corev1.ConditionTrue,
+			},
+		},
+	}
+
+	newAddon := func(name string, status kubermaticv1.AddonStatus, requires ...string) *kubermaticv1.Addon {
+		return &kubermaticv1.Addon{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "cluster-test",
+			},
+			Spec: kubermaticv1.AddonSpec{
+				Name:     name,
+				Requires: requires,
+			},
+			Status: status,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		addon         *kubermaticv1.Addon
+		existing      []ctrlruntimeclient.Object
+		expectRequeue bool
+		expectErr     bool
+	}{
+		{
+			name:  "no requirements",
+			addon: newAddon("b", kubermaticv1.AddonStatus{}),
+		},
+		{
+			name:     "required addon is installed",
+			addon:    newAddon("b", kubermaticv1.AddonStatus{}, "a"),
+			existing: []ctrlruntimeclient.Object{newAddon("a", installed)},
+		},
+		{
+			name:          "required addon is not installed yet",
+			addon:         newAddon("b", kubermaticv1.AddonStatus{}, "a"),
+			existing:      []ctrlruntimeclient.Object{newAddon("a", kubermaticv1.AddonStatus{})},
+			expectRequeue: true,
+		},
+		{
+			name:          "required addon does not exist",
+			addon:         newAddon("b", kubermaticv1.AddonStatus{}, "a"),
+			expectRequeue: true,
+		},
+		{
+			name:      "addons require each other",
+			addon:     newAddon("b", kubermaticv1.AddonStatus{}, "a"),
+			existing:  []ctrlruntimeclient.Object{newAddon("a", kubermaticv1.AddonStatus{}, "b")},
+			expectErr: true,
+		},
+		{
+			name:  "transitive cycle",
+			addon: newAddon("c", kubermaticv1.AddonStatus{}, "a"),
+			existing: []ctrlruntimeclient.Object{
+				newAddon("a", installed, "b"),
+				newAddon("b", installed, "c"),
+			},
+			expectErr: true,
+		},
+		{
+			name:      "addon requires itself",
+			addon:     newAddon("a", kubermaticv1.AddonStatus{}, "a"),
+			expectErr: true,
+		},
+	}
+
+	log := kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{
+				Client: fakectrlruntimeclient.
+					NewClientBuilder().
+					WithScheme(scheme.Scheme).
+					WithObjects(append(tc.existing, tc.addon)...).
+					Build(),
+			}
+
+			result, err := r.ensureRequiredAddonsInstalled(context.Background(), log, tc.addon)
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("Expected error: %v, but got %v", tc.expectErr, err)
+			}
+			if tc.expectRequeue != (result != nil) {
+				t.Fatalf("Expected requeue: %v, but got %v", tc.expectRequeue, result)
+			}
+		})
+	}
+}
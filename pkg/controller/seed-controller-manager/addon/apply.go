@@ -0,0 +1,317 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/kubermatic/v2/pkg/addon"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// clusterPruneAllowlist is kubectl's default prune allowlist, used for addons that are
+// not restricted to a namespace.
+var clusterPruneAllowlist = append([]string{
+	"core/v1/Namespace",
+	"core/v1/PersistentVolume",
+}, namespacedPruneAllowlist...)
+
+var crdGroupKind = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
+// parseAddonManifests decodes the addon manifests and adds the addon label to every object.
+func (r *Reconciler) parseAddonManifests(addon *kubermaticv1.Addon, manifests []addon.Manifest) ([]*metav1unstructured.Unstructured, error) {
+	var objects []*metav1unstructured.Unstructured
+
+	wantLabels := r.getAddonLabel(addon)
+	for _, m := range manifests {
+		obj := &metav1unstructured.Unstructured{}
+		if _, _, err := metav1unstructured.UnstructuredJSONScheme.Decode(m.Content.Raw, nil, obj); err != nil {
+			return nil, fmt.Errorf("parsing unstructured failed: %w", err)
+		}
+
+		existingLabels := obj.GetLabels()
+		if existingLabels == nil {
+			existingLabels = map[string]string{}
+		}
+
+		// Apply the wanted labels
+		for k, v := range wantLabels {
+			existingLabels[k] = v
+		}
+		obj.SetLabels(existingLabels)
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// getAddonObjects returns the labelled objects of the addon. Their namespaces are defaulted
+// only right before each object is used, as the scope of custom resources is unknown until
+// their CustomResourceDefinition, which can be part of the same addon, has been applied.
+func (r *Reconciler) getAddonObjects(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) ([]*metav1unstructured.Unstructured, error) {
+	manifests, err := r.getAddonManifests(ctx, log, addon, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get addon manifests: %w", err)
+	}
+
+	objects, err := r.parseAddonManifests(addon, manifests)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add the addon specific label to all addon resources: %w", err)
+	}
+
+	return objects, nil
+}
+
+// ensureIsInstalledServerSide is the counterpart to running "kubectl apply --prune": all
+// objects are applied using server-side apply and afterwards all labelled objects that
+// are no longer part of the addon are deleted.
+func (r *Reconciler) ensureIsInstalledServerSide(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster, manifests []addon.Manifest) error {
+	userClusterClient, err := r.KubeconfigProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get client for usercluster: %w", err)
+	}
+
+	objects, err := r.parseAddonManifests(addon, manifests)
+	if err != nil {
+		return fmt.Errorf("failed to add the addon specific label to all addon resources: %w", err)
+	}
+
+	if len(objects) == 0 {
+		log.Debug("Skipping addon installation as the manifest is empty after parsing")
+		return nil
+	}
+
+	log.Debug("Applying manifest...")
+	start := time.Now()
+	err = applyObjects(ctx, userClusterClient, objects, addon.Spec.Namespace)
+	if err == nil {
+		// We delete all resources with this label which are not in the manifest
+		selector := labels.SelectorFromSet(r.getAddonLabel(addon))
+		err = pruneObjects(ctx, log, userClusterClient, objects, selector, addon.Spec.Namespace)
+	}
+	applyDuration.WithLabelValues(addon.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		applyFailures.WithLabelValues(addon.Name).Inc()
+		return fmt.Errorf("failed to apply addon %s of cluster %s: %w", addon.Name, cluster.Name, err)
+	}
+
+	return nil
+}
+
+// cleanupManifestsServerSide is the counterpart to running "kubectl delete --ignore-not-found".
+func (r *Reconciler) cleanupManifestsServerSide(ctx context.Context, log *zap.SugaredLogger, addon *kubermaticv1.Addon, cluster *kubermaticv1.Cluster) error {
+	userClusterClient, err := r.KubeconfigProvider.GetClient(ctx, cluster)
+	if err != nil {
+		return fmt.Errorf("failed to get client for usercluster: %w", err)
+	}
+
+	objects, err := r.getAddonObjects(ctx, log, addon, cluster)
+	if err != nil {
+		// FIXME: use a dedicated error type and proper error unwrapping when we have the technology to do it
+		if strings.Contains(err.Error(), "no such file or directory") { // if the manifest is already deleted, that's ok
+			log.Debugf("cleanupManifests failed for addon %s/%s: %v", addon.Namespace, addon.Name, err)
+			return nil
+		}
+		return err
+	}
+
+	log.Debug("Deleting resources...")
+	mapper := userClusterClient.RESTMapper()
+	for _, obj := range objects {
+		if err := defaultObjectNamespace(mapper, obj, addon.Spec.Namespace); err != nil {
+			// the CRD of a custom resource is already gone, and with it all its resources
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return err
+		}
+
+		if err := userClusterClient.Delete(ctx, obj); err != nil && ctrlruntimeclient.IgnoreNotFound(err) != nil && !meta.IsNoMatchError(err) {
+			cleanupFailures.WithLabelValues(addon.Name).Inc()
+			return fmt.Errorf("failed to delete %s %s for addon %s of cluster %s: %w", obj.GetKind(), objectName(obj), addon.Name, cluster.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// defaultObjectNamespace sets the namespace of namespaced objects that do not specify one,
+// defaulting to the addon's namespace or the "default" namespace, like kubectl does.
+func defaultObjectNamespace(mapper meta.RESTMapper, obj *metav1unstructured.Unstructured, namespace string) error {
+	if obj.GetNamespace() != "" {
+		return nil
+	}
+
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		// returned as-is, as meta.IsNoMatchError does not unwrap errors
+		if meta.IsNoMatchError(err) {
+			return err
+		}
+		return fmt.Errorf("failed to determine scope of %s %q: %w", gvk.Kind, obj.GetName(), err)
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return nil
+	}
+
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	obj.SetNamespace(namespace)
+
+	return nil
+}
+
+// applyObjects applies all CustomResourceDefinitions first, so that custom resources of the
+// same addon can be applied afterwards. If the new types are not yet served by the API server,
+// an error is returned and applying is retried with the next reconciliation.
+func applyObjects(ctx context.Context, client ctrlruntimeclient.Client, objects []*metav1unstructured.Unstructured, namespace string) error {
+	var crds, others []*metav1unstructured.Unstructured
+	for _, obj := range objects {
+		if obj.GroupVersionKind().GroupKind() == crdGroupKind {
+			crds = append(crds, obj)
+		} else {
+			others = append(others, obj)
+		}
+	}
+
+	if err := applyObjectsInOrder(ctx, client, crds, namespace); err != nil {
+		return err
+	}
+
+	// make sure the mapper learns about the types that were just created
+	if len(crds) > 0 {
+		if mapper, ok := client.RESTMapper().(meta.ResettableRESTMapper); ok {
+			mapper.Reset()
+		}
+	}
+
+	return applyObjectsInOrder(ctx, client, others, namespace)
+}
+
+func applyObjectsInOrder(ctx context.Context, client ctrlruntimeclient.Client, objects []*metav1unstructured.Unstructured, namespace string) error {
+	mapper := client.RESTMapper()
+
+	for _, obj := range objects {
+		if err := defaultObjectNamespace(mapper, obj, namespace); err != nil {
+			if meta.IsNoMatchError(err) {
+				return fmt.Errorf("%s %s is not yet served by the API server, retrying later: %w", obj.GetKind(), objectName(obj), err)
+			}
+			return err
+		}
+
+		if err := client.Patch(ctx, obj, ctrlruntimeclient.Apply, ctrlruntimeclient.FieldOwner(ControllerName), ctrlruntimeclient.ForceOwnership); err != nil {
+			return fmt.Errorf("failed to apply %s %s: %w", obj.GetKind(), objectName(obj), err)
+		}
+	}
+
+	return nil
+}
+
+// pruneObjects deletes all objects matching the selector that are not part of the
+// given objects. Like kubectl, only the types from the prune allowlist are considered.
+func pruneObjects(ctx context.Context, log *zap.SugaredLogger, client ctrlruntimeclient.Client, objects []*metav1unstructured.Unstructured, selector labels.Selector, namespace string) error {
+	wanted := sets.NewString()
+	for _, obj := range objects {
+		wanted.Insert(objectKey(obj))
+	}
+
+	allowlist := clusterPruneAllowlist
+	listOpts := []ctrlruntimeclient.ListOption{ctrlruntimeclient.MatchingLabelsSelector{Selector: selector}}
+
+	// restrict pruning to the namespace and never prune cluster-scoped types
+	if namespace != "" {
+		allowlist = namespacedPruneAllowlist
+		listOpts = append(listOpts, ctrlruntimeclient.InNamespace(namespace))
+	}
+
+	for _, resource := range allowlist {
+		gvk, err := parsePruneResource(resource)
+		if err != nil {
+			return err
+		}
+
+		list := &metav1unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := client.List(ctx, list, listOpts...); err != nil {
+			// the type might not be served by the usercluster's Kubernetes version
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("failed to list %s: %w", resource, err)
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if obj.GetDeletionTimestamp() != nil || wanted.Has(objectKey(obj)) {
+				continue
+			}
+
+			log.Debugw("Pruning resource", "kind", obj.GetKind(), "name", objectName(obj))
+			if err := client.Delete(ctx, obj); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("failed to prune %s %s: %w", obj.GetKind(), objectName(obj), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parsePruneResource parses a "group/version/Kind" string as used by kubectl's prune allowlist.
+func parsePruneResource(resource string) (schema.GroupVersionKind, error) {
+	parts := strings.Split(resource, "/")
+	if len(parts) != 3 {
+		return schema.GroupVersionKind{}, fmt.Errorf("invalid prune resource %q, expected group/version/Kind", resource)
+	}
+
+	group := parts[0]
+	if group == "core" {
+		group = ""
+	}
+
+	return schema.GroupVersionKind{Group: group, Version: parts[1], Kind: parts[2]}, nil
+}
+
+// objectKey identifies an object independent of the API version it was read with.
+func objectKey(obj *metav1unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s", obj.GroupVersionKind().GroupKind().String(), objectName(obj))
+}
+
+func objectName(obj *metav1unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
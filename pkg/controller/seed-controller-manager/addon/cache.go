@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"k8c.io/kubermatic/v2/pkg/addon"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// manifestCache holds the most recently rendered manifests for each Addon, so
+// that periodic enforcing does not re-template unchanged addons. Entries are
+// only reused if the hash over all template inputs is unchanged.
+type manifestCache struct {
+	lock    sync.Mutex
+	entries map[types.NamespacedName]manifestCacheEntry
+}
+
+type manifestCacheEntry struct {
+	hash      string
+	manifests []addon.Manifest
+}
+
+// manifestCacheKey is everything that influences the rendered manifests. The
+// template data includes the credentials and admin kubeconfig, so rotating
+// either invalidates the cached manifests.
+type manifestCacheKey struct {
+	AddonName         string
+	OverwriteRegistry string
+	Data              *addon.TemplateData
+}
+
+func hashManifestInputs(addonName, overwriteRegistry string, data *addon.TemplateData) (string, error) {
+	encoded, err := json.Marshal(manifestCacheKey{
+		AddonName:         addonName,
+		OverwriteRegistry: overwriteRegistry,
+		Data:              data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode template inputs: %w", err)
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (c *manifestCache) get(key types.NamespacedName, hash string) ([]addon.Manifest, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || entry.hash != hash {
+		return nil, false
+	}
+
+	return entry.manifests, true
+}
+
+func (c *manifestCache) set(key types.NamespacedName, hash string, manifests []addon.Manifest) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.entries == nil {
+		c.entries = map[types.NamespacedName]manifestCacheEntry{}
+	}
+
+	c.entries[key] = manifestCacheEntry{
+		hash:      hash,
+		manifests: manifests,
+	}
+}
+
+func (c *manifestCache) forget(key types.NamespacedName) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.entries, key)
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// All addon metrics are labelled with the addon name only, so their cardinality
+// is bounded by the number of available addons and not by the number of clusters.
+var (
+	applyDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "kubermatic",
+		Subsystem: "addon_controller",
+		Name:      "apply_duration_seconds",
+		Help:      "The time it took to apply the manifests of an addon to a usercluster",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 20, 30, 60, 120},
+	}, []string{"addon"})
+
+	applyFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kubermatic",
+		Subsystem: "addon_controller",
+		Name:      "apply_failures_total",
+		Help:      "The number of failed attempts to apply the manifests of an addon to a usercluster",
+	}, []string{"addon"})
+
+	cleanupFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kubermatic",
+		Subsystem: "addon_controller",
+		Name:      "cleanup_failures_total",
+		Help:      "The number of failed attempts to delete the manifests of an addon from a usercluster",
+	}, []string{"addon"})
+)
+
+// MustRegisterMetrics registers the addon controller metrics at the given prometheus registry.
+func MustRegisterMetrics(c prometheus.Registerer) {
+	c.MustRegister(applyDuration)
+	c.MustRegister(applyFailures)
+	c.MustRegister(cleanupFailures)
+}
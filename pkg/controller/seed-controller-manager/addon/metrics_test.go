@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"k8c.io/kubermatic/v2/pkg/addon"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// metricsTestClient applies objects like applyTestClient, but can be made to fail
+// and never finds anything to prune.
+type metricsTestClient struct {
+	applyTestClient
+
+	err error
+}
+
+func (c *metricsTestClient) Patch(ctx context.Context, obj ctrlruntimeclient.Object, patch ctrlruntimeclient.Patch, opts ...ctrlruntimeclient.PatchOption) error {
+	if c.err != nil {
+		return c.err
+	}
+	return c.applyTestClient.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *metricsTestClient) List(_ context.Context, _ ctrlruntimeclient.ObjectList, _ ...ctrlruntimeclient.ListOption) error {
+	return nil
+}
+
+type metricsTestKubeconfigProvider struct {
+	fakeKubeconfigProvider
+
+	client ctrlruntimeclient.Client
+}
+
+func (p *metricsTestKubeconfigProvider) GetClient(_ context.Context, _ *kubermaticv1.Cluster, _ ...clusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	return p.client, nil
+}
+
+func TestApplyMetrics(t *testing.T) {
+	testCases := []struct {
+		name             string
+		addonName        string
+		applyErr         error
+		expectedFailures float64
+	}{
+		{
+			name:             "successful apply",
+			addonName:        "metrics-test-success",
+			expectedFailures: 0,
+		},
+		{
+			name:             "failed apply",
+			addonName:        "metrics-test-failure",
+			applyErr:         errors.New("apply failed"),
+			expectedFailures: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mapper := meta.NewDefaultRESTMapper(nil)
+			mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+
+			client := &metricsTestClient{
+				applyTestClient: applyTestClient{mapper: mapper},
+				err:             tc.applyErr,
+			}
+
+			r := &Reconciler{
+				KubeconfigProvider: &metricsTestKubeconfigProvider{client: client},
+			}
+
+			manifests := []addon.Manifest{{
+				Content: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"}}`)},
+			}}
+
+			durationSeries := testutil.CollectAndCount(applyDuration)
+
+			err := r.ensureIsInstalledServerSide(context.Background(), kubermaticlog.Logger, setupTestAddon(tc.addonName), setupTestCluster("10.240.16.0/20"), manifests)
+			if (err != nil) != (tc.applyErr != nil) {
+				t.Fatalf("Expected error: %v, got: %v", tc.applyErr != nil, err)
+			}
+
+			if count := testutil.CollectAndCount(applyDuration); count != durationSeries+1 {
+				t.Errorf("Expected the apply duration to be observed for addon %q", tc.addonName)
+			}
+
+			if failures := testutil.ToFloat64(applyFailures.WithLabelValues(tc.addonName)); failures != tc.expectedFailures {
+				t.Errorf("Expected %v apply failures, got %v", tc.expectedFailures, failures)
+			}
+		})
+	}
+}
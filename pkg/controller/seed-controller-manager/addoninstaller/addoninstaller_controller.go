@@ -267,13 +267,15 @@ func (r *Reconciler) ensureAddons(ctx context.Context, log *zap.SugaredLogger, c
 			}
 		} else {
 			addonLog.Debug("Addon already exists")
-			if !reflect.DeepEqual(addon.Labels, existingAddon.Labels) || !reflect.DeepEqual(addon.Annotations, existingAddon.Annotations) || !reflect.DeepEqual(addon.Spec.Variables, existingAddon.Spec.Variables) || !reflect.DeepEqual(addon.Spec.RequiredResourceTypes, existingAddon.Spec.RequiredResourceTypes) {
+			if !reflect.DeepEqual(addon.Labels, existingAddon.Labels) || !reflect.DeepEqual(addon.Annotations, existingAddon.Annotations) || !reflect.DeepEqual(addon.Spec.Variables, existingAddon.Spec.Variables) || !reflect.DeepEqual(addon.Spec.RequiredResourceTypes, existingAddon.Spec.RequiredResourceTypes) || !reflect.DeepEqual(addon.Spec.Requires, existingAddon.Spec.Requires) || addon.Spec.OverwriteRegistry != existingAddon.Spec.OverwriteRegistry {
 				updatedAddon := existingAddon.DeepCopy()
 				updatedAddon.Labels = addon.Labels
 				updatedAddon.Annotations = addon.Annotations
 				updatedAddon.Spec.Name = addon.Name
 				updatedAddon.Spec.Variables = addon.Spec.Variables
 				updatedAddon.Spec.RequiredResourceTypes = addon.Spec.RequiredResourceTypes
+				updatedAddon.Spec.Requires = addon.Spec.Requires
+				updatedAddon.Spec.OverwriteRegistry = addon.Spec.OverwriteRegistry
 				updatedAddon.Spec.IsDefault = true
 				if err := r.Patch(ctx, updatedAddon, ctrlruntimeclient.MergeFrom(existingAddon)); err != nil {
 					return fmt.Errorf("failed to update addon %q: %w", addon.Name, err)
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -53,6 +54,9 @@ import (
 
 const (
 	ControllerName = "kkp-cloud-controller"
+	// providerHealingInterval is the minimum time between two drift healings of the cloud
+	// provider resources of a cluster, for providers that support it.
+	providerHealingInterval = 10 * time.Minute
 	// icmpMigrationRevision is the migration revision that will be set on the cluster after its
 	// security group was migrated to contain allow rules for ICMP.
 	icmpMigrationRevision = 1
@@ -77,6 +81,12 @@ type Reconciler struct {
 	workerName string
 	versions   kubermatic.Versions
 	caBundle   *x509.CertPool
+
+	// lastHealing remembers when the cloud provider resources of a cluster have been healed
+	// the last time. This is kept in memory only, as healing is cheap and can safely be
+	// repeated after a restart.
+	lastHealing     map[string]time.Time
+	lastHealingLock sync.Mutex
 }
 
 func Add(
@@ -96,6 +106,8 @@ func Add(
 		workerName: workerName,
 		versions:   versions,
 		caBundle:   caBundle,
+
+		lastHealing: map[string]time.Time{},
 	}
 
 	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: numWorkers})
@@ -156,6 +168,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 
 	if cluster.DeletionTimestamp != nil {
 		log.Debug("Cleaning up cloud provider")
+		r.forgetHealing(cluster.Name)
 
 		// in-cluster resources, like nodes, are still being cleaned up
 		if kuberneteshelper.HasAnyFinalizer(cluster, apiv1.InClusterLBCleanupFinalizer, apiv1.InClusterPVCleanupFinalizer, apiv1.NodeDeletionFinalizer) {
@@ -201,6 +214,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	// To prevent reconciling right after initialization (would cause a bunch of unneeded API
 	// calls), we distinguish early between the providers and use _only_ reconciling when
 	// provider implements it.
+	var result *reconcile.Result
 	if betterProvider, ok := prov.(provider.ReconcilingCloudProvider); ok {
 		last := cluster.Status.LastProviderReconciliation
 
@@ -234,6 +248,24 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 
 			// update metrics
 			successfulProviderReconciliations.WithLabelValues(cluster.Name, providerName).Inc()
+
+			// a full reconciliation includes healing
+			r.rememberHealing(cluster.Name)
+		} else if healingProvider, ok := prov.(provider.HealingCloudProvider); ok && r.healingDue(cluster.Name) {
+			// In between full reconciliations, providers can cheaply heal drift on existing resources.
+			log.Debug("Healing cloud provider resources for cluster")
+
+			cluster, err = healingProvider.HealCloudProvider(ctx, cluster, r.clusterUpdater)
+			if err != nil {
+				return handleProviderError(err)
+			}
+
+			r.rememberHealing(cluster.Name)
+		}
+
+		if _, ok := prov.(provider.HealingCloudProvider); ok {
+			// ensure healing happens periodically, even if the cluster does not change
+			result = &reconcile.Result{RequeueAfter: providerHealingInterval}
 		}
 	} else {
 		// the provider only offers a one-time init :-(
@@ -249,7 +281,31 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		return nil, fmt.Errorf("failed to set cluster health: %w", err)
 	}
 
-	return nil, nil
+	return result, nil
+}
+
+// healingDue returns true if the cloud provider resources of the cluster have not been
+// healed within the last providerHealingInterval.
+func (r *Reconciler) healingDue(clusterName string) bool {
+	r.lastHealingLock.Lock()
+	defer r.lastHealingLock.Unlock()
+
+	last, ok := r.lastHealing[clusterName]
+	return !ok || time.Since(last) >= providerHealingInterval
+}
+
+func (r *Reconciler) rememberHealing(clusterName string) {
+	r.lastHealingLock.Lock()
+	defer r.lastHealingLock.Unlock()
+
+	r.lastHealing[clusterName] = time.Now()
+}
+
+func (r *Reconciler) forgetHealing(clusterName string) {
+	r.lastHealingLock.Lock()
+	defer r.lastHealingLock.Unlock()
+
+	delete(r.lastHealing, clusterName)
 }
 
 func (r *Reconciler) migrateICMP(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster, cloudProvider provider.CloudProvider) error {
@@ -916,7 +916,7 @@ func (r *Reconciler) backupJob(backupConfig *kubermaticv1.EtcdBackupConfig, clus
 					Value: "/etc/etcd/client/backup-etcd-client.key",
 				},
 			},
-			Command: snapshotCommand(endpoints),
+			Command: snapshotCommand(endpoints, backupConfig.Spec.Compress),
 			VolumeMounts: []corev1.VolumeMount{
 				{
 					Name:      SharedVolumeName,
@@ -1080,7 +1080,7 @@ func (r *Reconciler) jobBase(backupConfig *kubermaticv1.EtcdBackupConfig, cluste
 	}
 }
 
-func snapshotCommand(etcdEndpoints []string) []string {
+func snapshotCommand(etcdEndpoints []string, compress bool) []string {
 	cmd := []string{
 		"/bin/sh",
 		"-c",
@@ -1097,8 +1097,15 @@ func snapshotCommand(etcdEndpoints []string) []string {
   echo "Successfully created backup, exiting"
   exit 0
 }`)
+	// the compressed snapshot replaces the original file, so the store container
+	// does not need to know whether compression is enabled; the operators are quoted
+	// so they are only evaluated inside backupOrReportFailure
+	compressCommand := ""
+	if compress {
+		compressCommand = " '&&' gzip /backup/snapshot.db '&&' mv /backup/snapshot.db.gz /backup/snapshot.db"
+	}
 	for _, endpoint := range etcdEndpoints {
-		_, _ = script.WriteString(fmt.Sprintf("\nbackupOrReportFailure etcdctl --endpoints %s snapshot save /backup/snapshot.db", endpoint))
+		_, _ = script.WriteString(fmt.Sprintf("\nbackupOrReportFailure etcdctl --endpoints %s snapshot save /backup/snapshot.db%s", endpoint, compressCommand))
 	}
 	_, _ = script.WriteString("\necho \"Unable to create backup\"\nexit 1")
 	cmd = append(cmd, script.String())
@@ -24,6 +24,7 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources/address"
+	"k8c.io/kubermatic/v2/pkg/validation"
 )
 
 // syncAddress will set the all address relevant fields on the cluster.
@@ -44,6 +45,9 @@ func (r *Reconciler) syncAddress(ctx context.Context, log *zap.SugaredLogger, cl
 	b := address.NewModifiersBuilder(log)
 	// we only need providing the agentIP if the Tunneling strategy is used.
 	if cluster.Spec.ExposeStrategy == kubermaticv1.ExposeStrategyTunneling {
+		if err := validation.ValidateTunnelingAgentIP(r.tunnelingAgentIP, cluster.Spec.ClusterNetwork); err != nil {
+			return err
+		}
 		b.TunnelingAgentIP(r.tunnelingAgentIP)
 	}
 	modifiers, err := b.
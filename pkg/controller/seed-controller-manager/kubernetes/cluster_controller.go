@@ -34,6 +34,8 @@ import (
 	"k8c.io/kubermatic/v2/pkg/provider"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -96,7 +98,11 @@ type Reconciler struct {
 	machineControllerImageTag        string
 	machineControllerImageRepository string
 	concurrentClusterUpdates         int
+	concurrencyPartition             controllerutil.ConcurrencyPartition
+	clusterErrorGracePeriod          time.Duration
 	backupSchedule                   time.Duration
+	lbCleanupTimeout                 time.Duration
+	forceLBCleanup                   bool
 
 	oidcIssuerURL      string
 	oidcIssuerClientID string
@@ -124,7 +130,11 @@ func Add(
 	userClusterMLAEnabled bool,
 	dockerPullConfigJSON []byte,
 	concurrentClusterUpdates int,
+	concurrencyPartition controllerutil.ConcurrencyPartition,
+	clusterErrorGracePeriod time.Duration,
 	backupSchedule time.Duration,
+	lbCleanupTimeout time.Duration,
+	forceLBCleanup bool,
 
 	oidcIssuerURL string,
 	oidcIssuerClientID string,
@@ -159,7 +169,11 @@ func Add(
 		machineControllerImageTag:        machineControllerImageTag,
 		machineControllerImageRepository: machineControllerImageRepository,
 		concurrentClusterUpdates:         concurrentClusterUpdates,
+		concurrencyPartition:             concurrencyPartition,
+		clusterErrorGracePeriod:          clusterErrorGracePeriod,
 		backupSchedule:                   backupSchedule,
+		lbCleanupTimeout:                 lbCleanupTimeout,
+		forceLBCleanup:                   forceLBCleanup,
 
 		externalURL:  externalURL,
 		seedGetter:   seedGetter,
@@ -202,7 +216,7 @@ func Add(
 		}
 	}
 
-	return c.Watch(&source.Kind{Type: &kubermaticv1.Cluster{}}, &handler.EnqueueRequestForObject{})
+	return c.Watch(&source.Kind{Type: &kubermaticv1.Cluster{}}, &handler.EnqueueRequestForObject{}, workerlabel.Predicates(workerName))
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -219,6 +233,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 	log = log.With("cluster", cluster.Name)
 
+	if cluster.Annotations[kubermaticv1.DryRunReconcileAnnotation] == "true" && cluster.DeletionTimestamp == nil {
+		return reconcile.Result{}, r.reconcileDryRun(ctx, log, cluster)
+	}
+
 	// ensure new Cluster objects have basic status information set;
 	// this should be done regardless of ClusterAvailableForReconciling()
 	// and hence outside the ClusterReconcileWrapper
@@ -237,6 +255,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	// while paused, the ClusterControllerReconcilingSuccess condition is managed by
+	// setReconcilingEnabledCondition, as nothing is actually reconciled
+	conditionType := kubermaticv1.ClusterConditionClusterControllerReconcilingSuccess
+	if reconcilingPaused(cluster) {
+		conditionType = kubermaticv1.ClusterConditionNone
+	}
+
 	// Add a wrapping here so we can emit an event on error
 	result, err := kubermaticv1helper.ClusterReconcileWrapper(
 		ctx,
@@ -244,11 +269,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		r.workerName,
 		cluster,
 		r.versions,
-		kubermaticv1.ClusterConditionClusterControllerReconcilingSuccess,
+		conditionType,
 		func() (*reconcile.Result, error) {
 			// only reconcile this cluster if there are not yet too many updates running
-			if available, err := controllerutil.ClusterAvailableForReconciling(ctx, r, cluster, r.concurrentClusterUpdates); !available || err != nil {
-				log.Infow("Concurrency limit reached, checking again in 10 seconds", "concurrency-limit", r.concurrentClusterUpdates)
+			if available, err := controllerutil.ClusterAvailableForReconciling(ctx, r, cluster, r.concurrentClusterUpdates, r.concurrencyPartition); !available || err != nil {
+				log.Infow("Concurrency limit reached, checking again in 10 seconds", "concurrency-limit", r.concurrentClusterUpdates, "concurrency-partition", r.concurrencyPartition)
 				return &reconcile.Result{
 					RequeueAfter: 10 * time.Second,
 				}, err
@@ -269,6 +294,37 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return *result, err
 }
 
+// reconcileDryRun runs the regular reconciling against a dry-run client, so that every
+// change is validated by the API server but never persisted, and reports the changes
+// that would have been made. The cluster status is left untouched.
+func (r *Reconciler) reconcileDryRun(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) error {
+	if cluster.Status.Versions.ControlPlane == "" {
+		log.Debug("Cluster not yet ready for reconciling")
+		return nil
+	}
+
+	dryRun := *r
+	dryRun.Client = ctrlruntimeclient.NewDryRunClient(r.Client)
+
+	ctx = reconciling.WithDryRun(ctx)
+
+	// work on a copy, as the dry-run requests still return the would-be objects
+	_, err := dryRun.reconcileCluster(ctx, cluster.DeepCopy())
+
+	// every change has already been logged by the reconciling package
+	changes := reconciling.DryRunChanges(ctx)
+
+	if err != nil {
+		log.Errorw("Dry-run reconciling failed", zap.Error(err))
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "DryRunReconcilingError", err.Error())
+		return err
+	}
+
+	r.recorder.Eventf(cluster, corev1.EventTypeNormal, "DryRunReconcile", "%d resources would be changed", len(changes))
+
+	return nil
+}
+
 func (r *Reconciler) reconcileClusterStatus(ctx context.Context, cluster *kubermaticv1.Cluster) error {
 	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 		if c.Status.NamespaceName == "" {
@@ -296,11 +352,30 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 		}
 
 		// Always requeue a cluster after we executed the cleanup.
-		return &reconcile.Result{RequeueAfter: 10 * time.Second}, clusterdeletion.New(r.Client, userClusterClientGetter).CleanupCluster(ctx, log, cluster)
+		return &reconcile.Result{RequeueAfter: 10 * time.Second}, clusterdeletion.New(r.Client, r.recorder, userClusterClientGetter).
+			WithLBCleanupTimeout(r.lbCleanupTimeout, r.forceLBCleanup).
+			CleanupCluster(ctx, log, cluster)
+	}
+
+	// keep syncing the health, but do not touch any of the cluster's resources while paused
+	paused := reconcilingPaused(cluster)
+	if err := r.setReconcilingEnabledCondition(ctx, cluster, !paused); err != nil {
+		return nil, fmt.Errorf("failed to set reconciling condition: %w", err)
+	}
+	if paused {
+		log.Debug("Reconciling is paused")
+		return nil, nil
 	}
 
 	res, err := r.reconcileCluster(ctx, cluster)
 	if err != nil {
+		// transient errors, e.g. during control plane restarts, should not
+		// immediately be reported on the cluster status
+		if r.withinErrorGracePeriod(cluster) {
+			log.Debugw("Reconciling failed, but still within the grace period", zap.Error(err))
+			return nil, fmt.Errorf("failed to reconcile cluster: %w", err)
+		}
+
 		updateErr := r.updateClusterError(ctx, cluster, kubermaticv1.ReconcileClusterError, err.Error())
 		if updateErr != nil {
 			return nil, fmt.Errorf("failed to set the cluster error: %w", updateErr)
@@ -315,6 +390,52 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 	return res, nil
 }
 
+const reasonReconcilingPaused = "ReconcilingPaused"
+
+// reconcilingPaused returns true if reconciling the cluster is paused via the
+// PauseReconcileAnnotation. Deleting clusters are always cleaned up.
+func reconcilingPaused(cluster *kubermaticv1.Cluster) bool {
+	return cluster.DeletionTimestamp == nil && cluster.Annotations[kubermaticv1.PauseReconcileAnnotation] == "true"
+}
+
+// setReconcilingEnabledCondition reflects whether reconciling is paused in the cluster's
+// ReconcilingEnabled condition and emits an event whenever reconciling is paused or resumed.
+// While paused, the ClusterControllerReconcilingSuccess condition is set to false as well,
+// as the cluster's resources are not being reconciled.
+func (r *Reconciler) setReconcilingEnabledCondition(ctx context.Context, cluster *kubermaticv1.Cluster, enabled bool) error {
+	condition, exists := cluster.Status.Conditions[kubermaticv1.ClusterConditionReconcilingEnabled]
+	wasEnabled := !exists || condition.Status == corev1.ConditionTrue
+	if exists && enabled == wasEnabled {
+		return nil
+	}
+
+	status := corev1.ConditionTrue
+	reason := "ReconcilingResumed"
+	message := "Reconciling has been resumed."
+	if !enabled {
+		status = corev1.ConditionFalse
+		reason = reasonReconcilingPaused
+		message = fmt.Sprintf("Reconciling is paused via the %s annotation.", kubermaticv1.PauseReconcileAnnotation)
+	}
+
+	err := kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionReconcilingEnabled, status, reason, message)
+		if !enabled {
+			kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionClusterControllerReconcilingSuccess, corev1.ConditionFalse, reason, message)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// only pausing and resuming are worth an event, not enabling the condition initially
+	if enabled != wasEnabled {
+		r.recorder.Event(cluster, corev1.EventTypeNormal, reason, message)
+	}
+
+	return nil
+}
+
 func (r *Reconciler) updateCluster(ctx context.Context, cluster *kubermaticv1.Cluster, modify func(*kubermaticv1.Cluster), opts ...ctrlruntimeclient.MergeFromOption) error {
 	oldCluster := cluster.DeepCopy()
 	modify(cluster)
@@ -349,6 +470,34 @@ func (r *Reconciler) updateClusterError(ctx context.Context, cluster *kubermatic
 	return nil
 }
 
+// withinErrorGracePeriod returns true if the cluster has not been failing to reconcile
+// for longer than the configured grace period. The start of the failures is determined
+// by the last transition of the ClusterControllerReconcilingSuccess condition.
+func (r *Reconciler) withinErrorGracePeriod(cluster *kubermaticv1.Cluster) bool {
+	if r.clusterErrorGracePeriod <= 0 {
+		return false
+	}
+
+	// the condition is only updated after this reconciliation, so a successful
+	// condition means the failures have just started
+	condition, exists := cluster.Status.Conditions[kubermaticv1.ClusterConditionClusterControllerReconcilingSuccess]
+	if !exists || condition.Status == corev1.ConditionTrue {
+		return true
+	}
+
+	// the condition is also false while reconciling was paused, which is not a failure
+	if condition.Reason == reasonReconcilingPaused {
+		return true
+	}
+
+	failingSince := condition.LastTransitionTime
+	if failingSince.IsZero() {
+		failingSince = condition.LastHeartbeatTime
+	}
+
+	return time.Since(failingSince.Time) < r.clusterErrorGracePeriod
+}
+
 func (r *Reconciler) clearClusterError(ctx context.Context, cluster *kubermaticv1.Cluster) error {
 	return kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
 		c.Status.ErrorMessage = nil
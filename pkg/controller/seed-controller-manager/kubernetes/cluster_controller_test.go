@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWithinErrorGracePeriod(t *testing.T) {
+	testCases := []struct {
+		name        string
+		gracePeriod time.Duration
+		condition   *kubermaticv1.ClusterCondition
+		expected    bool
+	}{
+		{
+			name:        "grace period disabled",
+			gracePeriod: 0,
+			condition: &kubermaticv1.ClusterCondition{
+				Status: corev1.ConditionTrue,
+			},
+			expected: false,
+		},
+		{
+			name:        "no condition yet",
+			gracePeriod: 2 * time.Minute,
+			expected:    true,
+		},
+		{
+			name:        "previous reconciliation was successful",
+			gracePeriod: 2 * time.Minute,
+			condition: &kubermaticv1.ClusterCondition{
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			expected: true,
+		},
+		{
+			name:        "failing for less than the grace period",
+			gracePeriod: 2 * time.Minute,
+			condition: &kubermaticv1.ClusterCondition{
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			},
+			expected: true,
+		},
+		{
+			name:        "failing for longer than the grace period",
+			gracePeriod: 2 * time.Minute,
+			condition: &kubermaticv1.ClusterCondition{
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+			},
+			expected: false,
+		},
+		{
+			name:        "failing since the condition was created",
+			gracePeriod: 2 * time.Minute,
+			condition: &kubermaticv1.ClusterCondition{
+				Status:            corev1.ConditionFalse,
+				LastHeartbeatTime: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+			},
+			expected: false,
+		},
+		{
+			name:        "resumed after a long pause",
+			gracePeriod: 2 * time.Minute,
+			condition: &kubermaticv1.ClusterCondition{
+				Status:             corev1.ConditionFalse,
+				Reason:             reasonReconcilingPaused,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{}
+			if tc.condition != nil {
+				cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+					kubermaticv1.ClusterConditionClusterControllerReconcilingSuccess: *tc.condition,
+				}
+			}
+
+			r := &Reconciler{
+				clusterErrorGracePeriod: tc.gracePeriod,
+			}
+
+			if result := r.withinErrorGracePeriod(cluster); result != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestSetReconcilingEnabledCondition(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		existingStatus           corev1.ConditionStatus
+		enabled                  bool
+		expectedStatus           corev1.ConditionStatus
+		expectedReconciledStatus corev1.ConditionStatus
+		expectEvent              bool
+	}{
+		{
+			name:                     "never paused",
+			enabled:                  true,
+			expectedStatus:           corev1.ConditionTrue,
+			expectedReconciledStatus: corev1.ConditionTrue,
+			expectEvent:              false,
+		},
+		{
+			name:                     "pausing",
+			existingStatus:           corev1.ConditionTrue,
+			enabled:                  false,
+			expectedStatus:           corev1.ConditionFalse,
+			expectedReconciledStatus: corev1.ConditionFalse,
+			expectEvent:              true,
+		},
+		{
+			name:                     "paused before the condition existed",
+			enabled:                  false,
+			expectedStatus:           corev1.ConditionFalse,
+			expectedReconciledStatus: corev1.ConditionFalse,
+			expectEvent:              true,
+		},
+		{
+			name:                     "still paused",
+			existingStatus:           corev1.ConditionFalse,
+			enabled:                  false,
+			expectedStatus:           corev1.ConditionFalse,
+			expectedReconciledStatus: corev1.ConditionTrue,
+			expectEvent:              false,
+		},
+		{
+			name:                     "resuming",
+			existingStatus:           corev1.ConditionFalse,
+			enabled:                  true,
+			expectedStatus:           corev1.ConditionTrue,
+			expectedReconciledStatus: corev1.ConditionTrue,
+			expectEvent:              true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-a",
+				},
+			}
+			cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+				kubermaticv1.ClusterConditionClusterControllerReconcilingSuccess: {Status: corev1.ConditionTrue},
+			}
+			if tc.existingStatus != "" {
+				cluster.Status.Conditions[kubermaticv1.ClusterConditionReconcilingEnabled] = kubermaticv1.ClusterCondition{Status: tc.existingStatus}
+			}
+
+			client := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(cluster).
+				Build()
+
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{
+				Client:   client,
+				log:      kubermaticlog.Logger,
+				recorder: recorder,
+			}
+
+			ctx := context.Background()
+			if err := r.setReconcilingEnabledCondition(ctx, cluster, tc.enabled); err != nil {
+				t.Fatalf("Failed to set reconciling condition: %v", err)
+			}
+
+			updated := &kubermaticv1.Cluster{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(cluster), updated); err != nil {
+				t.Fatalf("Failed to get cluster: %v", err)
+			}
+
+			if status := updated.Status.Conditions[kubermaticv1.ClusterConditionReconcilingEnabled].Status; status != tc.expectedStatus {
+				t.Errorf("Expected condition status %q, got %q", tc.expectedStatus, status)
+			}
+
+			if status := updated.Status.Conditions[kubermaticv1.ClusterConditionClusterControllerReconcilingSuccess].Status; status != tc.expectedReconciledStatus {
+				t.Errorf("Expected reconciled condition status %q, got %q", tc.expectedReconciledStatus, status)
+			}
+
+			if hasEvent := len(recorder.Events) > 0; hasEvent != tc.expectEvent {
+				t.Errorf("Expected event: %v, got: %v", tc.expectEvent, hasEvent)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+const (
+	componentNamespace           = "namespace"
+	componentServices            = "services"
+	componentSecrets             = "secrets"
+	componentRBAC                = "rbac"
+	componentNetworkPolicies     = "network-policies"
+	componentEtcd                = "etcd"
+	componentEtcdBackupConfigs   = "etcd-backup-configs"
+	componentConfigMaps          = "configmaps"
+	componentDeployments         = "deployments"
+	componentCronJobs            = "cronjobs"
+	componentPodDisruptionBudget = "pod-disruption-budgets"
+	componentVPA                 = "vertical-pod-autoscalers"
+)
+
+// componentReconcileDuration is deliberately not labelled with the cluster name,
+// so its cardinality is bounded by the number of components and phases.
+var componentReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "kubermatic",
+	Subsystem: "cluster_controller",
+	Name:      "component_reconcile_duration_seconds",
+	Help:      "The time it took to reconcile a control plane component of a usercluster",
+	Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+}, []string{"component", "phase"})
+
+// MustRegisterMetrics registers the cluster controller metrics at the given prometheus registry.
+func MustRegisterMetrics(c prometheus.Registerer) {
+	c.MustRegister(componentReconcileDuration)
+}
+
+// observeComponentReconcileDuration records the time since start for the given
+// component; it is meant to be deferred at the beginning of a reconcile function.
+func observeComponentReconcileDuration(component string, cluster *kubermaticv1.Cluster, start time.Time) {
+	componentReconcileDuration.WithLabelValues(component, string(cluster.Status.Phase)).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestComponentReconcileDurationIsObserved(t *testing.T) {
+	// use a phase no other test uses, so that the observed series is new
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-a",
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-a",
+			Phase:         kubermaticv1.ClusterPhase("MetricsTest"),
+		},
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: cluster.Status.NamespaceName,
+		},
+	}
+
+	r := &Reconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(namespace).
+			Build(),
+	}
+
+	series := testutil.CollectAndCount(componentReconcileDuration)
+
+	if _, err := r.ensureNamespaceExists(context.Background(), cluster); err != nil {
+		t.Fatalf("Failed to ensure namespace: %v", err)
+	}
+
+	if count := testutil.CollectAndCount(componentReconcileDuration); count != series+1 {
+		t.Errorf("Expected the reconcile duration of the %q component to be observed, got %d new series", componentNamespace, count-series)
+	}
+}
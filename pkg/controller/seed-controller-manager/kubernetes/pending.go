@@ -53,8 +53,15 @@ func (r *Reconciler) reconcileCluster(ctx context.Context, cluster *kubermaticv1
 		return nil, err
 	}
 
+	// Control plane version updates are disruptive and only rolled out during the
+	// cluster's update window; all other changes are still reconciled right away.
+	reconcilingCluster, deferredFor, err := r.deferControlPlaneUpdate(ctx, cluster, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// Deploy & Update master components for Kubernetes
-	res, err := r.ensureResourcesAreDeployed(ctx, cluster, namespace)
+	res, err := r.ensureResourcesAreDeployed(ctx, reconcilingCluster, namespace)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +96,7 @@ func (r *Reconciler) reconcileCluster(ctx context.Context, cluster *kubermaticv1
 		return r.AddFinalizers(ctx, cluster, finalizers...)
 	}
 
-	return &reconcile.Result{}, nil
+	return &reconcile.Result{RequeueAfter: deferredFor}, nil
 }
 
 // ensureEtcdLauncherFeatureFlag will apply seed controller etcdLauncher setting on the cluster level.
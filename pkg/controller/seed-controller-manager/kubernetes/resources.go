@@ -49,9 +49,11 @@ import (
 	webterminal "k8c.io/kubermatic/v2/pkg/resources/web-terminal"
 
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
@@ -109,8 +111,14 @@ func (r *Reconciler) ensureResourcesAreDeployed(ctx context.Context, cluster *ku
 	// check that all StatefulSets are created
 	if ok, err := r.statefulSetHealthCheck(ctx, cluster); !ok || err != nil {
 		r.log.Info("Skipping reconcile for StatefulSets, not healthy yet")
-	} else if err := r.ensureStatefulSets(ctx, cluster, data); err != nil {
-		return nil, err
+	} else {
+		if err := r.ensureStatefulSets(ctx, cluster, data); err != nil {
+			return nil, err
+		}
+
+		if err := r.ensureEtcdVolumeSize(ctx, cluster); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := r.ensureEtcdBackupConfigs(ctx, cluster, data, seed); err != nil {
@@ -230,6 +238,8 @@ func (r *Reconciler) getClusterTemplateData(ctx context.Context, cluster *kuberm
 		WithDnatControllerImage(r.dnatControllerImage).
 		WithMachineControllerImageTag(r.machineControllerImageTag).
 		WithMachineControllerImageRepository(r.machineControllerImageRepository).
+		WithOperatingSystemManagerImageTag(config.Spec.UserCluster.OperatingSystemManager.ImageTag).
+		WithOperatingSystemManagerImageRepository(config.Spec.UserCluster.OperatingSystemManager.ImageRepository).
 		WithBackupPeriod(r.backupSchedule).
 		WithFailureDomainZoneAntiaffinity(supportsFailureDomainZoneAntiAffinity).
 		WithVersions(r.versions).
@@ -238,6 +248,8 @@ func (r *Reconciler) getClusterTemplateData(ctx context.Context, cluster *kuberm
 
 // ensureNamespaceExists will create the cluster namespace.
 func (r *Reconciler) ensureNamespaceExists(ctx context.Context, cluster *kubermaticv1.Cluster) (*corev1.Namespace, error) {
+	defer observeComponentReconcileDuration(componentNamespace, cluster, time.Now())
+
 	ns := &corev1.Namespace{}
 	err := r.Get(ctx, types.NamespacedName{Name: cluster.Status.NamespaceName}, ns)
 	if err == nil {
@@ -295,6 +307,8 @@ func GetServiceCreators(data *resources.TemplateData) []reconciling.NamedService
 }
 
 func (r *Reconciler) ensureServices(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentServices, c, time.Now())
+
 	creators := GetServiceCreators(data)
 	return reconciling.ReconcileServices(ctx, creators, c.Status.NamespaceName, r)
 }
@@ -348,8 +362,10 @@ func GetDeploymentCreators(data *resources.TemplateData, enableAPIserverOIDCAuth
 }
 
 func (r *Reconciler) ensureDeployments(ctx context.Context, cluster *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentDeployments, cluster, time.Now())
+
 	creators := GetDeploymentCreators(data, r.features.KubernetesOIDCAuthentication)
-	return reconciling.ReconcileDeployments(ctx, creators, cluster.Status.NamespaceName, r)
+	return reconciling.ReconcileDeployments(ctx, creators, cluster.Status.NamespaceName, r, resources.ImagePullSecretRevisionWrapper(r.dockerPullConfigJSON))
 }
 
 // GetSecretCreators returns all SecretCreators that are currently in use.
@@ -423,6 +439,8 @@ func (r *Reconciler) GetSecretCreators(data *resources.TemplateData) []reconcili
 }
 
 func (r *Reconciler) ensureSecrets(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentSecrets, c, time.Now())
+
 	namedSecretCreatorGetters := r.GetSecretCreators(data)
 
 	if err := reconciling.ReconcileSecrets(ctx, namedSecretCreatorGetters, c.Status.NamespaceName, r.Client); err != nil {
@@ -502,7 +520,7 @@ func (r *Reconciler) ensureClusterRoles(ctx context.Context) error {
 		usercluster.ClusterRole(),
 		userclusterwebhook.ClusterRole(),
 	}
-	if err := reconciling.ReconcileClusterRoles(ctx, namedClusterRoleCreatorGetters, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoles(ctx, namedClusterRoleCreatorGetters, r.Client); err != nil {
 		return fmt.Errorf("failed to ensure Cluster Roles: %w", err)
 	}
 
@@ -514,7 +532,7 @@ func (r *Reconciler) ensureClusterRoleBindings(ctx context.Context, c *kubermati
 		usercluster.ClusterRoleBinding(namespace),
 		userclusterwebhook.ClusterRoleBinding(namespace),
 	}
-	if err := reconciling.ReconcileClusterRoleBindings(ctx, namedClusterRoleBindingsCreatorGetters, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoleBindings(ctx, namedClusterRoleBindingsCreatorGetters, r.Client); err != nil {
 		return fmt.Errorf("failed to ensure Cluster Role Bindings: %w", err)
 	}
 
@@ -522,6 +540,8 @@ func (r *Reconciler) ensureClusterRoleBindings(ctx context.Context, c *kubermati
 }
 
 func (r *Reconciler) ensureNetworkPolicies(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentNetworkPolicies, c, time.Now())
+
 	if c.Spec.Features[kubermaticv1.ApiserverNetworkPolicy] {
 		namedNetworkPolicyCreatorGetters := []reconciling.NamedNetworkPolicyCreatorGetter{
 			apiserver.DenyAllPolicyCreator(),
@@ -595,6 +615,10 @@ func GetConfigMapCreators(data *resources.TemplateData) []reconciling.NamedConfi
 		)
 	}
 
+	if sa := data.Cluster().Spec.ServiceAccount; sa != nil && sa.JWKSURI != "" {
+		creators = append(creators, apiserver.ServiceAccountJWKSConfigMapCreator(data))
+	}
+
 	if data.Cluster().Spec.Cloud.VSphere != nil {
 		creators = append(creators, cloudconfig.VsphereCSIConfigMapCreator(data))
 	}
@@ -607,6 +631,8 @@ func GetConfigMapCreators(data *resources.TemplateData) []reconciling.NamedConfi
 }
 
 func (r *Reconciler) ensureConfigMaps(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentConfigMaps, c, time.Now())
+
 	creators := GetConfigMapCreators(data)
 
 	if err := reconciling.ReconcileConfigMaps(ctx, creators, c.Status.NamespaceName, r.Client); err != nil {
@@ -652,6 +678,8 @@ func GetPodDisruptionBudgetCreators(data *resources.TemplateData) []reconciling.
 }
 
 func (r *Reconciler) ensurePodDisruptionBudgets(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentPodDisruptionBudget, c, time.Now())
+
 	creators := GetPodDisruptionBudgetCreators(data)
 
 	if err := reconciling.ReconcilePodDisruptionBudgets(ctx, creators, c.Status.NamespaceName, r.Client); err != nil {
@@ -669,6 +697,8 @@ func GetCronJobCreators(data *resources.TemplateData) []reconciling.NamedCronJob
 }
 
 func (r *Reconciler) ensureCronJobs(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentCronJobs, c, time.Now())
+
 	creators := GetCronJobCreators(data)
 
 	if err := reconciling.ReconcileCronJobs(ctx, creators, c.Status.NamespaceName, r.Client); err != nil {
@@ -679,6 +709,8 @@ func (r *Reconciler) ensureCronJobs(ctx context.Context, c *kubermaticv1.Cluster
 }
 
 func (r *Reconciler) ensureVerticalPodAutoscalers(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentVPA, c, time.Now())
+
 	controlPlaneDeploymentNames := []string{
 		resources.MachineControllerDeploymentName,
 		resources.MachineControllerWebhookDeploymentName,
@@ -703,6 +735,8 @@ func (r *Reconciler) ensureVerticalPodAutoscalers(ctx context.Context, c *kuberm
 }
 
 func (r *Reconciler) ensureStatefulSets(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData) error {
+	defer observeComponentReconcileDuration(componentEtcd, c, time.Now())
+
 	useTLSOnly, err := r.etcdUseStrictTLS(ctx, c)
 	if err != nil {
 		return err
@@ -713,8 +747,77 @@ func (r *Reconciler) ensureStatefulSets(ctx context.Context, c *kubermaticv1.Clu
 	return reconciling.ReconcileStatefulSets(ctx, creators, c.Status.NamespaceName, r.Client)
 }
 
+// ensureEtcdVolumeSize grows the etcd PVCs to the disk size configured in the cluster's
+// etcd override. The volume claim templates of the StatefulSet are immutable, so the
+// PVCs are resized directly, as long as their storage class allows volume expansion.
+// Shrinking volumes is not supported by Kubernetes and smaller sizes are ignored.
+// The controller's default disk size only applies to new clusters.
+func (r *Reconciler) ensureEtcdVolumeSize(ctx context.Context, c *kubermaticv1.Cluster) error {
+	if c.Spec.ComponentsOverride.Etcd.DiskSize == nil {
+		return nil
+	}
+	desiredSize := *c.Spec.ComponentsOverride.Etcd.DiskSize
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := r.List(ctx, pvcs, ctrlruntimeclient.InNamespace(c.Status.NamespaceName), ctrlruntimeclient.MatchingLabels(etcd.GetBasePodLabels(c))); err != nil {
+		return fmt.Errorf("failed to list etcd PVCs: %w", err)
+	}
+
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+
+		currentSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		if desiredSize.Cmp(currentSize) <= 0 {
+			continue
+		}
+
+		expandable, err := r.storageClassAllowsVolumeExpansion(ctx, pvc.Spec.StorageClassName)
+		if err != nil {
+			return err
+		}
+
+		if !expandable {
+			r.recorder.Eventf(c, corev1.EventTypeWarning, "EtcdVolumeResizeUnsupported",
+				"Cannot resize etcd PVC %s to %s because its storage class does not allow volume expansion", pvc.Name, desiredSize.String())
+			continue
+		}
+
+		oldPVC := pvc.DeepCopy()
+		if pvc.Spec.Resources.Requests == nil {
+			pvc.Spec.Resources.Requests = corev1.ResourceList{}
+		}
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = desiredSize
+
+		if err := r.Patch(ctx, pvc, ctrlruntimeclient.MergeFrom(oldPVC)); err != nil {
+			return fmt.Errorf("failed to resize etcd PVC %s: %w", pvc.Name, err)
+		}
+
+		r.log.Infow("Resized etcd PVC", "cluster", c.Name, "pvc", pvc.Name, "from", currentSize.String(), "to", desiredSize.String())
+	}
+
+	return nil
+}
+
+func (r *Reconciler) storageClassAllowsVolumeExpansion(ctx context.Context, storageClassName *string) (bool, error) {
+	if storageClassName == nil || *storageClassName == "" {
+		return false, nil
+	}
+
+	storageClass := &storagev1.StorageClass{}
+	if err := r.Get(ctx, types.NamespacedName{Name: *storageClassName}, storageClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get StorageClass %s: %w", *storageClassName, err)
+	}
+
+	return storageClass.AllowVolumeExpansion != nil && *storageClass.AllowVolumeExpansion, nil
+}
+
 func (r *Reconciler) ensureEtcdBackupConfigs(ctx context.Context, c *kubermaticv1.Cluster, data *resources.TemplateData,
 	seed *kubermaticv1.Seed) error {
+	defer observeComponentReconcileDuration(componentEtcdBackupConfigs, c, time.Now())
+
 	if seed.IsDefaultEtcdAutomaticBackupEnabled() {
 		creators := GetEtcdBackupConfigCreators(data, seed)
 		return reconciling.ReconcileEtcdBackupConfigs(ctx, creators, c.Status.NamespaceName, r.Client)
@@ -808,6 +911,8 @@ func (r *Reconciler) ensureEncryptionConfigurationIsRemoved(ctx context.Context,
 }
 
 func (r *Reconciler) ensureRBAC(ctx context.Context, cluster *kubermaticv1.Cluster, namespace *corev1.Namespace) error {
+	defer observeComponentReconcileDuration(componentRBAC, cluster, time.Now())
+
 	if err := r.ensureServiceAccounts(ctx, cluster); err != nil {
 		return err
 	}
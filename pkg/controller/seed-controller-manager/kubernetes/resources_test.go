@@ -22,16 +22,23 @@ import (
 	"testing"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/apiserver"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates"
 	"k8c.io/kubermatic/v2/pkg/resources/cloudcontroller"
+	"k8c.io/kubermatic/v2/pkg/resources/etcd"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -375,3 +382,115 @@ func (k KCMDeploymentConfig) Create(td *resources.TemplateData) *appsv1.Deployme
 	d.Spec.Template.Spec = *wrappedPodSpec
 	return &d
 }
+
+func TestEnsureEtcdVolumeSize(t *testing.T) {
+	expandableStorageClass := &storagev1.StorageClass{
+		ObjectMeta:           metav1.ObjectMeta{Name: "expandable"},
+		Provisioner:          "test",
+		AllowVolumeExpansion: pointer.Bool(true),
+	}
+	fixedStorageClass := &storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "fixed"},
+		Provisioner: "test",
+	}
+
+	testCases := []struct {
+		name         string
+		storageClass string
+		pvcSize      string
+		overrideSize string
+		expectedSize string
+	}{
+		{
+			name:         "override size matches, nothing to do",
+			storageClass: expandableStorageClass.Name,
+			pvcSize:      "5Gi",
+			overrideSize: "5Gi",
+			expectedSize: "5Gi",
+		},
+		{
+			name:         "no override, the controller default only applies to new clusters",
+			storageClass: expandableStorageClass.Name,
+			pvcSize:      "5Gi",
+			expectedSize: "5Gi",
+		},
+		{
+			name:         "cluster override grows the volume",
+			storageClass: expandableStorageClass.Name,
+			pvcSize:      "5Gi",
+			overrideSize: "20Gi",
+			expectedSize: "20Gi",
+		},
+		{
+			name:         "smaller cluster override does not shrink the volume",
+			storageClass: expandableStorageClass.Name,
+			pvcSize:      "5Gi",
+			overrideSize: "2Gi",
+			expectedSize: "5Gi",
+		},
+		{
+			name:         "storage class does not allow volume expansion",
+			storageClass: fixedStorageClass.Name,
+			pvcSize:      "5Gi",
+			overrideSize: "20Gi",
+			expectedSize: "5Gi",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-a",
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-cluster-a",
+				},
+			}
+			if tc.overrideSize != "" {
+				size := resource.MustParse(tc.overrideSize)
+				cluster.Spec.ComponentsOverride.Etcd.DiskSize = &size
+			}
+
+			pvc := &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "data-etcd-0",
+					Namespace: cluster.Status.NamespaceName,
+					Labels:    etcd.GetBasePodLabels(cluster),
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					StorageClassName: pointer.String(tc.storageClass),
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(tc.pvcSize)},
+					},
+				},
+			}
+
+			client := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(cluster, pvc, expandableStorageClass, fixedStorageClass).
+				Build()
+
+			r := &Reconciler{
+				Client:   client,
+				log:      kubermaticlog.Logger,
+				recorder: record.NewFakeRecorder(10),
+			}
+
+			ctx := context.Background()
+			if err := r.ensureEtcdVolumeSize(ctx, cluster); err != nil {
+				t.Fatalf("Failed to ensure etcd volume size: %v", err)
+			}
+
+			updated := &corev1.PersistentVolumeClaim{}
+			if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(pvc), updated); err != nil {
+				t.Fatalf("Failed to get PVC: %v", err)
+			}
+
+			expected := resource.MustParse(tc.expectedSize)
+			if size := updated.Spec.Resources.Requests[corev1.ResourceStorage]; size.Cmp(expected) != 0 {
+				t.Errorf("Expected PVC size %s, got %s", expected.String(), size.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/coreos/locksmith/pkg/timeutil"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1/helper"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// deferControlPlaneUpdate checks whether an apiserver version update is pending while the cluster's
+// update window is closed. In that case a copy of the cluster is returned that keeps the apiserver (and
+// thereby etcd) at the currently deployed version, together with the duration until the window opens.
+// Otherwise the cluster is returned unchanged and the duration is zero.
+func (r *Reconciler) deferControlPlaneUpdate(ctx context.Context, cluster *kubermaticv1.Cluster, now time.Time) (*kubermaticv1.Cluster, time.Duration, error) {
+	window := cluster.Spec.UpdateWindow
+	if window == nil || window.Start == "" || window.Length == "" {
+		return cluster, 0, r.setControlPlaneUpdateAllowedCondition(ctx, cluster, "")
+	}
+
+	deployed, err := r.deployedApiserverVersion(ctx, cluster)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// nothing has been deployed yet or no update is pending
+	if deployed == nil || deployed.Equal(&cluster.Status.Versions.Apiserver) {
+		return cluster, 0, r.setControlPlaneUpdateAllowedCondition(ctx, cluster, "")
+	}
+
+	periodic, err := timeutil.ParsePeriodic(window.Start, window.Length)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse update window: %w", err)
+	}
+
+	untilWindow := periodic.DurationToStart(now)
+	if untilWindow <= 0 {
+		return cluster, 0, r.setControlPlaneUpdateAllowedCondition(ctx, cluster, "")
+	}
+
+	message := fmt.Sprintf("Control plane update from %s to %s is deferred until the update window (%s for %s) opens.",
+		deployed, cluster.Status.Versions.Apiserver.String(), window.Start, window.Length)
+	if err := r.setControlPlaneUpdateAllowedCondition(ctx, cluster, message); err != nil {
+		return nil, 0, err
+	}
+
+	pinned := cluster.DeepCopy()
+	pinned.Status.Versions.Apiserver = *deployed
+
+	return pinned, untilWindow, nil
+}
+
+// setControlPlaneUpdateAllowedCondition reflects in the cluster's ControlPlaneUpdateAllowed condition
+// whether a control plane update is deferred, which is the case if deferredMessage is not empty. The
+// condition is only updated and an event only emitted when an update gets deferred or is not deferred
+// anymore, so that the event is not repeated on every reconciliation while the window is closed.
+func (r *Reconciler) setControlPlaneUpdateAllowedCondition(ctx context.Context, cluster *kubermaticv1.Cluster, deferredMessage string) error {
+	condition, exists := cluster.Status.Conditions[kubermaticv1.ClusterConditionControlPlaneUpdateAllowed]
+	wasDeferred := exists && condition.Status == corev1.ConditionFalse
+	deferred := deferredMessage != ""
+
+	if !deferred && !wasDeferred {
+		return nil
+	}
+	if deferred && wasDeferred && condition.Message == deferredMessage {
+		return nil
+	}
+
+	status := corev1.ConditionTrue
+	reason := "ControlPlaneUpdateAllowed"
+	message := "Control plane updates are not deferred anymore."
+	if deferred {
+		status = corev1.ConditionFalse
+		reason = "ControlPlaneUpdateDeferred"
+		message = deferredMessage
+	}
+
+	err := kubermaticv1helper.UpdateClusterStatus(ctx, r, cluster, func(c *kubermaticv1.Cluster) {
+		kubermaticv1helper.SetClusterCondition(c, r.versions, kubermaticv1.ClusterConditionControlPlaneUpdateAllowed, status, reason, message)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set %s condition: %w", kubermaticv1.ClusterConditionControlPlaneUpdateAllowed, err)
+	}
+
+	r.recorder.Event(cluster, corev1.EventTypeNormal, reason, message)
+
+	return nil
+}
+
+// deployedApiserverVersion returns the version the apiserver Deployment is currently configured
+// with, or nil if the Deployment does not exist yet.
+func (r *Reconciler) deployedApiserverVersion(ctx context.Context, cluster *kubermaticv1.Cluster) (*semver.Semver, error) {
+	deployment := &appsv1.Deployment{}
+	key := types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.ApiserverDeploymentName}
+
+	if err := r.Get(ctx, key, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get apiserver Deployment: %w", err)
+	}
+
+	versionLabel := deployment.Spec.Template.Labels[resources.VersionLabel]
+	if versionLabel == "" {
+		return nil, nil
+	}
+
+	version, err := semver.NewSemver(versionLabel)
+	if err != nil {
+		return nil, fmt.Errorf("apiserver Deployment has invalid version label %q: %w", versionLabel, err)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDeferControlPlaneUpdate(t *testing.T) {
+	// a Monday
+	now := time.Date(2022, time.June, 6, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		updateWindow    *kubermaticv1.UpdateWindow
+		deployedVersion string
+		expectedVersion string
+		deferredBefore  bool
+		expectedDefer   time.Duration
+		expectedEvent   string
+	}{
+		{
+			name:            "no update window configured",
+			deployedVersion: "1.22.5",
+			expectedVersion: "1.23.6",
+		},
+		{
+			name:            "apiserver not deployed yet",
+			updateWindow:    &kubermaticv1.UpdateWindow{Start: "Mon 20:00", Length: "2h"},
+			expectedVersion: "1.23.6",
+		},
+		{
+			name:            "no update pending",
+			updateWindow:    &kubermaticv1.UpdateWindow{Start: "Mon 20:00", Length: "2h"},
+			deployedVersion: "1.23.6",
+			expectedVersion: "1.23.6",
+		},
+		{
+			name:            "update pending within the update window",
+			updateWindow:    &kubermaticv1.UpdateWindow{Start: "Mon 09:00", Length: "2h"},
+			deployedVersion: "1.22.5",
+			expectedVersion: "1.23.6",
+		},
+		{
+			name:            "previously deferred update within the update window",
+			updateWindow:    &kubermaticv1.UpdateWindow{Start: "Mon 09:00", Length: "2h"},
+			deployedVersion: "1.22.5",
+			expectedVersion: "1.23.6",
+			deferredBefore:  true,
+			expectedEvent:   "ControlPlaneUpdateAllowed",
+		},
+		{
+			name:            "update pending outside of the update window",
+			updateWindow:    &kubermaticv1.UpdateWindow{Start: "Mon 20:00", Length: "2h"},
+			deployedVersion: "1.22.5",
+			expectedVersion: "1.22.5",
+			expectedDefer:   10 * time.Hour,
+			expectedEvent:   "ControlPlaneUpdateDeferred",
+		},
+		{
+			name:            "update still deferred outside of the update window",
+			updateWindow:    &kubermaticv1.UpdateWindow{Start: "Mon 20:00", Length: "2h"},
+			deployedVersion: "1.22.5",
+			expectedVersion: "1.22.5",
+			deferredBefore:  true,
+			expectedDefer:   10 * time.Hour,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-a",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					UpdateWindow: tc.updateWindow,
+				},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName: "cluster-cluster-a",
+					Versions: kubermaticv1.ClusterVersionsStatus{
+						Apiserver: *semver.NewSemverOrDie("1.23.6"),
+					},
+				},
+			}
+
+			if tc.deferredBefore {
+				cluster.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+					kubermaticv1.ClusterConditionControlPlaneUpdateAllowed: {
+						Status:  corev1.ConditionFalse,
+						Reason:  "ControlPlaneUpdateDeferred",
+						Message: "Control plane update from 1.22.5 to 1.23.6 is deferred until the update window (Mon 20:00 for 2h) opens.",
+					},
+				}
+			}
+
+			objects := []ctrlruntimeclient.Object{cluster}
+			if tc.deployedVersion != "" {
+				objects = append(objects, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      resources.ApiserverDeploymentName,
+						Namespace: cluster.Status.NamespaceName,
+					},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{resources.VersionLabel: tc.deployedVersion},
+							},
+						},
+					},
+				})
+			}
+
+			recorder := record.NewFakeRecorder(10)
+			r := &Reconciler{
+				Client:   fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build(),
+				log:      kubermaticlog.Logger,
+				recorder: recorder,
+			}
+
+			reconcilingCluster, deferredFor, err := r.deferControlPlaneUpdate(context.Background(), cluster, now)
+			if err != nil {
+				t.Fatalf("Failed to check update window: %v", err)
+			}
+
+			if deferredFor != tc.expectedDefer {
+				t.Errorf("Expected update to be deferred for %v, got %v", tc.expectedDefer, deferredFor)
+			}
+
+			if version := reconcilingCluster.Status.Versions.Apiserver.String(); version != tc.expectedVersion {
+				t.Errorf("Expected apiserver version %q to be reconciled, got %q", tc.expectedVersion, version)
+			}
+
+			if cluster.Status.Versions.Apiserver.String() != "1.23.6" {
+				t.Errorf("Expected original cluster to not be modified, but got apiserver version %q", cluster.Status.Versions.Apiserver.String())
+			}
+
+			// reconciling again must not repeat the event
+			if _, _, err := r.deferControlPlaneUpdate(context.Background(), cluster, now.Add(time.Minute)); err != nil {
+				t.Fatalf("Failed to check update window: %v", err)
+			}
+
+			var events []string
+			close(recorder.Events)
+			for event := range recorder.Events {
+				events = append(events, event)
+			}
+
+			switch {
+			case tc.expectedEvent == "" && len(events) > 0:
+				t.Errorf("Expected no events, got %v", events)
+			case tc.expectedEvent != "" && (len(events) != 1 || !strings.Contains(events[0], tc.expectedEvent)):
+				t.Errorf("Expected a single %s event, got %v", tc.expectedEvent, events)
+			}
+		})
+	}
+}
@@ -26,22 +26,34 @@ import (
 
 	grafanasdk "github.com/kubermatic/grafanasdk"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
-	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 )
 
 func getOrgByProject(ctx context.Context, grafanaClient *grafanasdk.Client, project *kubermaticv1.Project) (grafanasdk.Org, error) {
-	orgID, ok := project.GetAnnotations()[GrafanaOrgAnnotationKey]
+	id, ok, err := getOrgIDByProject(project)
+	if err != nil {
+		return grafanasdk.Org{}, err
+	}
 	if !ok {
 		return grafanasdk.Org{}, fmt.Errorf("project should have grafana org annotation set")
 	}
+	return grafanaClient.GetOrgById(ctx, id)
+}
+
+// getOrgIDByProject returns the ID of the Grafana org assigned to the project. The boolean
+// is false if no org has been assigned to the project yet.
+func getOrgIDByProject(project *kubermaticv1.Project) (uint, bool, error) {
+	orgID, ok := project.GetAnnotations()[GrafanaOrgAnnotationKey]
+	if !ok {
+		return 0, false, nil
+	}
 	id, err := strconv.ParseUint(orgID, 10, 32)
 	if err != nil {
-		return grafanasdk.Org{}, err
+		return 0, false, fmt.Errorf("invalid grafana org annotation %q: %w", orgID, err)
 	}
-	return grafanaClient.GetOrgById(ctx, uint(id))
+	return uint(id), true, nil
 }
 
 func GetGrafanaOrgUser(ctx context.Context, grafanaClient *grafanasdk.Client, orgID, uid uint) (*grafanasdk.OrgUser, error) {
@@ -93,14 +105,18 @@ func removeUserFromOrg(ctx context.Context, grafanaClient *grafanasdk.Client, or
 	return nil
 }
 
-func ensureOrgUser(ctx context.Context, grafanaClient *grafanasdk.Client, project *kubermaticv1.Project, userProjectBinding *kubermaticv1.UserProjectBinding) error {
-	user, err := grafanaClient.LookupUser(ctx, userProjectBinding.Spec.UserEmail)
+// ensureOrgUser adds the user of the UserProjectBinding to the project's Grafana org, using the role derived
+// from the binding's group. If the user is already a member with a different role, the role is updated.
+func ensureOrgUser(ctx context.Context, grafanaClient *grafanasdk.Client, project *kubermaticv1.Project, userProjectBinding *kubermaticv1.UserProjectBinding, ownerRole grafanasdk.RoleType) error {
+	role, err := grafanaRoleForGroup(userProjectBinding.Spec.Group, ownerRole)
 	if err != nil {
 		return err
 	}
 
-	group := rbac.ExtractGroupPrefix(userProjectBinding.Spec.Group)
-	role := groupToRole[group]
+	user, err := grafanaClient.LookupUser(ctx, userProjectBinding.Spec.UserEmail)
+	if err != nil {
+		return err
+	}
 
 	org, err := getOrgByProject(ctx, grafanaClient, project)
 	if err != nil {
@@ -49,14 +49,44 @@ const (
 )
 
 var (
-	// groupToRole map kubermatic groups to grafana roles.
+	// groupToRole map kubermatic groups to grafana roles. Project owners are not part of this mapping,
+	// their role is configurable (see grafanaRoleForGroup).
 	groupToRole = map[string]grafanasdk.RoleType{
-		rbac.OwnerGroupNamePrefix:  grafanasdk.ROLE_EDITOR, // we assign the editor (not admin) role to project owners, to make sure they cannot edit datasources in Grafana
-		rbac.EditorGroupNamePrefix: grafanasdk.ROLE_EDITOR,
-		rbac.ViewerGroupNamePrefix: grafanasdk.ROLE_VIEWER,
+		rbac.EditorGroupNamePrefix:         grafanasdk.ROLE_EDITOR,
+		rbac.ViewerGroupNamePrefix:         grafanasdk.ROLE_VIEWER,
+		rbac.ProjectManagerGroupNamePrefix: grafanasdk.ROLE_VIEWER,
 	}
 )
 
+// DefaultGrafanaOwnerRole is the Grafana org role assigned to project owners by default. We assign the editor
+// (not admin) role to project owners, to make sure they cannot edit datasources in Grafana.
+const DefaultGrafanaOwnerRole = grafanasdk.ROLE_EDITOR
+
+// ValidateGrafanaOwnerRole checks that the given role is a valid Grafana org role.
+func ValidateGrafanaOwnerRole(role string) error {
+	switch grafanasdk.RoleType(role) {
+	case grafanasdk.ROLE_ADMIN, grafanasdk.ROLE_EDITOR, grafanasdk.ROLE_VIEWER:
+		return nil
+	default:
+		return fmt.Errorf("invalid Grafana org role %q, must be one of %s, %s or %s", role, grafanasdk.ROLE_ADMIN, grafanasdk.ROLE_EDITOR, grafanasdk.ROLE_VIEWER)
+	}
+}
+
+// grafanaRoleForGroup returns the Grafana org role for the given UserProjectBinding group.
+func grafanaRoleForGroup(group string, ownerRole grafanasdk.RoleType) (grafanasdk.RoleType, error) {
+	prefix := rbac.ExtractGroupPrefix(group)
+	if prefix == rbac.OwnerGroupNamePrefix {
+		return ownerRole, nil
+	}
+
+	role, ok := groupToRole[prefix]
+	if !ok {
+		return "", fmt.Errorf("no Grafana org role known for group %q", group)
+	}
+
+	return role, nil
+}
+
 type grafanaClientProvider func(ctx context.Context) (*grafanasdk.Client, error)
 
 func newGrafanaClientProvider(client ctrlruntimeclient.Client, httpClient *http.Client, secretName string, grafanaURL string, enabled bool) (grafanaClientProvider, error) {
@@ -117,6 +147,7 @@ func Add(
 	cortexAlertmanagerURL string,
 	cortexRulerURL string,
 	lokiRulerURL string,
+	grafanaOwnerRole string,
 	mlaEnabled bool,
 ) error {
 	log = log.Named(ControllerName)
@@ -127,11 +158,11 @@ func Add(
 		return fmt.Errorf("failed to prepare Grafana client: %w", err)
 	}
 
-	orgUserGrafanaController := newOrgUserGrafanaController(mgr.GetClient(), log, clientProvider)
+	orgUserGrafanaController := newOrgUserGrafanaController(mgr.GetClient(), log, clientProvider, grafanasdk.RoleType(grafanaOwnerRole))
 	orgGrafanaController := newOrgGrafanaController(mgr.GetClient(), log, mlaNamespace, clientProvider)
 	alertmanagerController := newAlertmanagerController(mgr.GetClient(), log, httpClient, cortexAlertmanagerURL)
 	datasourceGrafanaController := newDatasourceGrafanaController(mgr.GetClient(), clientProvider, mlaNamespace, log, overwriteRegistry)
-	userGrafanaController := newUserGrafanaController(mgr.GetClient(), log, clientProvider, httpClient, grafanaURL, grafanaHeader)
+	userGrafanaController := newUserGrafanaController(mgr.GetClient(), log, clientProvider, httpClient, grafanaURL, grafanaHeader, grafanasdk.RoleType(grafanaOwnerRole))
 	ruleGroupController := newRuleGroupController(mgr.GetClient(), log, httpClient, cortexRulerURL, lokiRulerURL, mlaNamespace)
 	dashboardGrafanaController := newDashboardGrafanaController(mgr.GetClient(), log, mlaNamespace, clientProvider)
 	ratelimitCortexController := newRatelimitCortexController(mgr.GetClient(), log, mlaNamespace)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 
 	"go.uber.org/zap"
 
@@ -31,10 +32,12 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/pointer"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -90,7 +93,46 @@ func newOrgUserGrafanaReconciler(
 	if err := c.Watch(&source.Kind{Type: &kubermaticv1.UserProjectBinding{}}, &handler.EnqueueRequestForObject{}, serviceAccountPredicate); err != nil {
 		return fmt.Errorf("failed to watch UserProjectBindings: %w", err)
 	}
-	return err
+
+	enqueueUserProjectBindingsForProject := handler.EnqueueRequestsFromMapFunc(func(object ctrlruntimeclient.Object) []reconcile.Request {
+		userProjectBindingList := &kubermaticv1.UserProjectBindingList{}
+		if err := client.List(context.Background(), userProjectBindingList); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list UserProjectBindings for project %q: %w", object.GetName(), err))
+			return nil
+		}
+		var requests []reconcile.Request
+		for _, userProjectBinding := range userProjectBindingList.Items {
+			if userProjectBinding.Spec.ProjectID != object.GetName() || kubermaticv1helper.IsProjectServiceAccount(userProjectBinding.Spec.UserEmail) {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: userProjectBinding.Name}})
+		}
+		return requests
+	})
+
+	projectPredicate := predicate.Funcs{
+		// Bindings are reconciled on their own when they are created or deleted, so only
+		// relevant Project updates need to trigger a re-sync.
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		DeleteFunc: func(event.DeleteEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return projectChangedForGrafana(e.ObjectOld.(*kubermaticv1.Project), e.ObjectNew.(*kubermaticv1.Project))
+		},
+	}
+
+	if err := c.Watch(&source.Kind{Type: &kubermaticv1.Project{}}, enqueueUserProjectBindingsForProject, projectPredicate); err != nil {
+		return fmt.Errorf("failed to watch Projects: %w", err)
+	}
+
+	return nil
+}
+
+// projectChangedForGrafana returns true if a Project changed in a way that requires its
+// UserProjectBindings to be synced to Grafana again.
+func projectChangedForGrafana(oldProject, newProject *kubermaticv1.Project) bool {
+	return oldProject.Spec.Name != newProject.Spec.Name ||
+		!reflect.DeepEqual(oldProject.Labels, newProject.Labels) ||
+		oldProject.Annotations[GrafanaOrgAnnotationKey] != newProject.Annotations[GrafanaOrgAnnotationKey]
 }
 
 func (r *orgUserGrafanaReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -114,7 +156,11 @@ func (r *orgUserGrafanaReconciler) Reconcile(ctx context.Context, request reconc
 		return reconcile.Result{}, nil
 	}
 
+	// A nil client means MLA is disabled, so there is nothing to sync. If MLA is enabled but
+	// Grafana cannot be reached, the client provider or the Grafana API calls below fail and
+	// the request is requeued until Grafana recovers.
 	if grafanaClient == nil {
+		log.Debug("Skipping because MLA is disabled")
 		return reconcile.Result{}, nil
 	}
 
@@ -127,7 +173,7 @@ func (r *orgUserGrafanaReconciler) Reconcile(ctx context.Context, request reconc
 		return reconcile.Result{}, fmt.Errorf("failed to get project: %w", err)
 	}
 
-	if err := ensureOrgUser(ctx, grafanaClient, project, userProjectBinding); err != nil {
+	if err := ensureOrgUser(ctx, grafanaClient, project, userProjectBinding, r.orgUserGrafanaController.ownerRole); err != nil {
 		return reconcile.Result{}, fmt.Errorf("unable to ensure Grafana Org/User: %w", err)
 	}
 
@@ -138,14 +184,16 @@ type orgUserGrafanaController struct {
 	ctrlruntimeclient.Client
 	clientProvider grafanaClientProvider
 	log            *zap.SugaredLogger
+	ownerRole      grafanasdk.RoleType
 }
 
-func newOrgUserGrafanaController(client ctrlruntimeclient.Client, log *zap.SugaredLogger, clientProvider grafanaClientProvider,
+func newOrgUserGrafanaController(client ctrlruntimeclient.Client, log *zap.SugaredLogger, clientProvider grafanaClientProvider, ownerRole grafanasdk.RoleType,
 ) *orgUserGrafanaController {
 	return &orgUserGrafanaController{
 		Client:         client,
 		clientProvider: clientProvider,
 		log:            log,
+		ownerRole:      ownerRole,
 	}
 }
 
@@ -172,14 +220,19 @@ func (r *orgUserGrafanaController) handleDeletion(ctx context.Context, userProje
 		if err := r.Get(ctx, types.NamespacedName{Name: userProjectBinding.Spec.ProjectID}, project); err != nil && !apierrors.IsNotFound(err) {
 			return fmt.Errorf("failed to get project: %w", err)
 		}
-		org, err := getOrgByProject(ctx, grafanaClient, project)
-		if err == nil {
+		// The org ID is taken from the project, so that the finalizer is only removed if either no org
+		// exists for the project or the user was removed from it, but not if Grafana is unreachable.
+		orgID, ok, err := getOrgIDByProject(project)
+		if err != nil {
+			return err
+		}
+		if ok {
 			user, err := grafanaClient.LookupUser(ctx, userProjectBinding.Spec.UserEmail)
 			if err != nil && !errors.As(err, &grafanasdk.ErrNotFound{}) {
 				return err
 			}
 			if err == nil {
-				status, err := grafanaClient.DeleteOrgUser(ctx, org.ID, user.ID)
+				status, err := grafanaClient.DeleteOrgUser(ctx, orgID, user.ID)
 				if err != nil {
 					return fmt.Errorf("failed to delete org user: %w (status: %s, message: %s)", err, pointer.StringPtrDerefOr(status.Status, "no status"), pointer.StringPtrDerefOr(status.Message, "no message"))
 				}
@@ -52,7 +52,7 @@ func newTestOrgUserGrafanaReconciler(t *testing.T, objects []ctrlruntimeclient.O
 
 	orgUserGrafanaController := newOrgUserGrafanaController(dynamicClient, kubermaticlog.Logger, func(ctx context.Context) (*grafanasdk.Client, error) {
 		return grafanaClient, nil
-	})
+	}, DefaultGrafanaOwnerRole)
 	reconciler := orgUserGrafanaReconciler{
 		Client:                   dynamicClient,
 		log:                      kubermaticlog.Logger,
@@ -202,6 +202,54 @@ func TestOrgUserGrafanaReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "UserProjectBinding group changed to viewers",
+			requestName: "downgrade",
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.UserProjectBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "downgrade",
+					},
+					Spec: kubermaticv1.UserProjectBindingSpec{
+						UserEmail: "user@email.com",
+						ProjectID: "projectID",
+						Group:     "viewers-projectID",
+					},
+				},
+				&kubermaticv1.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "projectID",
+						Annotations: map[string]string{GrafanaOrgAnnotationKey: "1"},
+					},
+					Spec: kubermaticv1.ProjectSpec{
+						Name: "projectName",
+					},
+				},
+			},
+			hasFinalizer: true,
+			requests: []request{
+				{
+					name:     "lookup user",
+					request:  httptest.NewRequest(http.MethodGet, "/api/users/lookup?loginOrEmail=user@email.com", nil),
+					response: &http.Response{Body: io.NopCloser(strings.NewReader(`{"id":1,"email":"user@email.com","login":"admin"}`)), StatusCode: http.StatusOK},
+				},
+				{
+					name:     "get org by id",
+					request:  httptest.NewRequest(http.MethodGet, "/api/orgs/1", nil),
+					response: &http.Response{Body: io.NopCloser(strings.NewReader(`{"id":1,"name":"projectName","address":{"address1":"","address2":"","city":"","zipCode":"","state":"","country":""}}`)), StatusCode: http.StatusOK},
+				},
+				{
+					name:     "get org users",
+					request:  httptest.NewRequest(http.MethodGet, "/api/orgs/1/users", nil),
+					response: &http.Response{Body: io.NopCloser(strings.NewReader(`[{"orgId":1,"userId":1,"email":"user@email.com","login":"admin","role":"Editor"}]`)), StatusCode: http.StatusOK},
+				},
+				{
+					name:     "update org user",
+					request:  httptest.NewRequest(http.MethodPatch, "/api/orgs/1/users/1", strings.NewReader(`{"loginOrEmail":"user@email.com","role":"Viewer"}`)),
+					response: &http.Response{Body: io.NopCloser(strings.NewReader(`{"message": "User updated"}`)), StatusCode: http.StatusOK},
+				},
+			},
+		},
 		{
 			name:        "UserProjectBinding role delete",
 			requestName: "delete",
@@ -230,11 +278,6 @@ func TestOrgUserGrafanaReconcile(t *testing.T) {
 			},
 			hasFinalizer: false,
 			requests: []request{
-				{
-					name:     "get org by id",
-					request:  httptest.NewRequest(http.MethodGet, "/api/orgs/1", nil),
-					response: &http.Response{Body: io.NopCloser(strings.NewReader(`{"id":1,"name":"projectName","address":{"address1":"","address2":"","city":"","zipCode":"","state":"","country":""}}`)), StatusCode: http.StatusOK},
-				},
 				{
 					name:     "lookup user",
 					request:  httptest.NewRequest(http.MethodGet, "/api/users/lookup?loginOrEmail=user@email.com", nil),
@@ -247,6 +290,69 @@ func TestOrgUserGrafanaReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:        "UserProjectBinding deleted while Grafana is unavailable",
+			requestName: "unavailable",
+			err:         true,
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.UserProjectBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "unavailable",
+						DeletionTimestamp: &metav1.Time{Time: time.Now()},
+						Finalizers:        []string{mlaFinalizer},
+					},
+					Spec: kubermaticv1.UserProjectBindingSpec{
+						UserEmail: "user@email.com",
+						ProjectID: "projectID",
+						Group:     "owners-projectID",
+					},
+				},
+				&kubermaticv1.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "projectID",
+						Annotations: map[string]string{GrafanaOrgAnnotationKey: "1"},
+					},
+					Spec: kubermaticv1.ProjectSpec{
+						Name: "projectName",
+					},
+				},
+			},
+			hasFinalizer: true,
+			requests: []request{
+				{
+					name:     "lookup user",
+					request:  httptest.NewRequest(http.MethodGet, "/api/users/lookup?loginOrEmail=user@email.com", nil),
+					response: &http.Response{StatusCode: http.StatusServiceUnavailable},
+				},
+			},
+		},
+		{
+			name:        "UserProjectBinding deleted before Grafana org was created",
+			requestName: "no-org",
+			objects: []ctrlruntimeclient.Object{
+				&kubermaticv1.UserProjectBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "no-org",
+						DeletionTimestamp: &metav1.Time{Time: time.Now()},
+						Finalizers:        []string{mlaFinalizer, "just-a-test-do-not-delete-thanks"},
+					},
+					Spec: kubermaticv1.UserProjectBindingSpec{
+						UserEmail: "user@email.com",
+						ProjectID: "projectID",
+						Group:     "owners-projectID",
+					},
+				},
+				&kubermaticv1.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "projectID",
+					},
+					Spec: kubermaticv1.ProjectSpec{
+						Name: "projectName",
+					},
+				},
+			},
+			hasFinalizer: false,
+		},
 	}
 	for idx := range testCases {
 		tc := testCases[idx]
@@ -271,3 +377,181 @@ func TestOrgUserGrafanaReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestGrafanaRoleForGroup(t *testing.T) {
+	testCases := []struct {
+		name         string
+		group        string
+		ownerRole    grafanasdk.RoleType
+		expectedRole grafanasdk.RoleType
+		wantErr      bool
+	}{
+		{
+			name:         "owners get the default owner role",
+			group:        "owners-projectID",
+			ownerRole:    DefaultGrafanaOwnerRole,
+			expectedRole: grafanasdk.ROLE_EDITOR,
+		},
+		{
+			name:         "owners get the configured owner role",
+			group:        "owners-projectID",
+			ownerRole:    grafanasdk.ROLE_ADMIN,
+			expectedRole: grafanasdk.ROLE_ADMIN,
+		},
+		{
+			name:         "editors",
+			group:        "editors-projectID",
+			ownerRole:    grafanasdk.ROLE_ADMIN,
+			expectedRole: grafanasdk.ROLE_EDITOR,
+		},
+		{
+			name:         "viewers",
+			group:        "viewers-projectID",
+			ownerRole:    grafanasdk.ROLE_ADMIN,
+			expectedRole: grafanasdk.ROLE_VIEWER,
+		},
+		{
+			name:      "unknown group",
+			group:     "strangers-projectID",
+			ownerRole: grafanasdk.ROLE_ADMIN,
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			role, err := grafanaRoleForGroup(tc.group, tc.ownerRole)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+
+			if role != tc.expectedRole {
+				t.Errorf("Expected role %q, got %q", tc.expectedRole, role)
+			}
+		})
+	}
+}
+
+func TestProjectChangedForGrafana(t *testing.T) {
+	project := &kubermaticv1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "projectID",
+			Labels:      map[string]string{"team": "a"},
+			Annotations: map[string]string{GrafanaOrgAnnotationKey: "1"},
+		},
+		Spec: kubermaticv1.ProjectSpec{
+			Name: "projectName",
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		modify   func(p *kubermaticv1.Project)
+		expected bool
+	}{
+		{
+			name:     "nothing changed",
+			modify:   func(p *kubermaticv1.Project) {},
+			expected: false,
+		},
+		{
+			name:     "status changed",
+			modify:   func(p *kubermaticv1.Project) { p.Status.Phase = kubermaticv1.ProjectActive },
+			expected: false,
+		},
+		{
+			name:     "project renamed",
+			modify:   func(p *kubermaticv1.Project) { p.Spec.Name = "renamed" },
+			expected: true,
+		},
+		{
+			name:     "labels changed",
+			modify:   func(p *kubermaticv1.Project) { p.Labels["team"] = "b" },
+			expected: true,
+		},
+		{
+			name:     "grafana org changed",
+			modify:   func(p *kubermaticv1.Project) { p.Annotations[GrafanaOrgAnnotationKey] = "2" },
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newProject := project.DeepCopy()
+			tc.modify(newProject)
+
+			if changed := projectChangedForGrafana(project, newProject); changed != tc.expected {
+				t.Errorf("Expected change to be detected: %v, got: %v", tc.expected, changed)
+			}
+		})
+	}
+}
+
+func TestOrgUserGrafanaReconcileMLADisabled(t *testing.T) {
+	testCases := []struct {
+		name               string
+		userProjectBinding *kubermaticv1.UserProjectBinding
+		hasFinalizer       bool
+	}{
+		{
+			name: "UserProjectBinding is not synced",
+			userProjectBinding: &kubermaticv1.UserProjectBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "binding",
+				},
+				Spec: kubermaticv1.UserProjectBindingSpec{
+					UserEmail: "user@email.com",
+					ProjectID: "projectID",
+					Group:     "owners-projectID",
+				},
+			},
+			hasFinalizer: false,
+		},
+		{
+			name: "finalizer of deleted UserProjectBinding is removed",
+			userProjectBinding: &kubermaticv1.UserProjectBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "binding",
+					DeletionTimestamp: &metav1.Time{Time: time.Now()},
+					Finalizers:        []string{mlaFinalizer, "just-a-test-do-not-delete-thanks"},
+				},
+				Spec: kubermaticv1.UserProjectBindingSpec{
+					UserEmail: "user@email.com",
+					ProjectID: "projectID",
+					Group:     "owners-projectID",
+				},
+			},
+			hasFinalizer: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := ctrlruntimefakeclient.NewClientBuilder().WithObjects(tc.userProjectBinding).Build()
+
+			// a nil client without an error means that MLA is disabled
+			controller := newOrgUserGrafanaController(client, kubermaticlog.Logger, func(ctx context.Context) (*grafanasdk.Client, error) {
+				return nil, nil
+			}, DefaultGrafanaOwnerRole)
+			reconciler := orgUserGrafanaReconciler{
+				Client:                   client,
+				log:                      kubermaticlog.Logger,
+				recorder:                 record.NewFakeRecorder(10),
+				orgUserGrafanaController: controller,
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.userProjectBinding.Name}}
+			if _, err := reconciler.Reconcile(ctx, request); err != nil {
+				t.Fatalf("Reconciling failed: %v", err)
+			}
+
+			upb := &kubermaticv1.UserProjectBinding{}
+			if err := client.Get(ctx, request.NamespacedName, upb); err != nil {
+				t.Fatalf("unable to get upb: %v", err)
+			}
+			assert.Equal(t, tc.hasFinalizer, kubernetes.HasFinalizer(upb, mlaFinalizer))
+		})
+	}
+}
@@ -138,6 +138,7 @@ type userGrafanaController struct {
 	log           *zap.SugaredLogger
 	grafanaURL    string
 	grafanaHeader string
+	ownerRole     grafanasdk.RoleType
 }
 
 func newUserGrafanaController(
@@ -147,6 +148,7 @@ func newUserGrafanaController(
 	httpClient *http.Client,
 	grafanaURL string,
 	grafanaHeader string,
+	ownerRole grafanasdk.RoleType,
 ) *userGrafanaController {
 	return &userGrafanaController{
 		Client:         client,
@@ -156,6 +158,7 @@ func newUserGrafanaController(
 		log:           log,
 		grafanaURL:    grafanaURL,
 		grafanaHeader: grafanaHeader,
+		ownerRole:     ownerRole,
 	}
 }
 
@@ -253,7 +256,7 @@ func (r *userGrafanaController) ensureGrafanaUser(ctx context.Context, user *kub
 				if err := r.Get(ctx, types.NamespacedName{Name: userProjectBinding.Spec.ProjectID}, project); err != nil {
 					return fmt.Errorf("failed to get project: %w", err)
 				}
-				if err := ensureOrgUser(ctx, grafanaClient, project, &userProjectBinding); err != nil {
+				if err := ensureOrgUser(ctx, grafanaClient, project, &userProjectBinding, r.ownerRole); err != nil {
 					return err
 				}
 			}
@@ -52,7 +52,7 @@ func newTestUserGrafanaReconciler(t *testing.T, objects []ctrlruntimeclient.Obje
 
 	userGrafanaController := newUserGrafanaController(dynamicClient, kubermaticlog.Logger, func(ctx context.Context) (*grafanasdk.Client, error) {
 		return grafanaClient, nil
-	}, ts.Client(), ts.URL, "X-WEBAUTH-USER")
+	}, ts.Client(), ts.URL, "X-WEBAUTH-USER", DefaultGrafanaOwnerRole)
 	reconciler := userGrafanaReconciler{
 		Client:                dynamicClient,
 		log:                   kubermaticlog.Logger,
@@ -78,6 +78,7 @@ type Reconciler struct {
 	nodeAccessNetwork        string
 	dockerPullConfigJSON     []byte
 	concurrentClusterUpdates int
+	concurrencyPartition     controllerutil.ConcurrencyPartition
 
 	features Features
 	versions kubermatic.Versions
@@ -98,6 +99,7 @@ func Add(
 	nodeAccessNetwork string,
 	dockerPullConfigJSON []byte,
 	concurrentClusterUpdates int,
+	concurrencyPartition controllerutil.ConcurrencyPartition,
 
 	features Features,
 	versions kubermatic.Versions,
@@ -116,6 +118,7 @@ func Add(
 		nodeAccessNetwork:        nodeAccessNetwork,
 		dockerPullConfigJSON:     dockerPullConfigJSON,
 		concurrentClusterUpdates: concurrentClusterUpdates,
+		concurrencyPartition:     concurrencyPartition,
 		seedGetter:               seedGetter,
 		configGetter:             configGetter,
 
@@ -190,8 +193,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		kubermaticv1.ClusterConditionMonitoringControllerReconcilingSuccess,
 		func() (*reconcile.Result, error) {
 			// only reconcile this cluster if there are not yet too many updates running
-			if available, err := controllerutil.ClusterAvailableForReconciling(ctx, r, cluster, r.concurrentClusterUpdates); !available || err != nil {
-				log.Infow("Concurrency limit reached, checking again in 10 seconds", "concurrency-limit", r.concurrentClusterUpdates)
+			if available, err := controllerutil.ClusterAvailableForReconciling(ctx, r, cluster, r.concurrentClusterUpdates, r.concurrencyPartition); !available || err != nil {
+				log.Infow("Concurrency limit reached, checking again in 10 seconds", "concurrency-limit", r.concurrentClusterUpdates, "concurrency-partition", r.concurrencyPartition)
 				return &reconcile.Result{
 					RequeueAfter: 10 * time.Second,
 				}, err
@@ -128,7 +128,7 @@ func (r *Reconciler) reconcileUpdateOperatorResources(ctx context.Context) error
 		resources.OperatorClusterRoleCreator(),
 		resources.AgentClusterRoleCreator(),
 	}
-	if err := reconciling.ReconcileClusterRoles(ctx, crCreators, metav1.NamespaceNone, r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoles(ctx, crCreators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile the ClusterRoles: %w", err)
 	}
 
@@ -136,7 +136,7 @@ func (r *Reconciler) reconcileUpdateOperatorResources(ctx context.Context) error
 		resources.OperatorClusterRoleBindingCreator(),
 		resources.AgentClusterRoleBindingCreator(),
 	}
-	if err := reconciling.ReconcileClusterRoleBindings(ctx, crbCreators, metav1.NamespaceNone, r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoleBindings(ctx, crbCreators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile the ClusterRoleBindings: %w", err)
 	}
 
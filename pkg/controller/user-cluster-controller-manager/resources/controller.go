@@ -87,6 +87,7 @@ func Add(
 	registerReconciledCheck func(name string, check healthz.Checker) error,
 	dnsClusterIP string,
 	nodeLocalDNSCache bool,
+	nodeLocalDNSCacheAddress string,
 	opaIntegration bool,
 	opaEnableMutation bool,
 	versions kubermatic.Versions,
@@ -117,6 +118,7 @@ func Add(
 		log:                          log,
 		dnsClusterIP:                 dnsClusterIP,
 		nodeLocalDNSCache:            nodeLocalDNSCache,
+		nodeLocalDNSCacheAddress:     nodeLocalDNSCacheAddress,
 		opaIntegration:               opaIntegration,
 		opaEnableMutation:            opaEnableMutation,
 		opaWebhookTimeout:            opaWebhookTimeout,
@@ -288,6 +290,7 @@ type reconciler struct {
 	tunnelingAgentIP             net.IP
 	dnsClusterIP                 string
 	nodeLocalDNSCache            bool
+	nodeLocalDNSCacheAddress     string
 	opaIntegration               bool
 	opaEnableMutation            bool
 	opaWebhookTimeout            int
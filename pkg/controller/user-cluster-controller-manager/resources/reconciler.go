@@ -55,6 +55,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/scheduler"
 	systembasicuser "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/system-basic-user"
 	userauth "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/user-auth"
+	userviewer "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/user-viewer"
 	"k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/usersshkeys"
 	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
 	"k8c.io/kubermatic/v2/pkg/crd"
@@ -63,7 +64,6 @@ import (
 	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 
-	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -136,6 +136,11 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 	}
 
 	data.kubernetesDashboardEnabled = cluster.Spec.KubernetesDashboard.Enabled
+	data.metricsServerSettings = cluster.Spec.MetricsServer
+
+	if r.opaIntegration && cluster.Spec.OPAIntegration != nil {
+		data.gatekeeperSyncResources = cluster.Spec.OPAIntegration.SyncResources
+	}
 
 	// Must be first because of openshift
 	if err := r.ensureAPIServices(ctx, data); err != nil {
@@ -200,6 +205,10 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 		return err
 	}
 
+	if err := r.reconcileViewerKubeconfig(ctx, cluster, data); err != nil {
+		return err
+	}
+
 	if err := r.reconcileDaemonSet(ctx, data); err != nil {
 		return err
 	}
@@ -212,6 +221,10 @@ func (r *reconciler) reconcile(ctx context.Context) error {
 		return err
 	}
 
+	if err := r.reconcileGatekeeperConfig(ctx, data); err != nil {
+		return err
+	}
+
 	if r.networkPolices {
 		if err := r.reconcileNetworkPolicies(ctx, data); err != nil {
 			return err
@@ -281,7 +294,7 @@ func (r *reconciler) ensureAPIServices(ctx context.Context, data reconcileData)
 		metricsserver.APIServiceCreator(caCert),
 	}
 
-	if err := reconciling.ReconcileAPIServices(ctx, creators, metav1.NamespaceNone, r.Client); err != nil {
+	if err := reconciling.ReconcileAPIServices(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile APIServices: %w", err)
 	}
 
@@ -291,6 +304,7 @@ func (r *reconciler) ensureAPIServices(ctx context.Context, data reconcileData)
 func (r *reconciler) reconcileServiceAccounts(ctx context.Context, data reconcileData) error {
 	creators := []reconciling.NamedServiceAccountCreatorGetter{
 		userauth.ServiceAccountCreator(),
+		userviewer.ServiceAccountCreator(),
 		usersshkeys.ServiceAccountCreator(),
 		coredns.ServiceAccountCreator(),
 	}
@@ -545,7 +559,7 @@ func (r *reconciler) reconcileClusterRoles(ctx context.Context, data reconcileDa
 		creators = append(creators, operatingsystemmanager.MachineDeploymentsClusterRoleCreator())
 	}
 
-	if err := reconciling.ReconcileClusterRoles(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoles(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoles: %w", err)
 	}
 	return nil
@@ -554,6 +568,7 @@ func (r *reconciler) reconcileClusterRoles(ctx context.Context, data reconcileDa
 func (r *reconciler) reconcileClusterRoleBindings(ctx context.Context, data reconcileData) error {
 	creators := []reconciling.NamedClusterRoleBindingCreatorGetter{
 		userauth.ClusterRoleBindingCreator(),
+		userviewer.ClusterRoleBindingCreator(),
 		kubestatemetrics.ClusterRoleBindingCreator(),
 		prometheus.ClusterRoleBindingCreator(),
 		machinecontroller.ClusterRoleBindingCreator(),
@@ -594,7 +609,7 @@ func (r *reconciler) reconcileClusterRoleBindings(ctx context.Context, data reco
 		creators = append(creators, operatingsystemmanager.MachineDeploymentsClusterRoleBindingCreator())
 	}
 
-	if err := reconciling.ReconcileClusterRoleBindings(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileClusterRoleBindings(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoleBindings: %w", err)
 	}
 	return nil
@@ -629,7 +644,7 @@ func (r *reconciler) reconcileCRDs(ctx context.Context) error {
 			gatekeeper.AssignMetadataCRDCreator())
 	}
 
-	if err := reconciling.ReconcileCustomResourceDefinitions(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileCustomResourceDefinitions(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile CustomResourceDefinitions: %w", err)
 	}
 	return nil
@@ -642,7 +657,7 @@ func (r *reconciler) reconcileMutatingWebhookConfigurations(ctx context.Context,
 	if r.opaIntegration && r.opaEnableMutation {
 		creators = append(creators, gatekeeper.MutatingWebhookConfigurationCreator(r.opaWebhookTimeout))
 	}
-	if err := reconciling.ReconcileMutatingWebhookConfigurations(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileMutatingWebhookConfigurations(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile MutatingWebhookConfigurations: %w", err)
 	}
 	return nil
@@ -664,7 +679,7 @@ func (r *reconciler) reconcileValidatingWebhookConfigurations(ctx context.Contex
 		creators = append(creators, csisnapshotter.ValidatingSnapshotWebhookConfigurationCreator(data.caCert.Cert, metav1.NamespaceSystem, resources.CSISnapshotValidationWebhookConfigurationName))
 	}
 
-	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileValidatingWebhookConfigurations(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile ValidatingWebhookConfigurations: %w", err)
 	}
 	return nil
@@ -778,7 +793,7 @@ func (r *reconciler) reconcileConfigMaps(ctx context.Context, data reconcileData
 	creators = append(creators, coredns.ConfigMapCreator())
 
 	if r.nodeLocalDNSCache {
-		creators = append(creators, nodelocaldns.ConfigMapCreator(r.dnsClusterIP))
+		creators = append(creators, nodelocaldns.ConfigMapCreator(r.dnsClusterIP, r.nodeLocalDNSCacheAddress))
 	}
 
 	if err := reconciling.ReconcileConfigMaps(ctx, creators, metav1.NamespaceSystem, r.Client); err != nil {
@@ -810,6 +825,7 @@ func (r *reconciler) reconcileConfigMaps(ctx context.Context, data reconcileData
 func (r *reconciler) reconcileSecrets(ctx context.Context, data reconcileData) error {
 	creators := []reconciling.NamedSecretCreatorGetter{
 		cloudcontroller.CloudConfig(data.cloudConfig, resources.CloudConfigSecretName),
+		userviewer.TokenSecretCreator(),
 	}
 	if !r.isKonnectivityEnabled {
 		creators = append(creators, openvpn.ClientCertificate(data.openVPNCACert))
@@ -897,11 +913,53 @@ func (r *reconciler) reconcileSecrets(ctx context.Context, data reconcileData) e
 	return nil
 }
 
+// reconcileViewerKubeconfig writes a read-only kubeconfig for the "external-viewer-user"
+// ServiceAccount into the cluster namespace in the seed. Until the token controller has
+// populated the token Secret, this is a no-op; the Secret update triggers a new reconciliation.
+func (r *reconciler) reconcileViewerKubeconfig(ctx context.Context, cluster *kubermaticv1.Cluster, data reconcileData) error {
+	tokenSecret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: userviewer.TokenSecretName}, tokenSecret); err != nil {
+		return fmt.Errorf("failed to get viewer token Secret: %w", err)
+	}
+
+	token := tokenSecret.Data[corev1.ServiceAccountTokenKey]
+	if len(token) == 0 {
+		r.log.Debug("Viewer ServiceAccount token has not been created yet")
+		return nil
+	}
+
+	creators := []reconciling.NamedSecretCreatorGetter{
+		userviewer.KubeconfigCreator(data.caCert.Cert, cluster.Address.URL, cluster.Name, token),
+	}
+	if err := reconciling.ReconcileSecrets(ctx, creators, r.namespace, r.seedClient); err != nil {
+		return fmt.Errorf("failed to reconcile viewer kubeconfig Secret in namespace %s: %w", r.namespace, err)
+	}
+
+	return nil
+}
+
+// reconcileGatekeeperConfig manages the sync settings of the Gatekeeper Config. The Config is only
+// reconciled if sync resources have been configured, so Configs managed via the API are left alone.
+func (r *reconciler) reconcileGatekeeperConfig(ctx context.Context, data reconcileData) error {
+	if len(data.gatekeeperSyncResources) == 0 {
+		return nil
+	}
+
+	creators := []reconciling.NamedGatekeeperV1alpha1ConfigCreatorGetter{
+		gatekeeper.ConfigCreator(data.gatekeeperSyncResources),
+	}
+	if err := reconciling.ReconcileGatekeeperV1alpha1Configs(ctx, creators, resources.GatekeeperNamespace, r.Client); err != nil {
+		return fmt.Errorf("failed to reconcile Gatekeeper Config in namespace %s: %w", resources.GatekeeperNamespace, err)
+	}
+
+	return nil
+}
+
 func (r *reconciler) reconcileDaemonSet(ctx context.Context, data reconcileData) error {
 	var dsCreators []reconciling.NamedDaemonSetCreatorGetter
 
 	if r.nodeLocalDNSCache {
-		dsCreators = append(dsCreators, nodelocaldns.DaemonSetCreator(r.overwriteRegistryFunc))
+		dsCreators = append(dsCreators, nodelocaldns.DaemonSetCreator(r.nodeLocalDNSCacheAddress, r.overwriteRegistryFunc))
 	}
 
 	if r.userSSHKeyAgent {
@@ -947,7 +1005,7 @@ func (r *reconciler) reconcileNamespaces(ctx context.Context, data reconcileData
 		creators = append(creators, mla.NamespaceCreator)
 	}
 
-	if err := reconciling.ReconcileNamespaces(ctx, creators, "", r.Client); err != nil {
+	if err := reconciling.ReconcileNamespaces(ctx, creators, r.Client); err != nil {
 		return fmt.Errorf("failed to reconcile namespaces: %w", err)
 	}
 
@@ -1013,7 +1071,7 @@ func (r *reconciler) reconcileDeployments(ctx context.Context, data reconcileDat
 	if r.isKonnectivityEnabled {
 		creators := []reconciling.NamedDeploymentCreatorGetter{
 			konnectivity.DeploymentCreator(r.konnectivityServerHost, r.konnectivityServerPort, r.overwriteRegistryFunc),
-			metricsserver.DeploymentCreator(r.overwriteRegistryFunc), // deploy metrics-server in user cluster
+			metricsserver.DeploymentCreator(r.overwriteRegistryFunc, data.metricsServerSettings), // deploy metrics-server in user cluster
 		}
 		if err := reconciling.ReconcileDeployments(ctx, creators, metav1.NamespaceSystem, r.Client); err != nil {
 			return fmt.Errorf("failed to reconcile Deployments in namespace %s: %w", metav1.NamespaceSystem, err)
@@ -1025,7 +1083,7 @@ func (r *reconciler) reconcileDeployments(ctx context.Context, data reconcileDat
 
 func (r *reconciler) reconcileNetworkPolicies(ctx context.Context, data reconcileData) error {
 	namedNetworkPolicyCreatorGetters := []reconciling.NamedNetworkPolicyCreatorGetter{
-		kubesystem.DefaultNetworkPolicyCreator(),
+		kubesystem.DefaultNetworkPolicyCreator(r.nodeLocalDNSCacheAddress),
 		coredns.KubeDNSNetworkPolicyCreator(data.clusterAddress.IP, int(data.clusterAddress.Port), data.k8sServiceApiIP.String()),
 	}
 
@@ -1083,6 +1141,7 @@ type reconcileData struct {
 	loggingRequirements         *corev1.ResourceRequirements
 	gatekeeperCtrlRequirements  *corev1.ResourceRequirements
 	gatekeeperAuditRequirements *corev1.ResourceRequirements
+	gatekeeperSyncResources     []kubermaticv1.OPASyncResource
 	monitoringReplicas          *int32
 	clusterAddress              *kubermaticv1.ClusterAddress
 	ipFamily                    kubermaticv1.IPFamily
@@ -1090,6 +1149,7 @@ type reconcileData struct {
 	reconcileK8sSvcEndpoints    bool
 	kubernetesDashboardEnabled  bool
 	coreDNSReplicas             *int32
+	metricsServerSettings       *kubermaticv1.MetricsServerSettings
 }
 
 func (r *reconciler) ensureOPAIntegrationIsRemoved(ctx context.Context) error {
@@ -1106,10 +1166,7 @@ func (r *reconciler) ensureOPAIntegrationIsRemoved(ctx context.Context) error {
 }
 
 func (r *reconciler) ensureOPAExperimentalMutationWebhookIsRemoved(ctx context.Context) error {
-	if err := r.Client.Delete(ctx, &admissionregistrationv1.MutatingWebhookConfiguration{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: resources.GatekeeperMutatingWebhookConfigurationName,
-		}}); err != nil && !apierrors.IsNotFound(err) {
+	if err := reconciling.DeleteMutatingWebhookConfigurations(ctx, []string{resources.GatekeeperMutatingWebhookConfigurationName}, r.Client); err != nil {
 		return fmt.Errorf("failed to remove Mutation Webhook: %w", err)
 	}
 	return nil
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatekeeper
+
+import (
+	configv1alpha1 "github.com/open-policy-agent/gatekeeper/apis/config/v1alpha1"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+)
+
+// ConfigCreator returns a func to create/update the Gatekeeper Config which lists the resources
+// synced into OPA's cache. Only the sync settings are managed, other fields of the Config
+// (e.g. set by users via the API) are left untouched.
+func ConfigCreator(syncResources []kubermaticv1.OPASyncResource) reconciling.NamedGatekeeperV1alpha1ConfigCreatorGetter {
+	return func() (string, reconciling.GatekeeperV1alpha1ConfigCreator) {
+		return resources.GatekeeperConfigName, func(config *configv1alpha1.Config) (*configv1alpha1.Config, error) {
+			syncOnly := make([]configv1alpha1.SyncOnlyEntry, 0, len(syncResources))
+			for _, r := range syncResources {
+				syncOnly = append(syncOnly, configv1alpha1.SyncOnlyEntry{
+					Group:   r.Group,
+					Version: r.Version,
+					Kind:    r.Kind,
+				})
+			}
+			config.Spec.Sync.SyncOnly = syncOnly
+
+			return config, nil
+		}
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gatekeeper
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	configv1alpha1 "github.com/open-policy-agent/gatekeeper/apis/config/v1alpha1"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+)
+
+func TestConfigCreator(t *testing.T) {
+	syncResources := []kubermaticv1.OPASyncResource{
+		{Version: "v1", Kind: "Namespace"},
+		{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+	}
+
+	existing := &configv1alpha1.Config{
+		Spec: configv1alpha1.ConfigSpec{
+			Sync: configv1alpha1.Sync{
+				SyncOnly: []configv1alpha1.SyncOnlyEntry{
+					{Version: "v1", Kind: "Pod"},
+				},
+			},
+			Match: []configv1alpha1.MatchEntry{
+				{ExcludedNamespaces: []string{"kube-system"}},
+			},
+		},
+	}
+
+	name, create := ConfigCreator(syncResources)()
+	if name != resources.GatekeeperConfigName {
+		t.Fatalf("expected Config name %q, got %q", resources.GatekeeperConfigName, name)
+	}
+
+	config, err := create(existing)
+	if err != nil {
+		t.Fatalf("failed to create Config: %v", err)
+	}
+
+	expected := configv1alpha1.ConfigSpec{
+		Sync: configv1alpha1.Sync{
+			SyncOnly: []configv1alpha1.SyncOnlyEntry{
+				{Version: "v1", Kind: "Namespace"},
+				{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+			},
+		},
+		Match: []configv1alpha1.MatchEntry{
+			{ExcludedNamespaces: []string{"kube-system"}},
+		},
+	}
+	if diff := deep.Equal(config.Spec, expected); diff != nil {
+		t.Errorf("Config spec does not match the expected spec. Diff: \n%v", diff)
+	}
+}
@@ -28,10 +28,8 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-const NodeLocalDNSCacheAddress = "169.254.20.10"
-
 // DefaultNetworkPolicyCreator Default policy creator denys all expect egress to kube-dns for all pods without any network policy applied.
-func DefaultNetworkPolicyCreator() reconciling.NamedNetworkPolicyCreatorGetter {
+func DefaultNetworkPolicyCreator(nodeLocalDNSCacheAddress string) reconciling.NamedNetworkPolicyCreatorGetter {
 	return func() (string, reconciling.NetworkPolicyCreator) {
 		dnsPort := intstr.FromInt(53)
 		protoUdp := corev1.ProtocolUDP
@@ -80,7 +78,7 @@ func DefaultNetworkPolicyCreator() reconciling.NamedNetworkPolicyCreatorGetter {
 						To: []networkingv1.NetworkPolicyPeer{
 							{
 								IPBlock: &networkingv1.IPBlock{
-									CIDR: fmt.Sprintf("%s/32", NodeLocalDNSCacheAddress),
+									CIDR: fmt.Sprintf("%s/32", nodeLocalDNSCacheAddress),
 								},
 							},
 						},
@@ -19,8 +19,10 @@ package metricsserver
 import (
 	"fmt"
 
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/certificates/servingcerthelper"
+	metricsserver "k8c.io/kubermatic/v2/pkg/resources/metrics-server"
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 	"k8c.io/kubermatic/v2/pkg/resources/registry"
 
@@ -62,7 +64,7 @@ func TLSServingCertSecretCreator(caGetter servingcerthelper.CAGetter) reconcilin
 }
 
 // DeploymentCreator returns the function to create and update the metrics server deployment.
-func DeploymentCreator(registryWithOverwrite registry.WithOverwriteFunc) reconciling.NamedDeploymentCreatorGetter {
+func DeploymentCreator(registryWithOverwrite registry.WithOverwriteFunc, settings *kubermaticv1.MetricsServerSettings) reconciling.NamedDeploymentCreatorGetter {
 	return func() (string, reconciling.DeploymentCreator) {
 		return resources.MetricsServerDeploymentName, func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
 			dep.Name = resources.MetricsServerDeploymentName
@@ -93,16 +95,7 @@ func DeploymentCreator(registryWithOverwrite registry.WithOverwriteFunc) reconci
 					Name:    resources.MetricsServerDeploymentName,
 					Image:   fmt.Sprintf("%s/%s:%s", registryWithOverwrite(resources.RegistryK8SGCR), imageName, imageTag),
 					Command: []string{"/metrics-server"},
-					Args: []string{
-						"--kubelet-insecure-tls",
-						"--kubelet-use-node-status-port",
-						"--secure-port", "4443",
-						"--metric-resolution", "15s",
-						"--kubelet-preferred-address-types", "InternalIP,ExternalIP,Hostname",
-						"--v", "1",
-						"--tls-cert-file", servingCertMountFolder + "/" + resources.ServingCertSecretKey,
-						"--tls-private-key-file", servingCertMountFolder + "/" + resources.ServingCertKeySecretKey,
-					},
+					Args:    getArgs(settings),
 					Ports: []corev1.ContainerPort{
 						{
 							ContainerPort: 4443,
@@ -194,6 +187,23 @@ func PodDisruptionBudgetCreator() reconciling.NamedPodDisruptionBudgetCreatorGet
 	}
 }
 
+func getArgs(settings *kubermaticv1.MetricsServerSettings) []string {
+	var args []string
+	if metricsserver.KubeletInsecureTLS(settings) {
+		args = append(args, "--kubelet-insecure-tls")
+	}
+
+	return append(args,
+		"--kubelet-use-node-status-port",
+		"--secure-port", "4443",
+		"--metric-resolution", metricsserver.MetricResolution(settings),
+		"--kubelet-preferred-address-types", "InternalIP,ExternalIP,Hostname",
+		"--v", "1",
+		"--tls-cert-file", servingCertMountFolder+"/"+resources.ServingCertSecretKey,
+		"--tls-private-key-file", servingCertMountFolder+"/"+resources.ServingCertKeySecretKey,
+	)
+}
+
 func getVolumes() []corev1.Volume {
 	return []corev1.Volume{
 		{
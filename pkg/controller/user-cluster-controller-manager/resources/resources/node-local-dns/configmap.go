@@ -32,7 +32,7 @@ const (
 )
 
 // ConfigMapCreator returns a ConfigMap containing the config for Node Local DNS cache.
-func ConfigMapCreator(dnsClusterIP string) reconciling.NamedConfigMapCreatorGetter {
+func ConfigMapCreator(dnsClusterIP, cacheAddress string) reconciling.NamedConfigMapCreatorGetter {
 	return func() (string, reconciling.ConfigMapCreator) {
 		return resources.NodeLocalDNSConfigMapName, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
 			if cm.Labels == nil {
@@ -45,7 +45,14 @@ func ConfigMapCreator(dnsClusterIP string) reconciling.NamedConfigMapCreatorGett
 				return nil, err
 			}
 			configBuf := bytes.Buffer{}
-			if err := t.Execute(&configBuf, struct{ DNSClusterIP string }{dnsClusterIP}); err != nil {
+			data := struct {
+				DNSClusterIP string
+				CacheAddress string
+			}{
+				DNSClusterIP: dnsClusterIP,
+				CacheAddress: cacheAddress,
+			}
+			if err := t.Execute(&configBuf, data); err != nil {
 				return nil, err
 			}
 
@@ -69,19 +76,19 @@ cluster.local:53 {
     }
     reload
     loop
-    bind 169.254.20.10
+    bind {{ .CacheAddress }}
     forward . {{ .DNSClusterIP }} {
             force_tcp
     }
     prometheus :9253
-    health 169.254.20.10:8080
+    health {{ .CacheAddress }}:8080
     }
 in-addr.arpa:53 {
     errors
     cache 30
     reload
     loop
-    bind 169.254.20.10
+    bind {{ .CacheAddress }}
     forward . {{ .DNSClusterIP }} {
             force_tcp
     }
@@ -92,7 +99,7 @@ ip6.arpa:53 {
     cache 30
     reload
     loop
-    bind 169.254.20.10
+    bind {{ .CacheAddress }}
     forward . {{ .DNSClusterIP }} {
             force_tcp
     }
@@ -103,7 +110,7 @@ ip6.arpa:53 {
     cache 30
     reload
     loop
-    bind 169.254.20.10
+    bind {{ .CacheAddress }}
     forward . /etc/resolv.conf
     prometheus :9253
     }
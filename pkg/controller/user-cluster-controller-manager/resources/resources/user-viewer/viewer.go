@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userviewer
+
+import (
+	"crypto/x509"
+	"fmt"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	ServiceAccountName = "external-viewer-user"
+	// TokenSecretName is the name of the ServiceAccount token Secret in kube-system.
+	TokenSecretName = "external-viewer-user-token"
+
+	clusterRoleBindingName = "external-viewer-user"
+	// viewClusterRoleName is the Kubernetes built-in read-only ClusterRole.
+	viewClusterRoleName = "view"
+)
+
+// ServiceAccountCreator returns a func to create/update the ServiceAccount used for read-only access.
+func ServiceAccountCreator() reconciling.NamedServiceAccountCreatorGetter {
+	return func() (string, reconciling.ServiceAccountCreator) {
+		return ServiceAccountName, func(sa *corev1.ServiceAccount) (*corev1.ServiceAccount, error) {
+			return sa, nil
+		}
+	}
+}
+
+// ClusterRoleBindingCreator returns a func to create/update the ClusterRoleBinding which will give the "external-viewer-user" read-only access.
+func ClusterRoleBindingCreator() reconciling.NamedClusterRoleBindingCreatorGetter {
+	return func() (string, reconciling.ClusterRoleBindingCreator) {
+		return clusterRoleBindingName, func(crb *rbacv1.ClusterRoleBinding) (*rbacv1.ClusterRoleBinding, error) {
+			crb.RoleRef = rbacv1.RoleRef{
+				Name:     viewClusterRoleName,
+				Kind:     "ClusterRole",
+				APIGroup: rbacv1.GroupName,
+			}
+			crb.Subjects = []rbacv1.Subject{
+				{
+					Kind:      rbacv1.ServiceAccountKind,
+					Name:      ServiceAccountName,
+					Namespace: metav1.NamespaceSystem,
+				},
+			}
+			return crb, nil
+		}
+	}
+}
+
+// TokenSecretCreator returns a func to create the Secret the token controller populates with
+// a token for the "external-viewer-user" ServiceAccount.
+func TokenSecretCreator() reconciling.NamedSecretCreatorGetter {
+	return func() (string, reconciling.SecretCreator) {
+		return TokenSecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			if se.Annotations == nil {
+				se.Annotations = map[string]string{}
+			}
+			se.Annotations[corev1.ServiceAccountNameKey] = ServiceAccountName
+			se.Type = corev1.SecretTypeServiceAccountToken
+			return se, nil
+		}
+	}
+}
+
+// KubeconfigCreator returns a func to create/update the Secret in the cluster namespace containing
+// a kubeconfig that authenticates as the "external-viewer-user" ServiceAccount.
+func KubeconfigCreator(caCert *x509.Certificate, server, clusterName string, token []byte) reconciling.NamedSecretCreatorGetter {
+	return func() (string, reconciling.SecretCreator) {
+		return resources.ViewerServiceAccountKubeconfigSecretName, func(se *corev1.Secret) (*corev1.Secret, error) {
+			if se.Data == nil {
+				se.Data = map[string][]byte{}
+			}
+
+			config := resources.GetBaseKubeconfig(caCert, server, clusterName)
+			config.AuthInfos = map[string]*clientcmdapi.AuthInfo{
+				Username(): {
+					Token: string(token),
+				},
+			}
+			config.Contexts[resources.KubeconfigDefaultContextKey].AuthInfo = Username()
+
+			b, err := clientcmd.Write(*config)
+			if err != nil {
+				return nil, err
+			}
+
+			se.Data[resources.KubeconfigSecretKey] = b
+
+			return se, nil
+		}
+	}
+}
+
+// Username returns the name the apiserver authenticates the "external-viewer-user" ServiceAccount as.
+func Username() string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", metav1.NamespaceSystem, ServiceAccountName)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userviewer
+
+import (
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/certificates/triple"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestKubeconfigCreator(t *testing.T) {
+	ca, err := triple.NewCA("test-ca")
+	if err != nil {
+		t.Fatalf("failed to create CA: %v", err)
+	}
+
+	name, create := KubeconfigCreator(ca.Cert, "https://example.com:6443", "test-cluster", []byte("viewer-token"))()
+	if name != resources.ViewerServiceAccountKubeconfigSecretName {
+		t.Fatalf("expected Secret name %q, got %q", resources.ViewerServiceAccountKubeconfigSecretName, name)
+	}
+
+	secret, err := create(&corev1.Secret{})
+	if err != nil {
+		t.Fatalf("failed to create Secret: %v", err)
+	}
+
+	config, err := clientcmd.Load(secret.Data[resources.KubeconfigSecretKey])
+	if err != nil {
+		t.Fatalf("failed to parse kubeconfig: %v", err)
+	}
+
+	expectedUser := "system:serviceaccount:kube-system:external-viewer-user"
+	context := config.Contexts[config.CurrentContext]
+	if context == nil {
+		t.Fatalf("kubeconfig has no current context %q", config.CurrentContext)
+	}
+	if context.AuthInfo != expectedUser {
+		t.Errorf("expected current context to use AuthInfo %q, got %q", expectedUser, context.AuthInfo)
+	}
+
+	authInfo := config.AuthInfos[expectedUser]
+	if authInfo == nil {
+		t.Fatalf("kubeconfig has no AuthInfo for %q", expectedUser)
+	}
+	if authInfo.Token != "viewer-token" {
+		t.Errorf("expected AuthInfo to use the ServiceAccount token, got %q", authInfo.Token)
+	}
+	if len(authInfo.ClientCertificateData) > 0 || len(authInfo.ClientKeyData) > 0 {
+		t.Error("expected AuthInfo to not contain client certificates")
+	}
+}
+
+func TestClusterRoleBindingCreator(t *testing.T) {
+	_, create := ClusterRoleBindingCreator()()
+
+	crb, err := create(&rbacv1.ClusterRoleBinding{})
+	if err != nil {
+		t.Fatalf("failed to create ClusterRoleBinding: %v", err)
+	}
+
+	if crb.RoleRef.Kind != "ClusterRole" || crb.RoleRef.Name != "view" {
+		t.Errorf("expected binding to the read-only ClusterRole \"view\", got %s %q", crb.RoleRef.Kind, crb.RoleRef.Name)
+	}
+
+	if len(crb.Subjects) != 1 {
+		t.Fatalf("expected exactly one subject, got %d", len(crb.Subjects))
+	}
+
+	expected := rbacv1.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      ServiceAccountName,
+		Namespace: metav1.NamespaceSystem,
+	}
+	if crb.Subjects[0] != expected {
+		t.Errorf("expected subject %+v, got %+v", expected, crb.Subjects[0])
+	}
+}
+
+func TestTokenSecretCreator(t *testing.T) {
+	_, create := TokenSecretCreator()()
+
+	secret, err := create(&corev1.Secret{})
+	if err != nil {
+		t.Fatalf("failed to create Secret: %v", err)
+	}
+
+	if secret.Type != corev1.SecretTypeServiceAccountToken {
+		t.Errorf("expected Secret type %q, got %q", corev1.SecretTypeServiceAccountToken, secret.Type)
+	}
+	if sa := secret.Annotations[corev1.ServiceAccountNameKey]; sa != ServiceAccountName {
+		t.Errorf("expected Secret to reference ServiceAccount %q, got %q", ServiceAccountName, sa)
+	}
+}
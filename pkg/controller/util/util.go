@@ -171,19 +171,109 @@ func EnqueueConst(queueKey string) handler.EventHandler {
 	})
 }
 
+// ConcurrencyPartition determines how the concurrency limit for cluster updates
+// is shared among clusters.
+type ConcurrencyPartition string
+
+const (
+	// ConcurrencyPartitionNone applies the concurrency limit globally to all clusters in the seed.
+	ConcurrencyPartitionNone ConcurrencyPartition = ""
+	// ConcurrencyPartitionProject shares the concurrency limit fairly among the owning projects.
+	ConcurrencyPartitionProject ConcurrencyPartition = "project"
+	// ConcurrencyPartitionWorkerName shares the concurrency limit fairly among the worker names.
+	ConcurrencyPartitionWorkerName ConcurrencyPartition = "worker-name"
+)
+
+// AllConcurrencyPartitions contains all supported concurrency partitions.
+var AllConcurrencyPartitions = []ConcurrencyPartition{
+	ConcurrencyPartitionNone,
+	ConcurrencyPartitionProject,
+	ConcurrencyPartitionWorkerName,
+}
+
+// ValidateConcurrencyPartition returns an error if the given partition is not supported.
+func ValidateConcurrencyPartition(partition ConcurrencyPartition) error {
+	for _, p := range AllConcurrencyPartitions {
+		if p == partition {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown concurrency partition %q, must be one of %q, %q or empty", partition, ConcurrencyPartitionProject, ConcurrencyPartitionWorkerName)
+}
+
+// key returns the partition the given cluster belongs to.
+func (p ConcurrencyPartition) key(cluster *kubermaticv1.Cluster) string {
+	switch p {
+	case ConcurrencyPartitionProject:
+		return cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	case ConcurrencyPartitionWorkerName:
+		return cluster.Labels[kubermaticv1.WorkerNameLabelKey]
+	default:
+		return ""
+	}
+}
+
 // ClusterAvailableForReconciling returns true if the given cluster can be reconciled. This is true if
 // the cluster does not yet have the SeedResourcesUpToDate condition or if the concurrency limit of the
 // controller is not yet reached. This ensures that not too many cluster updates are running at the same
 // time, but also makes sure that un-UpToDate clusters will continue to be reconciled.
-func ClusterAvailableForReconciling(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, concurrencyLimit int) (bool, error) {
+//
+// If a partition is given, a cluster is additionally only available as long as its partition has
+// fewer updates in progress than its fair share of the limit, so that a single partition cannot starve
+// all others.
+func ClusterAvailableForReconciling(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, concurrencyLimit int, partition ConcurrencyPartition) (bool, error) {
 	if !cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionSeedResourcesUpToDate, corev1.ConditionTrue) {
 		return true, nil
 	}
 
-	limitReached, err := ConcurrencyLimitReached(ctx, client, concurrencyLimit)
+	if partition == ConcurrencyPartitionNone {
+		limitReached, err := ConcurrencyLimitReached(ctx, client, concurrencyLimit)
+		return !limitReached, err
+	}
+
+	limitReached, err := PartitionedConcurrencyLimitReached(ctx, client, concurrencyLimit, partition, partition.key(cluster))
 	return !limitReached, err
 }
 
+// PartitionedConcurrencyLimitReached works like ConcurrencyLimitReached, but additionally limits each
+// partition to a fair share of the limit: The limit is divided evenly among all partitions with updates
+// in progress (including the given one), with at least one update per partition. The global limit is
+// never exceeded, but a partition that has used up its share cannot take slots freed up by other
+// partitions as long as they still have updates in progress.
+func PartitionedConcurrencyLimitReached(ctx context.Context, client ctrlruntimeclient.Client, limit int, partition ConcurrencyPartition, partitionKey string) (bool, error) {
+	clusters := &kubermaticv1.ClusterList{}
+	if err := client.List(ctx, clusters); err != nil {
+		return true, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	inProgress := map[string]int{}
+	total := 0
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if !cluster.Status.HasConditionValue(kubermaticv1.ClusterConditionSeedResourcesUpToDate, corev1.ConditionTrue) {
+			inProgress[partition.key(cluster)]++
+			total++
+		}
+	}
+
+	partitions := len(inProgress)
+	if _, ok := inProgress[partitionKey]; !ok {
+		partitions++
+	}
+
+	fairShare := limit / partitions
+	if fairShare < 1 {
+		fairShare = 1
+	}
+
+	if total >= limit {
+		return true, nil
+	}
+
+	return inProgress[partitionKey] >= fairShare, nil
+}
+
 // ConcurrencyLimitReached checks all the clusters inside the seed cluster and checks for the
 // SeedResourcesUpToDate condition. Returns true if the number of clusters without this condition
 // is equal or larger than the given limit.
@@ -22,6 +22,9 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -57,3 +60,112 @@ func TestConcurrencyLimitReached(t *testing.T) {
 		})
 	}
 }
+
+func TestPartitionedConcurrencyLimitReached(t *testing.T) {
+	cluster := func(name, project string, upToDate bool) *kubermaticv1.Cluster {
+		c := &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: project},
+			},
+		}
+		if upToDate {
+			c.Status.Conditions = map[kubermaticv1.ClusterConditionType]kubermaticv1.ClusterCondition{
+				kubermaticv1.ClusterConditionSeedResourcesUpToDate: {Status: corev1.ConditionTrue},
+			}
+		}
+		return c
+	}
+
+	testCases := []struct {
+		name                 string
+		clusters             []ctrlruntimeclient.Object
+		limit                int
+		project              string
+		expectedLimitReached bool
+	}{
+		{
+			name: "global limit not reached",
+			clusters: []ctrlruntimeclient.Object{
+				cluster("a", "noisy", false),
+				cluster("b", "quiet", true),
+			},
+			limit:                2,
+			project:              "noisy",
+			expectedLimitReached: false,
+		},
+		{
+			name: "noisy project cannot exceed global limit",
+			clusters: []ctrlruntimeclient.Object{
+				cluster("a", "noisy", false),
+				cluster("b", "noisy", false),
+				cluster("c", "noisy", true),
+			},
+			limit:                2,
+			project:              "noisy",
+			expectedLimitReached: true,
+		},
+		{
+			name: "quiet project cannot exceed global limit",
+			clusters: []ctrlruntimeclient.Object{
+				cluster("a", "noisy", false),
+				cluster("b", "noisy", false),
+				cluster("c", "quiet", true),
+			},
+			limit:                2,
+			project:              "quiet",
+			expectedLimitReached: true,
+		},
+		{
+			name: "noisy project is limited to its fair share",
+			clusters: []ctrlruntimeclient.Object{
+				cluster("a", "noisy", false),
+				cluster("b", "noisy", false),
+				cluster("c", "noisy", true),
+				cluster("d", "quiet", false),
+			},
+			limit:                4,
+			project:              "noisy",
+			expectedLimitReached: true,
+		},
+		{
+			name: "quiet project gets its fair share",
+			clusters: []ctrlruntimeclient.Object{
+				cluster("a", "noisy", false),
+				cluster("b", "noisy", false),
+				cluster("c", "quiet", false),
+				cluster("d", "quiet", true),
+			},
+			limit:                4,
+			project:              "quiet",
+			expectedLimitReached: false,
+		},
+		{
+			name: "quiet project is limited once its fair share is used",
+			clusters: []ctrlruntimeclient.Object{
+				cluster("a", "noisy", false),
+				cluster("b", "noisy", false),
+				cluster("c", "quiet", false),
+				cluster("d", "quiet", true),
+			},
+			limit:                2,
+			project:              "quiet",
+			expectedLimitReached: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := ctrlruntimefakeclient.NewClientBuilder().WithObjects(testCase.clusters...).Build()
+
+			reached, err := PartitionedConcurrencyLimitReached(context.Background(), client, testCase.limit, ConcurrencyPartitionProject, testCase.project)
+			if err != nil {
+				t.Fatalf("failed to run test: %v with error: %v", testCase.name, err)
+			}
+
+			if reached != testCase.expectedLimitReached {
+				t.Fatalf("failed to run test: %v, expects: %v, got: %v", testCase.name, testCase.expectedLimitReached, reached)
+			}
+		})
+	}
+}
@@ -124,7 +124,7 @@ func (r *Reconciler) reconcile(ctx context.Context, allowedRegistry *kubermaticv
 	ctCreatorGetters := []reconciling.NamedKubermaticV1ConstraintTemplateCreatorGetter{
 		allowedRegistryCTCreatorGetter(),
 	}
-	err = reconciling.ReconcileKubermaticV1ConstraintTemplates(ctx, ctCreatorGetters, "", r.masterClient)
+	err = reconciling.ReconcileKubermaticV1ConstraintTemplates(ctx, ctCreatorGetters, r.masterClient)
 	if err != nil {
 		return fmt.Errorf("error ensuring AllowedRegistry Constraint Template: %w", err)
 	}
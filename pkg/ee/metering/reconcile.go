@@ -79,7 +79,7 @@ func ReconcileMeteringResources(ctx context.Context, client ctrlruntimeclient.Cl
 
 	if err := reconciling.ReconcileClusterRoleBindings(ctx, []reconciling.NamedClusterRoleBindingCreatorGetter{
 		clusterRoleBindingCreator(resources.KubermaticNamespace),
-	}, "", client); err != nil {
+	}, client); err != nil {
 		return fmt.Errorf("failed to reconcile metering ClusterRoleBindings: %w", err)
 	}
 
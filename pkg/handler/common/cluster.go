@@ -224,7 +224,7 @@ func GenerateCluster(
 	if spec.ContainerRuntime == "" {
 		spec.ContainerRuntime = "containerd"
 	}
-	if err = validation.ValidateContainerRuntime(spec); err != nil {
+	if err = validation.ValidateContainerRuntime(spec, dc); err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
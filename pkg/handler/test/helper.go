@@ -1944,7 +1944,7 @@ func GenAPIEtcdBackupConfig(name, clusterID string) *apiv2.EtcdBackupConfig {
 		},
 		Spec: apiv2.EtcdBackupConfigSpec{
 			ClusterID:   clusterID,
-			Schedule:    "5 * * * * *",
+			Schedule:    "5 * * * *",
 			Keep:        &keep,
 			Destination: "s3",
 		},
@@ -1966,7 +1966,7 @@ func GenEtcdBackupConfig(name string, cluster *kubermaticv1.Cluster, projectID s
 		Spec: kubermaticv1.EtcdBackupConfigSpec{
 			Name:        name,
 			Cluster:     *clusterObjectRef,
-			Schedule:    "5 * * * * *",
+			Schedule:    "5 * * * *",
 			Keep:        &keep,
 			Destination: "s3",
 		},
@@ -36,9 +36,11 @@ import (
 	"k8c.io/kubermatic/v2/pkg/handler/v2/cluster"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	"k8c.io/kubermatic/v2/pkg/validation"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/reference"
 )
@@ -63,6 +65,10 @@ func CreateEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider prov
 			return nil, err
 		}
 
+		if errs := validation.ValidateEtcdBackupConfigSpec(&ebc.Spec, field.NewPath("spec")); len(errs) > 0 {
+			return nil, utilerrors.NewBadRequest("invalid etcd backup config: %v", errs.ToAggregate())
+		}
+
 		// set projectID label
 		ebc.Labels = map[string]string{
 			kubermaticv1.ProjectIDLabelKey: req.ProjectID,
@@ -230,6 +236,10 @@ func PatchEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provi
 		newEBC.Spec.Schedule = req.Body.Schedule
 		newEBC.Spec.Destination = req.Body.Destination
 
+		if errs := validation.ValidateEtcdBackupConfigSpec(&newEBC.Spec, field.NewPath("spec")); len(errs) > 0 {
+			return nil, utilerrors.NewBadRequest("invalid etcd backup config: %v", errs.ToAggregate())
+		}
+
 		// apply patch
 		ebc, err := patchEtcdBackupConfig(ctx, userInfoGetter, req.ProjectID, originalEBC, newEBC)
 		if err != nil {
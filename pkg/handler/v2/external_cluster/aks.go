@@ -24,7 +24,6 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
-	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/Azure/go-autorest/autorest/to"
 	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/go-kit/kit/endpoint"
@@ -315,37 +314,22 @@ func createOrImportAKSCluster(ctx context.Context, name string, userInfoGetter p
 }
 
 func patchAKSCluster(ctx context.Context, oldCluster, newCluster *apiv2.ExternalCluster, secretKeySelector provider.SecretKeySelectorValueFunc, cloud *kubermaticv1.ExternalClusterCloudSpec) (*apiv2.ExternalCluster, error) {
-	clusterName := cloud.AKS.Name
-	resourceGroup := cloud.AKS.ResourceGroup
-
-	newVersion := newCluster.Spec.Version.Semver().String()
-
-	cred, err := aks.GetCredentialsForCluster(*cloud, secretKeySelector)
-	if err != nil {
-		return nil, err
+	newVersion := newCluster.Spec.Version.Semver()
+	if oldCluster.Spec.Version.Semver().Equal(newVersion) {
+		return newCluster, nil
 	}
 
-	aksClient, err := aks.GetAKSClusterClient(cred)
-	if err != nil {
-		return nil, err
-	}
-	aksCluster, err := aks.GetAKSCluster(ctx, aksClient, cloud)
+	cred, err := aks.GetCredentialsForCluster(*cloud, secretKeySelector)
 	if err != nil {
 		return nil, err
 	}
 
-	location := aksCluster.Location
-
-	updateCluster := containerservice.ManagedCluster{
-		Location: location,
-		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
-			KubernetesVersion: &newVersion,
-		},
-	}
-	_, err = aksClient.CreateOrUpdate(ctx, resourceGroup, clusterName, updateCluster)
+	status, err := aks.UpgradeAKSCluster(ctx, cred, cloud, newVersion.String())
 	if err != nil {
 		return nil, err
 	}
+	newCluster.Status.State = status.State
+	newCluster.Status.ProviderState = status.ProviderState
 
 	return newCluster, nil
 }
@@ -532,7 +516,7 @@ func patchAKSMachineDeployment(ctx context.Context, oldCluster, newCluster *apiv
 		return nil, err
 	}
 
-	agentPoolClient, err := getAKSNodePoolClient(cred)
+	agentPoolClient, err := aks.GetAKSNodePoolClient(cred)
 	if err != nil {
 		return nil, err
 	}
@@ -543,7 +527,7 @@ func patchAKSMachineDeployment(ctx context.Context, oldCluster, newCluster *apiv
 	currentVersion := oldCluster.NodeDeployment.Spec.Template.Versions.Kubelet
 	desiredVersion := newCluster.NodeDeployment.Spec.Template.Versions.Kubelet
 	if desiredReplicas != currentReplicas {
-		_, err = resizeAKSNodePool(ctx, *agentPoolClient, cloud, nodePoolName, desiredReplicas)
+		_, err = aks.ScaleAKSNodePool(ctx, secretKeySelector, cloud, nodePoolName, desiredReplicas)
 		if err != nil {
 			return nil, err
 		}
@@ -562,28 +546,6 @@ func patchAKSMachineDeployment(ctx context.Context, oldCluster, newCluster *apiv
 	return newCluster, nil
 }
 
-func resizeAKSNodePool(ctx context.Context, agentPoolClient containerservice.AgentPoolsClient, cloud *kubermaticv1.ExternalClusterCloudSpec, nodePoolName string, desiredSize int32) (*containerservice.AgentPoolsCreateOrUpdateFuture, error) {
-	pool, err := agentPoolClient.Get(ctx, cloud.AKS.ResourceGroup, cloud.AKS.Name, nodePoolName)
-	if err != nil {
-		return nil, err
-	}
-	nodePool := containerservice.AgentPool{
-		Name: &nodePoolName,
-		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
-			Count: &desiredSize,
-		},
-	}
-	if pool.ManagedClusterAgentPoolProfileProperties.Mode == containerservice.AgentPoolModeSystem {
-		nodePool.ManagedClusterAgentPoolProfileProperties.Mode = containerservice.AgentPoolModeSystem
-	}
-	update, err := updateAKSNodePool(ctx, agentPoolClient, cloud, nodePoolName, nodePool)
-	if err != nil {
-		return nil, err
-	}
-
-	return update, nil
-}
-
 func upgradeNodePool(ctx context.Context, agentPoolClient containerservice.AgentPoolsClient, cloud *kubermaticv1.ExternalClusterCloudSpec, nodePoolName string, desiredVersion string) (*containerservice.AgentPoolsCreateOrUpdateFuture, error) {
 	pool, err := agentPoolClient.Get(ctx, cloud.AKS.ResourceGroup, cloud.AKS.Name, nodePoolName)
 	if err != nil {
@@ -606,17 +568,6 @@ func upgradeNodePool(ctx context.Context, agentPoolClient containerservice.Agent
 	return update, nil
 }
 
-func getAKSNodePoolClient(cred resources.AKSCredentials) (*containerservice.AgentPoolsClient, error) {
-	var err error
-
-	agentPoolClient := containerservice.NewAgentPoolsClient(cred.SubscriptionID)
-	agentPoolClient.Authorizer, err = auth.NewClientCredentialsConfig(cred.ClientID, cred.ClientSecret, cred.TenantID).Authorizer()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create authorizer: %w", err)
-	}
-	return &agentPoolClient, nil
-}
-
 func updateAKSNodePool(ctx context.Context, agentPoolClient containerservice.AgentPoolsClient, cloud *kubermaticv1.ExternalClusterCloudSpec, nodePoolName string, nodePool containerservice.AgentPool) (*containerservice.AgentPoolsCreateOrUpdateFuture, error) {
 	result, err := agentPoolClient.CreateOrUpdate(ctx, cloud.AKS.ResourceGroup, cloud.AKS.Name, nodePoolName, nodePool)
 	if err != nil {
@@ -632,7 +583,7 @@ func deleteAKSNodeGroup(ctx context.Context, cloud *kubermaticv1.ExternalCluster
 		return err
 	}
 
-	agentPoolClient, err := getAKSNodePoolClient(cred)
+	agentPoolClient, err := aks.GetAKSNodePoolClient(cred)
 	if err != nil {
 		return err
 	}
@@ -648,7 +599,7 @@ func createAKSNodePool(ctx context.Context, cloud *kubermaticv1.ExternalClusterC
 		return nil, err
 	}
 
-	agentPoolClient, err := getAKSNodePoolClient(cred)
+	agentPoolClient, err := aks.GetAKSNodePoolClient(cred)
 	if err != nil {
 		return nil, err
 	}
@@ -723,7 +674,7 @@ func AKSNodeVersionsWithClusterCredentialsEndpoint(userInfoGetter provider.UserI
 			return nil, err
 		}
 
-		agentPoolClient, err := getAKSNodePoolClient(cred)
+		agentPoolClient, err := aks.GetAKSNodePoolClient(cred)
 		if err != nil {
 			return nil, err
 		}
@@ -20,9 +20,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
+	semverlib "github.com/Masterminds/semver/v3"
 
 	apiv2 "k8c.io/kubermatic/v2/pkg/api/v2"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
@@ -111,6 +113,52 @@ func GetCredentialsForCluster(cloud kubermaticv1.ExternalClusterCloudSpec, secre
 	return cred, nil
 }
 
+// UpdateCredentialsForCluster replaces the service principal credentials of an imported AKS cluster,
+// e.g. after the client secret was rotated. To not lock KKP out of the cluster, the new credentials
+// are only persisted if they can be used to fetch the cluster. The credentials Secret is updated in
+// place and the cluster's CredentialsReference is set accordingly; the caller is responsible for
+// persisting the changed ExternalCluster object.
+func UpdateCredentialsForCluster(ctx context.Context, clusterProvider provider.ExternalClusterProvider, cluster *kubermaticv1.ExternalCluster, cred resources.AKSCredentials) error {
+	cloud := cluster.Spec.CloudSpec
+	if cloud == nil || cloud.AKS == nil {
+		return errors.New("cluster is not an AKS cluster")
+	}
+
+	aksClient, err := GetAKSClusterClient(cred)
+	if err != nil {
+		return err
+	}
+
+	if _, err := GetAKSCluster(ctx, aksClient, cloud); err != nil {
+		return fmt.Errorf("new credentials cannot be used to access the cluster: %w", err)
+	}
+
+	apiCloud := &apiv2.ExternalClusterCloudSpec{
+		AKS: &apiv2.AKSCloudSpec{
+			Name:           cloud.AKS.Name,
+			ResourceGroup:  cloud.AKS.ResourceGroup,
+			TenantID:       cred.TenantID,
+			SubscriptionID: cred.SubscriptionID,
+			ClientID:       cred.ClientID,
+			ClientSecret:   cred.ClientSecret,
+		},
+	}
+
+	keyRef, err := clusterProvider.CreateOrUpdateCredentialSecretForCluster(ctx, apiCloud, cluster.Labels[kubermaticv1.ProjectIDLabelKey], cluster.Name)
+	if err != nil {
+		return err
+	}
+
+	// inline credentials take precedence over the Secret, so they must be removed
+	cloud.AKS.TenantID = ""
+	cloud.AKS.SubscriptionID = ""
+	cloud.AKS.ClientID = ""
+	cloud.AKS.ClientSecret = ""
+	cloud.AKS.CredentialsReference = keyRef
+
+	return nil
+}
+
 func GetAKSClusterClient(cred resources.AKSCredentials) (*containerservice.ManagedClustersClient, error) {
 	var err error
 
@@ -134,6 +182,106 @@ func GetAKSCluster(ctx context.Context, aksClient *containerservice.ManagedClust
 	return &aksCluster, nil
 }
 
+func GetAKSNodePoolClient(cred resources.AKSCredentials) (*containerservice.AgentPoolsClient, error) {
+	var err error
+
+	agentPoolClient := containerservice.NewAgentPoolsClient(cred.SubscriptionID)
+	agentPoolClient.Authorizer, err = auth.NewClientCredentialsConfig(cred.ClientID, cred.ClientSecret, cred.TenantID).Authorizer()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create authorizer: %w", err)
+	}
+	return &agentPoolClient, nil
+}
+
+// ListAKSNodePools returns all node pools (agent pools) of the given AKS cluster.
+func ListAKSNodePools(ctx context.Context, secretKeySelector provider.SecretKeySelectorValueFunc, cloud *kubermaticv1.ExternalClusterCloudSpec) ([]containerservice.AgentPool, error) {
+	agentPoolClient, err := getAKSNodePoolClientForCluster(secretKeySelector, cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceGroup := cloud.AKS.ResourceGroup
+	clusterName := cloud.AKS.Name
+
+	iterator, err := agentPoolClient.ListComplete(ctx, resourceGroup, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list node pools of AKS managed cluster %v from resource group %v: %w", clusterName, resourceGroup, err)
+	}
+
+	nodePools := []containerservice.AgentPool{}
+	for iterator.NotDone() {
+		nodePools = append(nodePools, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("cannot list node pools of AKS managed cluster %v from resource group %v: %w", clusterName, resourceGroup, err)
+		}
+	}
+
+	return nodePools, nil
+}
+
+// GetAKSNodePool returns a single node pool (agent pool) of the given AKS cluster.
+func GetAKSNodePool(ctx context.Context, secretKeySelector provider.SecretKeySelectorValueFunc, cloud *kubermaticv1.ExternalClusterCloudSpec, nodePoolName string) (*containerservice.AgentPool, error) {
+	agentPoolClient, err := getAKSNodePoolClientForCluster(secretKeySelector, cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	return getAKSNodePool(ctx, agentPoolClient, cloud, nodePoolName)
+}
+
+// ScaleAKSNodePool sets the node count of a node pool (agent pool) of the given AKS cluster.
+// The scaling happens asynchronously, the returned future can be used to wait for its completion.
+func ScaleAKSNodePool(ctx context.Context, secretKeySelector provider.SecretKeySelectorValueFunc, cloud *kubermaticv1.ExternalClusterCloudSpec, nodePoolName string, desiredSize int32) (*containerservice.AgentPoolsCreateOrUpdateFuture, error) {
+	agentPoolClient, err := getAKSNodePoolClientForCluster(secretKeySelector, cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := getAKSNodePool(ctx, agentPoolClient, cloud, nodePoolName)
+	if err != nil {
+		return nil, err
+	}
+
+	nodePool := containerservice.AgentPool{
+		Name: &nodePoolName,
+		ManagedClusterAgentPoolProfileProperties: &containerservice.ManagedClusterAgentPoolProfileProperties{
+			Count: &desiredSize,
+		},
+	}
+	// the mode defaults to "User", system pools must keep their mode
+	if pool.ManagedClusterAgentPoolProfileProperties != nil && pool.ManagedClusterAgentPoolProfileProperties.Mode == containerservice.AgentPoolModeSystem {
+		nodePool.ManagedClusterAgentPoolProfileProperties.Mode = containerservice.AgentPoolModeSystem
+	}
+
+	update, err := agentPoolClient.CreateOrUpdate(ctx, cloud.AKS.ResourceGroup, cloud.AKS.Name, nodePoolName, nodePool)
+	if err != nil {
+		return nil, fmt.Errorf("cannot scale node pool %v of AKS managed cluster %v from resource group %v: %w", nodePoolName, cloud.AKS.Name, cloud.AKS.ResourceGroup, err)
+	}
+
+	return &update, nil
+}
+
+func getAKSNodePoolClientForCluster(secretKeySelector provider.SecretKeySelectorValueFunc, cloud *kubermaticv1.ExternalClusterCloudSpec) (*containerservice.AgentPoolsClient, error) {
+	cred, err := GetCredentialsForCluster(*cloud, secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetAKSNodePoolClient(cred)
+}
+
+func getAKSNodePool(ctx context.Context, agentPoolClient *containerservice.AgentPoolsClient, cloud *kubermaticv1.ExternalClusterCloudSpec, nodePoolName string) (*containerservice.AgentPool, error) {
+	resourceGroup := cloud.AKS.ResourceGroup
+	clusterName := cloud.AKS.Name
+
+	pool, err := agentPoolClient.Get(ctx, resourceGroup, clusterName, nodePoolName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get node pool %v of AKS managed cluster %v from resource group %v: %w", nodePoolName, clusterName, resourceGroup, err)
+	}
+
+	return &pool, nil
+}
+
 func GetAKSClusterStatus(ctx context.Context, secretKeySelector provider.SecretKeySelectorValueFunc, cloud *kubermaticv1.ExternalClusterCloudSpec) (*apiv2.ExternalClusterStatus, error) {
 	cred, err := GetCredentialsForCluster(*cloud, secretKeySelector)
 	if err != nil {
@@ -148,27 +296,162 @@ func GetAKSClusterStatus(ctx context.Context, secretKeySelector provider.SecretK
 	if err != nil {
 		return nil, err
 	}
-	state := apiv2.UNKNOWN
-	if aksCluster.ManagedClusterProperties != nil {
-		var powerState containerservice.Code
-		var provisioningState string
-		if aksCluster.ManagedClusterProperties.PowerState != nil {
-			powerState = aksCluster.ManagedClusterProperties.PowerState.Code
-		}
-		if aksCluster.ManagedClusterProperties.ProvisioningState != nil {
-			provisioningState = *aksCluster.ManagedClusterProperties.ProvisioningState
+
+	return convertAKSClusterStatus(aksCluster), nil
+}
+
+// convertAKSClusterStatus returns the status of the given AKS cluster, including its raw
+// provisioning state and an explanation if the cluster is in an error state.
+func convertAKSClusterStatus(aksCluster *containerservice.ManagedCluster) *apiv2.ExternalClusterStatus {
+	status := &apiv2.ExternalClusterStatus{
+		State: apiv2.UNKNOWN,
+	}
+
+	properties := aksCluster.ManagedClusterProperties
+	if properties == nil {
+		return status
+	}
+
+	var powerState containerservice.Code
+	if properties.PowerState != nil {
+		powerState = properties.PowerState.Code
+	}
+	if properties.ProvisioningState != nil {
+		status.ProviderState = *properties.ProvisioningState
+	}
+
+	status.State = convertAKSStatus(status.ProviderState, powerState)
+	if status.State == apiv2.ERROR || status.ProviderState == "Canceled" {
+		status.StatusMessage = aksErrorMessage(status.ProviderState, properties.AgentPoolProfiles)
+	}
+
+	return status
+}
+
+// aksErrorMessage explains why a cluster is in an error state or its last operation was
+// canceled. AKS does not report failure details for the cluster itself, so the failed agent
+// pools are listed instead.
+func aksErrorMessage(provisioningState string, agentPools *[]containerservice.ManagedClusterAgentPoolProfile) string {
+	var message string
+	switch provisioningState {
+	case "Canceled":
+		message = "the last operation on the cluster was canceled"
+	default:
+		message = fmt.Sprintf("the cluster provisioning state is %q", provisioningState)
+	}
+
+	if agentPools == nil {
+		return message
+	}
+
+	var failedPools []string
+	for _, pool := range *agentPools {
+		if pool.Name != nil && pool.ProvisioningState != nil && *pool.ProvisioningState != "Succeeded" {
+			failedPools = append(failedPools, fmt.Sprintf("%s (%s)", *pool.Name, *pool.ProvisioningState))
 		}
-		state = convertAKSStatus(provisioningState, powerState)
+	}
+
+	if len(failedPools) > 0 {
+		message = fmt.Sprintf("%s, affected agent pools: %s", message, strings.Join(failedPools, ", "))
+	}
+
+	return message
+}
+
+// UpgradeAKSCluster triggers a control plane upgrade of the given AKS cluster to targetVersion. The
+// target version must be one of the upgrades Azure offers for the cluster. The upgrade happens
+// asynchronously, the returned status reflects the cluster state while upgrading.
+func UpgradeAKSCluster(ctx context.Context, cred resources.AKSCredentials, cloud *kubermaticv1.ExternalClusterCloudSpec, targetVersion string) (*apiv2.ExternalClusterStatus, error) {
+	resourceGroup := cloud.AKS.ResourceGroup
+	clusterName := cloud.AKS.Name
+
+	aksClient, err := GetAKSClusterClient(cred)
+	if err != nil {
+		return nil, err
+	}
+
+	aksCluster, err := GetAKSCluster(ctx, aksClient, cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	upgradeProfile, err := aksClient.GetUpgradeProfile(ctx, resourceGroup, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get upgrade profile of AKS managed cluster %v from resource group %v: %w", clusterName, resourceGroup, err)
+	}
+
+	allowed, err := allowedUpgrades(upgradeProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := semverlib.NewVersion(targetVersion)
+	if err != nil {
+		return nil, fmt.Errorf("invalid target version %q: %w", targetVersion, err)
+	}
+
+	if !containsVersion(allowed, target) {
+		return nil, fmt.Errorf("cannot upgrade AKS managed cluster %v from resource group %v to version %v, allowed upgrades are: %v", clusterName, resourceGroup, target, allowed)
+	}
+
+	version := target.String()
+	updateCluster := containerservice.ManagedCluster{
+		Location: aksCluster.Location,
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			KubernetesVersion: &version,
+		},
+	}
+
+	if _, err := aksClient.CreateOrUpdate(ctx, resourceGroup, clusterName, updateCluster); err != nil {
+		return nil, fmt.Errorf("cannot upgrade AKS managed cluster %v from resource group %v: %w", clusterName, resourceGroup, err)
+	}
+
+	var powerState containerservice.Code
+	if aksCluster.ManagedClusterProperties != nil && aksCluster.ManagedClusterProperties.PowerState != nil {
+		powerState = aksCluster.ManagedClusterProperties.PowerState.Code
 	}
 
 	return &apiv2.ExternalClusterStatus{
-		State: state,
+		State:         convertAKSStatus("Upgrading", powerState),
+		ProviderState: "Upgrading",
 	}, nil
 }
 
+func allowedUpgrades(profile containerservice.ManagedClusterUpgradeProfile) ([]*semverlib.Version, error) {
+	versions := []*semverlib.Version{}
+
+	properties := profile.ManagedClusterUpgradeProfileProperties
+	if properties == nil || properties.ControlPlaneProfile == nil || properties.ControlPlaneProfile.Upgrades == nil {
+		return versions, nil
+	}
+
+	for _, upgrade := range *properties.ControlPlaneProfile.Upgrades {
+		if upgrade.KubernetesVersion == nil {
+			continue
+		}
+		version, err := semverlib.NewVersion(*upgrade.KubernetesVersion)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func containsVersion(versions []*semverlib.Version, version *semverlib.Version) bool {
+	for _, v := range versions {
+		if v.Equal(version) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func convertAKSStatus(provisioningState string, powerState containerservice.Code) apiv2.ExternalClusterState {
 	switch {
-	case provisioningState == "Creating":
+	case provisioningState == "Creating" || provisioningState == "Accepted":
 		return apiv2.PROVISIONING
 	case provisioningState == "Succeeded" && powerState == "Running":
 		return apiv2.RUNNING
@@ -180,9 +463,12 @@ func convertAKSStatus(provisioningState string, powerState containerservice.Code
 		return apiv2.STOPPED
 	case provisioningState == "Failed":
 		return apiv2.ERROR
-	case provisioningState == "Deleting":
+	case provisioningState == "Canceled" && powerState == "Running":
+		// a canceled operation leaves the cluster in its previous, usable state
+		return apiv2.RUNNING
+	case provisioningState == "Deleting" || provisioningState == "Deleted":
 		return apiv2.DELETING
-	case provisioningState == "Upgrading":
+	case provisioningState == "Upgrading" || provisioningState == "Updating" || provisioningState == "Scaling" || provisioningState == "Migrating":
 		return apiv2.RECONCILING
 	default:
 		return apiv2.UNKNOWN
@@ -0,0 +1,250 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/latest/containerservice/mgmt/containerservice"
+	semverlib "github.com/Masterminds/semver/v3"
+
+	apiv2 "k8c.io/kubermatic/v2/pkg/api/v2"
+
+	"k8s.io/utils/pointer"
+)
+
+func upgradeProfile(versions ...*string) containerservice.ManagedClusterUpgradeProfile {
+	upgrades := []containerservice.ManagedClusterPoolUpgradeProfileUpgradesItem{}
+	for _, v := range versions {
+		upgrades = append(upgrades, containerservice.ManagedClusterPoolUpgradeProfileUpgradesItem{KubernetesVersion: v})
+	}
+
+	return containerservice.ManagedClusterUpgradeProfile{
+		ManagedClusterUpgradeProfileProperties: &containerservice.ManagedClusterUpgradeProfileProperties{
+			ControlPlaneProfile: &containerservice.ManagedClusterPoolUpgradeProfile{
+				KubernetesVersion: pointer.String("1.21.9"),
+				Upgrades:          &upgrades,
+			},
+		},
+	}
+}
+
+func TestAllowedUpgrades(t *testing.T) {
+	testCases := []struct {
+		name     string
+		profile  containerservice.ManagedClusterUpgradeProfile
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "no upgrade profile properties",
+			profile:  containerservice.ManagedClusterUpgradeProfile{},
+			expected: []string{},
+		},
+		{
+			name:     "no upgrades available",
+			profile:  upgradeProfile(),
+			expected: []string{},
+		},
+		{
+			name:     "upgrades without version are skipped",
+			profile:  upgradeProfile(pointer.String("1.22.6"), nil, pointer.String("1.22.11")),
+			expected: []string{"1.22.6", "1.22.11"},
+		},
+		{
+			name:    "invalid version",
+			profile: upgradeProfile(pointer.String("not-a-version")),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			versions, err := allowedUpgrades(tc.profile)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			result := []string{}
+			for _, v := range versions {
+				result = append(result, v.String())
+			}
+
+			if len(result) != len(tc.expected) {
+				t.Fatalf("Expected versions %v, got %v", tc.expected, result)
+			}
+			for i := range result {
+				if result[i] != tc.expected[i] {
+					t.Fatalf("Expected versions %v, got %v", tc.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestContainsVersion(t *testing.T) {
+	versions := []*semverlib.Version{
+		semverlib.MustParse("1.22.6"),
+		semverlib.MustParse("1.22.11"),
+	}
+
+	testCases := []struct {
+		name     string
+		versions []*semverlib.Version
+		version  string
+		expected bool
+	}{
+		{
+			name:     "version is allowed",
+			versions: versions,
+			version:  "1.22.11",
+			expected: true,
+		},
+		{
+			name:     "version with v prefix is allowed",
+			versions: versions,
+			version:  "v1.22.6",
+			expected: true,
+		},
+		{
+			name:     "version is not allowed",
+			versions: versions,
+			version:  "1.21.9",
+			expected: false,
+		},
+		{
+			name:     "no versions",
+			version:  "1.22.6",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := containsVersion(tc.versions, semverlib.MustParse(tc.version)); result != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
+
+func TestConvertAKSStatus(t *testing.T) {
+	testCases := []struct {
+		provisioningState string
+		powerState        containerservice.Code
+		expected          apiv2.ExternalClusterState
+	}{
+		{provisioningState: "Creating", expected: apiv2.PROVISIONING},
+		{provisioningState: "Succeeded", powerState: "Running", expected: apiv2.RUNNING},
+		{provisioningState: "Succeeded", powerState: "Stopped", expected: apiv2.STOPPED},
+		{provisioningState: "Stopping", powerState: "Running", expected: apiv2.STOPPING},
+		{provisioningState: "Failed", powerState: "Running", expected: apiv2.ERROR},
+		{provisioningState: "Canceled", powerState: "Running", expected: apiv2.RUNNING},
+		{provisioningState: "Canceled", powerState: "Stopped", expected: apiv2.UNKNOWN},
+		{provisioningState: "Canceled", expected: apiv2.UNKNOWN},
+		{provisioningState: "Deleting", powerState: "Running", expected: apiv2.DELETING},
+		{provisioningState: "Upgrading", powerState: "Running", expected: apiv2.RECONCILING},
+		{provisioningState: "Unexpected", powerState: "Running", expected: apiv2.UNKNOWN},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s/%s", tc.provisioningState, tc.powerState), func(t *testing.T) {
+			if state := convertAKSStatus(tc.provisioningState, tc.powerState); state != tc.expected {
+				t.Errorf("Expected state %q, got %q", tc.expected, state)
+			}
+		})
+	}
+}
+
+func TestConvertAKSClusterStatus(t *testing.T) {
+	cluster := func(provisioningState string, powerState containerservice.Code, agentPools ...containerservice.ManagedClusterAgentPoolProfile) *containerservice.ManagedCluster {
+		return &containerservice.ManagedCluster{
+			ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+				ProvisioningState: pointer.String(provisioningState),
+				PowerState:        &containerservice.PowerState{Code: powerState},
+				AgentPoolProfiles: &agentPools,
+			},
+		}
+	}
+
+	pool := func(name, provisioningState string) containerservice.ManagedClusterAgentPoolProfile {
+		return containerservice.ManagedClusterAgentPoolProfile{
+			Name:              pointer.String(name),
+			ProvisioningState: pointer.String(provisioningState),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		cluster  *containerservice.ManagedCluster
+		expected apiv2.ExternalClusterStatus
+	}{
+		{
+			name:     "no properties",
+			cluster:  &containerservice.ManagedCluster{},
+			expected: apiv2.ExternalClusterStatus{State: apiv2.UNKNOWN},
+		},
+		{
+			name:    "running",
+			cluster: cluster("Succeeded", "Running", pool("pool1", "Succeeded")),
+			expected: apiv2.ExternalClusterStatus{
+				State:         apiv2.RUNNING,
+				ProviderState: "Succeeded",
+			},
+		},
+		{
+			name:    "failed agent pool",
+			cluster: cluster("Failed", "Running", pool("pool1", "Succeeded"), pool("pool2", "Failed")),
+			expected: apiv2.ExternalClusterStatus{
+				State:         apiv2.ERROR,
+				ProviderState: "Failed",
+				StatusMessage: `the cluster provisioning state is "Failed", affected agent pools: pool2 (Failed)`,
+			},
+		},
+		{
+			name:    "canceled operation on a running cluster",
+			cluster: cluster("Canceled", "Running", pool("pool1", "Canceled")),
+			expected: apiv2.ExternalClusterStatus{
+				State:         apiv2.RUNNING,
+				ProviderState: "Canceled",
+				StatusMessage: "the last operation on the cluster was canceled, affected agent pools: pool1 (Canceled)",
+			},
+		},
+		{
+			name:    "canceled operation on a stopped cluster",
+			cluster: cluster("Canceled", "Stopped"),
+			expected: apiv2.ExternalClusterStatus{
+				State:         apiv2.UNKNOWN,
+				ProviderState: "Canceled",
+				StatusMessage: "the last operation on the cluster was canceled",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status := convertAKSClusterStatus(tc.cluster)
+			if *status != tc.expected {
+				t.Errorf("Expected status %+v, got %+v", tc.expected, *status)
+			}
+		})
+	}
+}
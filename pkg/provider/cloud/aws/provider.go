@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/service/ec2"
+
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
@@ -67,6 +69,7 @@ func NewCloudProvider(dc *kubermaticv1.Datacenter, secretKeyGetter provider.Secr
 }
 
 var _ provider.ReconcilingCloudProvider = &AmazonEC2{}
+var _ provider.CredentialValidator = &AmazonEC2{}
 
 func (a *AmazonEC2) getClientSet(cloud kubermaticv1.CloudSpec) (*ClientSet, error) {
 	if a.clientSet != nil {
@@ -120,6 +123,18 @@ func (a *AmazonEC2) ValidateCloudSpec(ctx context.Context, spec kubermaticv1.Clo
 	return nil
 }
 
+// ValidateCredentials checks that the credentials in the given CloudSpec can be used to
+// authenticate against AWS. No resources are created or modified.
+func (a *AmazonEC2) ValidateCredentials(ctx context.Context, spec kubermaticv1.CloudSpec) error {
+	client, err := a.getClientSet(spec)
+	if err != nil {
+		return fmt.Errorf("failed to get API client: %w", err)
+	}
+
+	_, err = client.EC2.DescribeRegionsWithContext(ctx, &ec2.DescribeRegionsInput{})
+	return err
+}
+
 // ValidateCloudSpecUpdate verifies whether an update of cloud spec is valid and permitted.
 func (a *AmazonEC2) ValidateCloudSpecUpdate(_ context.Context, oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error {
 	if oldSpec.AWS == nil || newSpec.AWS == nil {
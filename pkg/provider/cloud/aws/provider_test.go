@@ -138,6 +138,14 @@ func TestValidateCloudSpec(t *testing.T) {
 	}
 }
 
+func TestValidateCredentials(t *testing.T) {
+	provider := newCloudProvider(t)
+
+	if err := provider.ValidateCredentials(context.Background(), kubermaticv1.CloudSpec{AWS: &kubermaticv1.AWSCloudSpec{}}); err != nil {
+		t.Fatalf("ValidateCredentials should not have errored, but returned %v", err)
+	}
+}
+
 func TestInitializeCloudProvider(t *testing.T) {
 	provider := newCloudProvider(t)
 	cluster := makeCluster(&kubermaticv1.AWSCloudSpec{})
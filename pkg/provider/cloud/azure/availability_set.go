@@ -51,7 +51,7 @@ func reconcileAvailabilitySet(ctx context.Context, clients *ClientSet, location
 		})
 	}
 
-	target, err := targetAvailabilitySet(cluster.Spec.Cloud, location, cluster.Name)
+	target, err := targetAvailabilitySet(cluster.Spec.Cloud, location, mergeTags(availabilitySet.Tags, clusterTags(cluster)))
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +64,8 @@ func reconcileAvailabilitySet(ctx context.Context, clients *ClientSet, location
 	// - SKU name
 	// - fault domain count
 	// - update domain count
-	if !((availabilitySet.Sku != nil && availabilitySet.Sku.Name != nil && *availabilitySet.Sku.Name == *target.Sku.Name) && availabilitySet.AvailabilitySetProperties != nil &&
+	// - cluster tags
+	if !(hasTags(availabilitySet.Tags, target.Tags) && (availabilitySet.Sku != nil && availabilitySet.Sku.Name != nil && *availabilitySet.Sku.Name == *target.Sku.Name) && availabilitySet.AvailabilitySetProperties != nil &&
 		(availabilitySet.AvailabilitySetProperties.PlatformFaultDomainCount != nil && *availabilitySet.AvailabilitySetProperties.PlatformFaultDomainCount == *target.AvailabilitySetProperties.PlatformFaultDomainCount) &&
 		(availabilitySet.AvailabilitySetProperties.PlatformUpdateDomainCount != nil && *availabilitySet.AvailabilitySetProperties.PlatformUpdateDomainCount == *target.AvailabilitySetProperties.PlatformUpdateDomainCount)) {
 		if err := ensureAvailabilitySet(ctx, clients.AvailabilitySets, cluster.Spec.Cloud, target); err != nil {
@@ -78,7 +79,7 @@ func reconcileAvailabilitySet(ctx context.Context, clients *ClientSet, location
 	})
 }
 
-func targetAvailabilitySet(cloud kubermaticv1.CloudSpec, location string, clusterName string) (*compute.AvailabilitySet, error) {
+func targetAvailabilitySet(cloud kubermaticv1.CloudSpec, location string, tags map[string]*string) (*compute.AvailabilitySet, error) {
 	faultDomainCount, ok := faultDomainsPerRegion[location]
 	if !ok {
 		return nil, fmt.Errorf("could not determine the number of fault domains, unknown region %q", location)
@@ -90,9 +91,7 @@ func targetAvailabilitySet(cloud kubermaticv1.CloudSpec, location string, cluste
 		Sku: &compute.Sku{
 			Name: to.StringPtr("Aligned"),
 		},
-		Tags: map[string]*string{
-			clusterTagKey: to.StringPtr(clusterName),
-		},
+		Tags: tags,
 		AvailabilitySetProperties: &compute.AvailabilitySetProperties{
 			PlatformFaultDomainCount:  to.Int32Ptr(faultDomainCount),
 			PlatformUpdateDomainCount: to.Int32Ptr(20),
@@ -36,6 +36,7 @@ const (
 	resourceNamePrefix = "kubernetes-"
 
 	clusterTagKey = "cluster"
+	projectTagKey = "project-id"
 
 	// FinalizerSecurityGroup will instruct the deletion of the security group.
 	FinalizerSecurityGroup = "kubermatic.k8c.io/cleanup-azure-security-group"
@@ -73,7 +74,8 @@ func New(dc *kubermaticv1.Datacenter, secretKeyGetter provider.SecretKeySelector
 	}, nil
 }
 
-var _ provider.ReconcilingCloudProvider = &Azure{}
+var _ provider.HealingCloudProvider = &Azure{}
+var _ provider.CredentialValidator = &Azure{}
 
 // Azure API doesn't allow programmatically getting the number of available fault domains in a given region.
 // We must therefore hardcode these based on https://docs.microsoft.com/en-us/azure/virtual-machines/windows/manage-availability
@@ -232,6 +234,30 @@ func (a *Azure) ReconcileCluster(ctx context.Context, cluster *kubermaticv1.Clus
 	return a.reconcileCluster(ctx, cluster, update, true, true)
 }
 
+// HealCloudProvider re-asserts the ownership tag on the cluster's resource group and the
+// ICMP rules in its security group, without touching any other resources.
+func (a *Azure) HealCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, _ provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	credentials, err := GetCredentialsForCluster(cluster.Spec.Cloud, a.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+
+	clientSet, err := GetClientSet(cluster.Spec.Cloud, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := healResourceGroupTags(ctx, clientSet.Groups, cluster); err != nil {
+		return nil, err
+	}
+
+	if err := a.AddICMPRulesIfRequired(ctx, cluster); err != nil {
+		return nil, fmt.Errorf("failed to ensure ICMP rules: %w", err)
+	}
+
+	return cluster, nil
+}
+
 func (a *Azure) reconcileCluster(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater, force bool, setTags bool) (*kubermaticv1.Cluster, error) {
 	var err error
 	logger := a.log.With("cluster", cluster.Name)
@@ -363,7 +389,7 @@ func (a *Azure) ValidateCloudSpec(ctx context.Context, cloud kubermaticv1.CloudS
 			return err
 		}
 
-		if _, err = routeTablesClient.Get(ctx, cloud.Azure.ResourceGroup, cloud.Azure.RouteTableName, ""); err != nil {
+		if _, err = routeTablesClient.Get(ctx, routeTableResourceGroup(cloud), cloud.Azure.RouteTableName, ""); err != nil {
 			return err
 		}
 	}
@@ -407,49 +433,34 @@ func (a *Azure) AddICMPRulesIfRequired(ctx context.Context, cluster *kubermaticv
 		return nil
 	}
 
-	var hasDenyAllTCPRule, hasDenyAllUDPRule, hasICMPAllowAllRule bool
+	var existingRules []network.SecurityRule
 	if sg.SecurityRules != nil {
-		for _, rule := range *sg.SecurityRules {
-			if rule.Name == nil {
-				continue
-			}
-			// We trust that no one will alter the content of the rules
-			switch *rule.Name {
-			case denyAllTCPSecGroupRuleName:
-				hasDenyAllTCPRule = true
-			case denyAllUDPSecGroupRuleName:
-				hasDenyAllUDPRule = true
-			case allowAllICMPSecGroupRuleName:
-				hasICMPAllowAllRule = true
-			}
-		}
+		existingRules = *sg.SecurityRules
 	}
 
-	var newSecurityRules []network.SecurityRule
-	if !hasDenyAllTCPRule {
-		a.log.With("cluster", cluster.Name).Info("Creating TCP deny all rule")
-		newSecurityRules = append(newSecurityRules, tcpDenyAllRule())
-	}
-	if !hasDenyAllUDPRule {
-		a.log.With("cluster", cluster.Name).Info("Creating UDP deny all rule")
-		newSecurityRules = append(newSecurityRules, udpDenyAllRule())
-	}
-	if !hasICMPAllowAllRule {
-		a.log.With("cluster", cluster.Name).Info("Creating ICMP allow all rule")
-		newSecurityRules = append(newSecurityRules, icmpAllowAllRule())
-	}
-
-	if len(newSecurityRules) > 0 {
-		newSecurityGroupRules := append(*sg.SecurityRules, newSecurityRules...)
-		sg.SecurityRules = &newSecurityGroupRules
+	rules, changed := reconcileICMPSecurityRules(existingRules)
+	if changed {
+		a.log.With("cluster", cluster.Name).Info("Reconciling ICMP security rules")
+		sg.SecurityRules = &rules
 		_, err := sgClient.CreateOrUpdate(ctx, azure.ResourceGroup, azure.SecurityGroup, sg)
 		if err != nil {
-			return fmt.Errorf("failed to add new rules to security group %q: %w", *sg.Name, err)
+			return fmt.Errorf("failed to update rules of security group %q: %w", *sg.Name, err)
 		}
 	}
 	return nil
 }
 
+// ValidateCredentials checks that the credentials in the given CloudSpec can be used to
+// authenticate against Azure. No resources are created or modified.
+func (a *Azure) ValidateCredentials(ctx context.Context, cloud kubermaticv1.CloudSpec) error {
+	credentials, err := GetCredentialsForCluster(cloud, a.secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	return ValidateCredentials(ctx, credentials)
+}
+
 // ValidateCloudSpecUpdate verifies whether an update of cloud spec is valid and permitted.
 func (a *Azure) ValidateCloudSpecUpdate(_ context.Context, oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error {
 	if oldSpec.Azure == nil || newSpec.Azure == nil {
@@ -468,6 +479,10 @@ func (a *Azure) ValidateCloudSpecUpdate(_ context.Context, oldSpec kubermaticv1.
 		return fmt.Errorf("updating Azure vnet resource group is not supported (was %s, updated to %s)", oldSpec.Azure.VNetResourceGroup, newSpec.Azure.VNetResourceGroup)
 	}
 
+	if oldSpec.Azure.RouteTableResourceGroup != "" && oldSpec.Azure.RouteTableResourceGroup != newSpec.Azure.RouteTableResourceGroup {
+		return fmt.Errorf("updating Azure route table resource group is not supported (was %s, updated to %s)", oldSpec.Azure.RouteTableResourceGroup, newSpec.Azure.RouteTableResourceGroup)
+	}
+
 	if oldSpec.Azure.VNetName != "" && oldSpec.Azure.VNetName != newSpec.Azure.VNetName {
 		return fmt.Errorf("updating Azure vnet name is not supported (was %s, updated to %s)", oldSpec.Azure.VNetName, newSpec.Azure.VNetName)
 	}
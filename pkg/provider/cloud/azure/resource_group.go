@@ -60,7 +60,7 @@ func reconcileResourceGroup(ctx context.Context, clients *ClientSet, location st
 		})
 	}
 
-	if err = ensureResourceGroup(ctx, clients.Groups, cluster.Spec.Cloud, location, cluster.Name); err != nil {
+	if err = ensureResourceGroup(ctx, clients.Groups, cluster.Spec.Cloud, location, clusterTags(cluster)); err != nil {
 		return nil, err
 	}
 
@@ -71,13 +71,11 @@ func reconcileResourceGroup(ctx context.Context, clients *ClientSet, location st
 }
 
 // ensureResourceGroup will create or update an Azure resource group. The call is idempotent.
-func ensureResourceGroup(ctx context.Context, groupsClient resourcesapi.GroupsClientAPI, cloud kubermaticv1.CloudSpec, location string, clusterName string) error {
+func ensureResourceGroup(ctx context.Context, groupsClient resourcesapi.GroupsClientAPI, cloud kubermaticv1.CloudSpec, location string, tags map[string]*string) error {
 	parameters := resources.Group{
 		Name:     to.StringPtr(cloud.Azure.ResourceGroup),
 		Location: to.StringPtr(location),
-		Tags: map[string]*string{
-			clusterTagKey: to.StringPtr(clusterName),
-		},
+		Tags:     tags,
 	}
 	if _, err := groupsClient.CreateOrUpdate(ctx, cloud.Azure.ResourceGroup, parameters); err != nil {
 		return fmt.Errorf("failed to create or update resource group %q: %w", cloud.Azure.ResourceGroup, err)
@@ -86,6 +84,42 @@ func ensureResourceGroup(ctx context.Context, groupsClient resourcesapi.GroupsCl
 	return nil
 }
 
+// healResourceGroupTags re-adds the cluster tags to the cluster's resource group if they got lost.
+// This is only done for resource groups that were created by us (indicated by the finalizer), while
+// all other tags on the resource group are retained.
+func healResourceGroupTags(ctx context.Context, groupsClient resourcesapi.GroupsClientAPI, cluster *kubermaticv1.Cluster) error {
+	name := cluster.Spec.Cloud.Azure.ResourceGroup
+	if name == "" || !kuberneteshelper.HasFinalizer(cluster, FinalizerResourceGroup) {
+		return nil
+	}
+
+	resourceGroup, err := groupsClient.Get(ctx, name)
+	if err != nil {
+		// a missing resource group is recreated by the regular reconciliation
+		if isNotFound(resourceGroup.Response) {
+			return nil
+		}
+		return fmt.Errorf("failed to get resource group %q: %w", name, err)
+	}
+
+	tags := clusterTags(cluster)
+	if hasTags(resourceGroup.Tags, tags) {
+		return nil
+	}
+
+	parameters := resources.Group{
+		Name:      resourceGroup.Name,
+		Location:  resourceGroup.Location,
+		ManagedBy: resourceGroup.ManagedBy,
+		Tags:      mergeTags(resourceGroup.Tags, tags),
+	}
+	if _, err := groupsClient.CreateOrUpdate(ctx, name, parameters); err != nil {
+		return fmt.Errorf("failed to update tags of resource group %q: %w", name, err)
+	}
+
+	return nil
+}
+
 func deleteResourceGroup(ctx context.Context, clients *ClientSet, cloud kubermaticv1.CloudSpec) error {
 	// We first check existence of the resource group to see if its already gone or not.
 	// We could also directly call delete but the error response would need to be unpacked twice to get the correct error message.
@@ -181,6 +181,89 @@ func TestReconcileResourceGroup(t *testing.T) {
 	}
 }
 
+func TestHealResourceGroupTags(t *testing.T) {
+	credentials, err := getFakeCredentials()
+	if err != nil {
+		t.Fatalf("failed to generate credentials: %v", err)
+	}
+
+	testcases := []struct {
+		name                    string
+		ownedByCluster          bool
+		projectID               string
+		existingTags            map[string]*string
+		expectedCreateCallCount int
+		expectedTags            map[string]*string
+	}{
+		{
+			name:                    "tag-intact",
+			ownedByCluster:          true,
+			existingTags:            map[string]*string{clusterTagKey: to.StringPtr("heal")},
+			expectedCreateCallCount: 0,
+			expectedTags:            map[string]*string{clusterTagKey: to.StringPtr("heal")},
+		},
+		{
+			name:                    "tag-missing",
+			ownedByCluster:          true,
+			existingTags:            map[string]*string{"team": to.StringPtr("a")},
+			expectedCreateCallCount: 1,
+			expectedTags:            map[string]*string{clusterTagKey: to.StringPtr("heal"), "team": to.StringPtr("a")},
+		},
+		{
+			name:                    "project-tag-missing",
+			ownedByCluster:          true,
+			projectID:               "my-project",
+			existingTags:            map[string]*string{clusterTagKey: to.StringPtr("heal"), "team": to.StringPtr("a")},
+			expectedCreateCallCount: 1,
+			expectedTags:            map[string]*string{clusterTagKey: to.StringPtr("heal"), projectTagKey: to.StringPtr("my-project"), "team": to.StringPtr("a")},
+		},
+		{
+			name:                    "not-owned",
+			ownedByCluster:          false,
+			existingTags:            map[string]*string{},
+			expectedCreateCallCount: 0,
+			expectedTags:            map[string]*string{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := makeCluster("heal", &kubermaticv1.AzureCloudSpec{ResourceGroup: "kubernetes-heal"}, credentials)
+			if tc.ownedByCluster {
+				cluster.Finalizers = []string{FinalizerResourceGroup}
+			}
+			if tc.projectID != "" {
+				cluster.Labels = map[string]string{kubermaticv1.ProjectIDLabelKey: tc.projectID}
+			}
+
+			existingGroup := &resources.Group{
+				Name:     to.StringPtr("kubernetes-heal"),
+				Location: to.StringPtr(testLocation),
+				Tags:     tc.existingTags,
+			}
+			clientSet := getFakeClientSetWithGroupsClient(*credentials, testLocation, cluster, existingGroup, fakeClientModeOkay)
+			fakeClient := clientSet.Groups.(*fakeGroupsClient)
+
+			if err := healResourceGroupTags(context.Background(), clientSet.Groups, cluster); err != nil {
+				t.Fatalf("failed to heal resource group tags: %v", err)
+			}
+
+			if fakeClient.CreateOrUpdateCalledCount != tc.expectedCreateCallCount {
+				t.Fatalf("expected %d, got %d calls to CreateOrUpdate", tc.expectedCreateCallCount, fakeClient.CreateOrUpdateCalledCount)
+			}
+
+			if len(fakeClient.Group.Tags) != len(tc.expectedTags) {
+				t.Fatalf("expected tags %v, got %v", tc.expectedTags, fakeClient.Group.Tags)
+			}
+			for key, value := range tc.expectedTags {
+				if got, ok := fakeClient.Group.Tags[key]; !ok || *got != *value {
+					t.Errorf("expected tag %q to be %q", key, *value)
+				}
+			}
+		})
+	}
+}
+
 const customExistingResourceGroup = "custom-existing-resource-group"
 
 type fakeGroupsClient struct {
@@ -32,6 +32,16 @@ func routeTableName(cluster *kubermaticv1.Cluster) string {
 	return resourceNamePrefix + cluster.Name
 }
 
+// routeTableResourceGroup returns the resource group the route table lives in, which is
+// the cluster's resource group unless a separate one has been configured.
+func routeTableResourceGroup(cloud kubermaticv1.CloudSpec) string {
+	if cloud.Azure.RouteTableResourceGroup != "" {
+		return cloud.Azure.RouteTableResourceGroup
+	}
+
+	return cloud.Azure.ResourceGroup
+}
+
 func reconcileRouteTable(ctx context.Context, clients *ClientSet, location string, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	name := cluster.Spec.Cloud.Azure.RouteTableName
 
@@ -39,7 +49,7 @@ func reconcileRouteTable(ctx context.Context, clients *ClientSet, location strin
 		cluster.Spec.Cloud.Azure.RouteTableName = routeTableName(cluster)
 	}
 
-	routeTable, err := clients.RouteTables.Get(ctx, cluster.Spec.Cloud.Azure.ResourceGroup, cluster.Spec.Cloud.Azure.RouteTableName, "")
+	routeTable, err := clients.RouteTables.Get(ctx, routeTableResourceGroup(cluster.Spec.Cloud), cluster.Spec.Cloud.Azure.RouteTableName, "")
 	if err != nil && !isNotFound(routeTable.Response) {
 		return nil, err
 	}
@@ -91,7 +101,7 @@ func ensureRouteTable(ctx context.Context, clients *ClientSet, cloud kubermaticv
 		return fmt.Errorf("invalid network.RouteTable passed")
 	}
 
-	future, err := clients.RouteTables.CreateOrUpdate(ctx, cloud.Azure.ResourceGroup, cloud.Azure.RouteTableName, *rt)
+	future, err := clients.RouteTables.CreateOrUpdate(ctx, routeTableResourceGroup(cloud), cloud.Azure.RouteTableName, *rt)
 	if err != nil {
 		return fmt.Errorf("failed to create or update route table %q: %w", cloud.Azure.RouteTableName, err)
 	}
@@ -106,7 +116,7 @@ func ensureRouteTable(ctx context.Context, clients *ClientSet, cloud kubermaticv
 func deleteRouteTable(ctx context.Context, clients *ClientSet, cloud kubermaticv1.CloudSpec) error {
 	// We first do Get to check existence of the route table to see if its already gone or not.
 	// We could also directly call delete but the error response would need to be unpacked twice to get the correct error message.
-	res, err := clients.RouteTables.Get(ctx, cloud.Azure.ResourceGroup, cloud.Azure.RouteTableName, "")
+	res, err := clients.RouteTables.Get(ctx, routeTableResourceGroup(cloud), cloud.Azure.RouteTableName, "")
 	if err != nil {
 		if isNotFound(res.Response) {
 			return nil
@@ -114,7 +124,7 @@ func deleteRouteTable(ctx context.Context, clients *ClientSet, cloud kubermaticv
 		return err
 	}
 
-	future, err := clients.RouteTables.Delete(ctx, cloud.Azure.ResourceGroup, cloud.Azure.RouteTableName)
+	future, err := clients.RouteTables.Delete(ctx, routeTableResourceGroup(cloud), cloud.Azure.RouteTableName)
 	if err != nil {
 		return err
 	}
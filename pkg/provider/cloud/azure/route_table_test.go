@@ -0,0 +1,59 @@
+//go:build integration
+
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+func TestRouteTableResourceGroup(t *testing.T) {
+	testCases := []struct {
+		name     string
+		spec     *kubermaticv1.AzureCloudSpec
+		expected string
+	}{
+		{
+			name: "defaults to cluster resource group",
+			spec: &kubermaticv1.AzureCloudSpec{
+				ResourceGroup:     "cluster-rg",
+				VNetResourceGroup: "vnet-rg",
+			},
+			expected: "cluster-rg",
+		},
+		{
+			name: "uses dedicated route table resource group",
+			spec: &kubermaticv1.AzureCloudSpec{
+				ResourceGroup:           "cluster-rg",
+				RouteTableResourceGroup: "route-table-rg",
+			},
+			expected: "route-table-rg",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rg := routeTableResourceGroup(kubermaticv1.CloudSpec{Azure: tc.spec})
+			if rg != tc.expected {
+				t.Errorf("Expected resource group %q, got %q", tc.expected, rg)
+			}
+		})
+	}
+}
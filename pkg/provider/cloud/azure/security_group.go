@@ -58,7 +58,7 @@ func reconcileSecurityGroup(ctx context.Context, clients *ClientSet, location st
 		NodePorts()
 	nodePortsAllowedIPRanges := kubermaticresources.GetNodePortsAllowedIPRanges(cluster, cluster.Spec.Cloud.Azure.NodePortsAllowedIPRanges, cluster.Spec.Cloud.Azure.NodePortsAllowedIPRange)
 
-	target := targetSecurityGroup(cluster.Spec.Cloud, location, cluster.Name, lowPort, highPort, nodePortsAllowedIPRanges.GetIPv4CIDRs(), nodePortsAllowedIPRanges.GetIPv6CIDRs())
+	target := targetSecurityGroup(cluster.Spec.Cloud, location, mergeTags(securityGroup.Tags, clusterTags(cluster)), lowPort, highPort, nodePortsAllowedIPRanges.GetIPv4CIDRs(), nodePortsAllowedIPRanges.GetIPv6CIDRs())
 
 	// check for attributes of the existing security group and return early if all values are already
 	// as expected. Since there are a lot of pointers in the network.SecurityGroup struct, we need to
@@ -66,8 +66,10 @@ func reconcileSecurityGroup(ctx context.Context, clients *ClientSet, location st
 	//
 	// Attributes we check:
 	// - defined security rules
+	// - cluster tags
 	if !(securityGroup.SecurityGroupPropertiesFormat != nil && securityGroup.SecurityGroupPropertiesFormat.SecurityRules != nil &&
-		compareSecurityRules(*securityGroup.SecurityGroupPropertiesFormat.SecurityRules, *target.SecurityGroupPropertiesFormat.SecurityRules)) {
+		compareSecurityRules(*securityGroup.SecurityGroupPropertiesFormat.SecurityRules, *target.SecurityGroupPropertiesFormat.SecurityRules) &&
+		hasTags(securityGroup.Tags, target.Tags)) {
 		if err := ensureSecurityGroup(ctx, clients, cluster.Spec.Cloud, target); err != nil {
 			return cluster, err
 		}
@@ -79,14 +81,12 @@ func reconcileSecurityGroup(ctx context.Context, clients *ClientSet, location st
 	})
 }
 
-func targetSecurityGroup(cloud kubermaticv1.CloudSpec, location string, clusterName string, portRangeLow int, portRangeHigh int,
+func targetSecurityGroup(cloud kubermaticv1.CloudSpec, location string, tags map[string]*string, portRangeLow int, portRangeHigh int,
 	nodePortsIPv4CIDRs []string, nodePortsIPv6CIDRs []string) *network.SecurityGroup {
 	securityGroup := &network.SecurityGroup{
 		Name:     to.StringPtr(cloud.Azure.SecurityGroup),
 		Location: to.StringPtr(location),
-		Tags: map[string]*string{
-			clusterTagKey: to.StringPtr(clusterName),
-		},
+		Tags:     tags,
 		SecurityGroupPropertiesFormat: &network.SecurityGroupPropertiesFormat{
 			Subnets: &[]network.Subnet{
 				{
@@ -284,6 +284,53 @@ func icmpAllowAllRule() network.SecurityRule {
 	}
 }
 
+// icmpSecurityRules returns the rules KKP manages to allow ICMP traffic, see icmpAllowAllRule.
+func icmpSecurityRules() []network.SecurityRule {
+	return []network.SecurityRule{tcpDenyAllRule(), udpDenyAllRule(), icmpAllowAllRule()}
+}
+
+// isICMPSecurityRule returns true if the rule is one of the KKP-managed ICMP rules.
+func isICMPSecurityRule(rule network.SecurityRule) bool {
+	if rule.Name == nil {
+		return false
+	}
+
+	switch *rule.Name {
+	case denyAllTCPSecGroupRuleName, denyAllUDPSecGroupRuleName, allowAllICMPSecGroupRuleName:
+		return true
+	}
+
+	return false
+}
+
+// reconcileICMPSecurityRules returns the given rules with all KKP-managed ICMP rules replaced by
+// their desired definition. All other rules are left untouched. The second return value is true
+// if the managed rules differed from the desired ones.
+func reconcileICMPSecurityRules(existing []network.SecurityRule) ([]network.SecurityRule, bool) {
+	desired := icmpSecurityRules()
+
+	var rules []network.SecurityRule
+	existingManaged := map[string]network.SecurityRule{}
+
+	for _, rule := range existing {
+		if isICMPSecurityRule(rule) {
+			existingManaged[*rule.Name] = rule
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	changed := len(existingManaged) != len(desired)
+	for _, rule := range desired {
+		current, ok := existingManaged[*rule.Name]
+		if !ok || current.SecurityRulePropertiesFormat == nil || !compareSecurityRules([]network.SecurityRule{current}, []network.SecurityRule{rule}) {
+			changed = true
+		}
+	}
+
+	return append(rules, desired...), changed
+}
+
 func compareSecurityRules(a []network.SecurityRule, b []network.SecurityRule) bool {
 	if len(a) != len(b) {
 		return false
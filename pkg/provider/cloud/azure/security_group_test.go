@@ -0,0 +1,103 @@
+//go:build integration
+
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-05-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+func TestReconcileICMPSecurityRules(t *testing.T) {
+	userRule := network.SecurityRule{
+		Name: to.StringPtr("user_rule"),
+		SecurityRulePropertiesFormat: &network.SecurityRulePropertiesFormat{
+			Direction:                network.SecurityRuleDirectionInbound,
+			Protocol:                 network.SecurityRuleProtocolTCP,
+			SourceAddressPrefix:      to.StringPtr("*"),
+			SourcePortRange:          to.StringPtr("*"),
+			DestinationAddressPrefix: to.StringPtr("*"),
+			DestinationPortRange:     to.StringPtr("443"),
+			Access:                   network.SecurityRuleAccessAllow,
+			Priority:                 to.Int32Ptr(150),
+		},
+	}
+
+	outdatedTCPRule := tcpDenyAllRule()
+	outdatedTCPRule.Priority = to.Int32Ptr(700)
+
+	testCases := []struct {
+		name        string
+		existing    []network.SecurityRule
+		wantChanged bool
+	}{
+		{
+			name:        "no rules exist",
+			wantChanged: true,
+		},
+		{
+			name:        "all rules up to date",
+			existing:    append([]network.SecurityRule{userRule}, icmpSecurityRules()...),
+			wantChanged: false,
+		},
+		{
+			name:        "rule missing",
+			existing:    []network.SecurityRule{userRule, tcpDenyAllRule(), icmpAllowAllRule()},
+			wantChanged: true,
+		},
+		{
+			name:        "outdated rule",
+			existing:    []network.SecurityRule{userRule, outdatedTCPRule, udpDenyAllRule(), icmpAllowAllRule()},
+			wantChanged: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules, changed := reconcileICMPSecurityRules(tc.existing)
+			if changed != tc.wantChanged {
+				t.Errorf("Expected changed to be %v, got %v", tc.wantChanged, changed)
+			}
+
+			counts := map[string]int{}
+			for _, rule := range rules {
+				counts[*rule.Name]++
+			}
+
+			for _, want := range icmpSecurityRules() {
+				if counts[*want.Name] != 1 {
+					t.Errorf("Expected rule %q exactly once, got %d times", *want.Name, counts[*want.Name])
+				}
+			}
+
+			for _, rule := range rules {
+				if *rule.Name == denyAllTCPSecGroupRuleName && *rule.Priority != *tcpDenyAllRule().Priority {
+					t.Errorf("Expected outdated rule %q to be updated to priority %d, got %d", *rule.Name, *tcpDenyAllRule().Priority, *rule.Priority)
+				}
+			}
+
+			for _, existing := range tc.existing {
+				if !isICMPSecurityRule(existing) && counts[*existing.Name] != 1 {
+					t.Errorf("Expected user rule %q to be kept", *existing.Name)
+				}
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"net/http"
 
 	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 )
@@ -41,3 +42,44 @@ func hasOwnershipTag(tags map[string]*string, cluster *kubermaticv1.Cluster) boo
 
 	return false
 }
+
+// clusterTags returns the tags for all resources created for the given cluster. Next
+// to the ownership tag, resources are tagged with the cluster's project to allow cost
+// attribution per project.
+func clusterTags(cluster *kubermaticv1.Cluster) map[string]*string {
+	tags := map[string]*string{
+		clusterTagKey: to.StringPtr(cluster.Name),
+	}
+
+	if projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]; projectID != "" {
+		tags[projectTagKey] = to.StringPtr(projectID)
+	}
+
+	return tags
+}
+
+// hasTags returns true if all expected tags are set to the expected values.
+func hasTags(tags map[string]*string, expected map[string]*string) bool {
+	for key, value := range expected {
+		existing, ok := tags[key]
+		if !ok || existing == nil || *existing != *value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mergeTags returns the expected tags on top of the existing ones, so that tags
+// added by users are retained when a resource is updated.
+func mergeTags(tags map[string]*string, expected map[string]*string) map[string]*string {
+	merged := map[string]*string{}
+	for key, value := range tags {
+		merged[key] = value
+	}
+	for key, value := range expected {
+		merged[key] = value
+	}
+
+	return merged
+}
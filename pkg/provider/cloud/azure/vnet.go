@@ -68,7 +68,7 @@ func reconcileVNet(ctx context.Context, clients *ClientSet, location string, clu
 	if cluster.IsIPv6Only() || cluster.IsDualStack() {
 		cidrs = append(cidrs, defaultVNetCIDRIPv6)
 	}
-	target := targetVnet(cluster.Spec.Cloud, location, cluster.Name, cidrs)
+	target := targetVnet(cluster.Spec.Cloud, location, mergeTags(vnet.Tags, clusterTags(cluster)), cidrs)
 
 	// check for attributes of the existing VNET and return early if all values are already
 	// as expected. Since there are a lot of pointers in the network.VirtualNetwork struct, we need to
@@ -76,8 +76,10 @@ func reconcileVNet(ctx context.Context, clients *ClientSet, location string, clu
 	//
 	// Attributes we check:
 	// - Address space CIDR
+	// - cluster tags
 	if !(vnet.VirtualNetworkPropertiesFormat != nil && vnet.VirtualNetworkPropertiesFormat.AddressSpace != nil &&
-		reflect.DeepEqual(vnet.VirtualNetworkPropertiesFormat.AddressSpace.AddressPrefixes, target.VirtualNetworkPropertiesFormat.AddressSpace.AddressPrefixes)) {
+		reflect.DeepEqual(vnet.VirtualNetworkPropertiesFormat.AddressSpace.AddressPrefixes, target.VirtualNetworkPropertiesFormat.AddressSpace.AddressPrefixes) &&
+		hasTags(vnet.Tags, target.Tags)) {
 		if err := ensureVNet(ctx, clients, cluster.Spec.Cloud, target); err != nil {
 			return nil, err
 		}
@@ -89,13 +91,11 @@ func reconcileVNet(ctx context.Context, clients *ClientSet, location string, clu
 	})
 }
 
-func targetVnet(cloud kubermaticv1.CloudSpec, location string, clusterName string, cidrs []string) *network.VirtualNetwork {
+func targetVnet(cloud kubermaticv1.CloudSpec, location string, tags map[string]*string, cidrs []string) *network.VirtualNetwork {
 	return &network.VirtualNetwork{
 		Name:     to.StringPtr(cloud.Azure.VNetName),
 		Location: to.StringPtr(location),
-		Tags: map[string]*string{
-			clusterTagKey: to.StringPtr(clusterName),
-		},
+		Tags:     tags,
 		VirtualNetworkPropertiesFormat: &network.VirtualNetworkPropertiesFormat{
 			AddressSpace: &network.AddressSpace{AddressPrefixes: &cidrs},
 		},
@@ -63,6 +63,7 @@ func NewCloudProvider(secretKeyGetter provider.SecretKeySelectorValueFunc) provi
 }
 
 var _ provider.ReconcilingCloudProvider = &gcp{}
+var _ provider.CredentialValidator = &gcp{}
 
 // InitializeCloudProvider initializes a cluster.
 func (g *gcp) InitializeCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
@@ -128,6 +129,17 @@ func (g *gcp) ValidateCloudSpec(ctx context.Context, spec kubermaticv1.CloudSpec
 	return nil
 }
 
+// ValidateCredentials checks that the service account in the given CloudSpec can be used to
+// authenticate against GCP. No resources are created or modified.
+func (g *gcp) ValidateCredentials(ctx context.Context, spec kubermaticv1.CloudSpec) error {
+	sa, err := GetCredentialsForCluster(spec, g.secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	return ValidateCredentials(ctx, sa)
+}
+
 // CleanUpCloudProvider removes firewall rules and related finalizer.
 func (g *gcp) CleanUpCloudProvider(ctx context.Context, cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	serviceAccount, err := GetCredentialsForCluster(cluster.Spec.Cloud, g.secretKeySelector)
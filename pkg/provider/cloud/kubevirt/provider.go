@@ -43,12 +43,18 @@ const (
 
 type kubevirt struct {
 	secretKeySelector provider.SecretKeySelectorValueFunc
+	dc                *kubermaticv1.DatacenterSpecKubevirt
 }
 
-func NewCloudProvider(secretKeyGetter provider.SecretKeySelectorValueFunc) provider.CloudProvider {
+func NewCloudProvider(dc *kubermaticv1.Datacenter, secretKeyGetter provider.SecretKeySelectorValueFunc) (provider.CloudProvider, error) {
+	if dc.Spec.Kubevirt == nil {
+		return nil, errors.New("datacenter is not a KubeVirt datacenter")
+	}
+
 	return &kubevirt{
 		secretKeySelector: secretKeyGetter,
-	}
+		dc:                dc.Spec.Kubevirt,
+	}, nil
 }
 
 var _ provider.ReconcilingCloudProvider = &kubevirt{}
@@ -70,13 +76,24 @@ func (k *kubevirt) ValidateCloudSpec(ctx context.Context, spec kubermaticv1.Clou
 		config = []byte(kubeconfig)
 	}
 
-	_, err = clientcmd.RESTConfigFromKubeConfig(config)
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(config)
 	if err != nil {
 		return err
 	}
 
 	spec.Kubevirt.Kubeconfig = string(config)
 
+	if len(spec.Kubevirt.PreAllocatedDataVolumes) > 0 {
+		client, err := ctrlruntimeclient.New(restConfig, ctrlruntimeclient.Options{})
+		if err != nil {
+			return fmt.Errorf("failed to create client for the infra cluster: %w", err)
+		}
+
+		if err := validatePreAllocatedDataVolumes(ctx, client, spec.Kubevirt.PreAllocatedDataVolumes); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -100,7 +117,7 @@ func (k *kubevirt) reconcileCluster(ctx context.Context, cluster *kubermaticv1.C
 		return cluster, err
 	}
 
-	err = reconcileCSIRoleRoleBinding(ctx, cluster.Status.NamespaceName, client, restConfig)
+	err = reconcileCSIRoleRoleBinding(ctx, cluster.Status.NamespaceName, client, restConfig, k.dc.DisableVolumeDeletion)
 	if err != nil {
 		return cluster, err
 	}
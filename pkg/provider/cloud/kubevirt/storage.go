@@ -27,13 +27,20 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	restclient "k8s.io/client-go/rest"
 	utilpointer "k8s.io/utils/pointer"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// defaultStorageClassAnnotation marks the default storage class of a cluster.
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
 var (
 	csiServiceAccountNamespace = metav1.NamespaceDefault
 	csiResourceName            = "kubevirt-csi"
@@ -47,14 +54,21 @@ func csiServiceAccountCreator(name string) reconciling.NamedServiceAccountCreato
 	}
 }
 
-func csiRoleCreator(name string) reconciling.NamedRoleCreatorGetter {
+// csiRoleCreator creates the Role for the CSI driver. If disableVolumeDeletion is set, the driver
+// is not allowed to delete DataVolumes.
+func csiRoleCreator(name string, disableVolumeDeletion bool) reconciling.NamedRoleCreatorGetter {
 	return func() (string, reconciling.RoleCreator) {
 		return name, func(r *rbacv1.Role) (*rbacv1.Role, error) {
+			dataVolumeVerbs := []string{"get", "create", "delete"}
+			if disableVolumeDeletion {
+				dataVolumeVerbs = []string{"get", "create"}
+			}
+
 			r.Rules = []rbacv1.PolicyRule{
 				{
 					APIGroups: []string{"cdi.kubevirt.io"},
 					Resources: []string{"datavolumes"},
-					Verbs:     []string{"get", "create", "delete"},
+					Verbs:     dataVolumeVerbs,
 				},
 				{
 					APIGroups: []string{"kubevirt.io"},
@@ -96,9 +110,10 @@ func csiRoleBindingCreator(name, namespace string) reconciling.NamedRoleBindingC
 }
 
 // reconcileCSIRoleRoleBinding reconciles the Role and Rolebindings needed by CSI driver.
-func reconcileCSIRoleRoleBinding(ctx context.Context, namespace string, client ctrlruntimeclient.Client, restConfig *restclient.Config) error {
+// The Role rules are always overwritten, so changing disableVolumeDeletion converges existing clusters.
+func reconcileCSIRoleRoleBinding(ctx context.Context, namespace string, client ctrlruntimeclient.Client, restConfig *restclient.Config, disableVolumeDeletion bool) error {
 	roleCreators := []reconciling.NamedRoleCreatorGetter{
-		csiRoleCreator(csiResourceName),
+		csiRoleCreator(csiResourceName, disableVolumeDeletion),
 	}
 	if err := reconciling.ReconcileRoles(ctx, roleCreators, namespace, client); err != nil {
 		return err
@@ -125,6 +140,12 @@ func dataVolumeCreator(datavolume *cdiv1beta1.DataVolume) reconciling.NamedCDIv1
 
 func reconcilePreAllocatedDataVolumes(ctx context.Context, cluster *kubermaticv1.Cluster, client ctrlruntimeclient.Client) error {
 	for _, d := range cluster.Spec.Cloud.Kubevirt.PreAllocatedDataVolumes {
+		storageClass, err := storageClassName(ctx, client, d.StorageClass)
+		if err != nil {
+			return fmt.Errorf("invalid storage class for DataVolume %s: %w", d.Name, err)
+		}
+		d.StorageClass = storageClass
+
 		dv, err := createPreAllocatedDataVolume(d, cluster.Status.NamespaceName)
 		if err != nil {
 			return err
@@ -144,17 +165,26 @@ func createPreAllocatedDataVolume(dv kubermaticv1.PreAllocatedDataVolume, namesp
 	if err != nil {
 		return nil, err
 	}
+
+	source := &cdiv1beta1.DataVolumeSource{}
+	if dv.SourcePVC != nil {
+		source.PVC = &cdiv1beta1.DataVolumeSourcePVC{
+			Namespace: dv.SourcePVC.Namespace,
+			Name:      dv.SourcePVC.Name,
+		}
+	} else {
+		source.HTTP = &cdiv1beta1.DataVolumeSourceHTTP{
+			URL: dv.URL,
+		}
+	}
+
 	return &cdiv1beta1.DataVolume{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      dv.Name,
 			Namespace: namespace,
 		},
 		Spec: cdiv1beta1.DataVolumeSpec{
-			Source: &cdiv1beta1.DataVolumeSource{
-				HTTP: &cdiv1beta1.DataVolumeSourceHTTP{
-					URL: dv.URL,
-				},
-			},
+			Source: source,
 			PVC: &corev1.PersistentVolumeClaimSpec{
 				StorageClassName: utilpointer.StringPtr(dv.StorageClass),
 				AccessModes: []corev1.PersistentVolumeAccessMode{
@@ -167,3 +197,42 @@ func createPreAllocatedDataVolume(dv kubermaticv1.PreAllocatedDataVolume, namesp
 		},
 	}, nil
 }
+
+// validatePreAllocatedDataVolumes checks that the storage classes of all pre-allocated DataVolumes
+// exist in the KubeVirt infra cluster, as DataVolumes with an unknown storage class stay pending forever.
+func validatePreAllocatedDataVolumes(ctx context.Context, client ctrlruntimeclient.Client, dataVolumes []kubermaticv1.PreAllocatedDataVolume) error {
+	for _, dv := range dataVolumes {
+		if _, err := storageClassName(ctx, client, dv.StorageClass); err != nil {
+			return fmt.Errorf("invalid storage class for DataVolume %s: %w", dv.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// storageClassName returns the given storage class if it exists in the KubeVirt infra cluster. If no
+// storage class is given, the default storage class of the infra cluster is returned.
+func storageClassName(ctx context.Context, client ctrlruntimeclient.Client, name string) (string, error) {
+	storageClasses := &storagev1.StorageClassList{}
+	if err := client.List(ctx, storageClasses); err != nil {
+		return "", fmt.Errorf("failed to list storage classes: %w", err)
+	}
+
+	available := sets.NewString()
+	for _, sc := range storageClasses.Items {
+		if name == "" && sc.Annotations[defaultStorageClassAnnotation] == "true" {
+			return sc.Name, nil
+		}
+		available.Insert(sc.Name)
+	}
+
+	if name == "" {
+		return "", fmt.Errorf("no storage class specified and the infra cluster has no default storage class, available storage classes: %v", available.List())
+	}
+
+	if !available.Has(name) {
+		return "", fmt.Errorf("storage class %q does not exist in the infra cluster, available storage classes: %v", name, available.List())
+	}
+
+	return name, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevirt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestStorageClassName(t *testing.T) {
+	standard := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "standard",
+			Annotations: map[string]string{defaultStorageClassAnnotation: "true"},
+		},
+	}
+	fast := &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fast",
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		storageClasses []ctrlruntimeclient.Object
+		storageClass   string
+		expected       string
+		wantErr        bool
+	}{
+		{
+			name:           "existing storage class",
+			storageClasses: []ctrlruntimeclient.Object{standard, fast},
+			storageClass:   "fast",
+			expected:       "fast",
+		},
+		{
+			name:           "unknown storage class",
+			storageClasses: []ctrlruntimeclient.Object{standard, fast},
+			storageClass:   "fsat",
+			wantErr:        true,
+		},
+		{
+			name:           "defaulted to the default storage class",
+			storageClasses: []ctrlruntimeclient.Object{fast, standard},
+			expected:       "standard",
+		},
+		{
+			name:           "no default storage class",
+			storageClasses: []ctrlruntimeclient.Object{fast},
+			wantErr:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithObjects(tc.storageClasses...).Build()
+
+			storageClass, err := storageClassName(context.Background(), client, tc.storageClass)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Expected error: %v, got: %v", tc.wantErr, err)
+			}
+
+			if storageClass != tc.expected {
+				t.Errorf("Expected storage class %q, got %q", tc.expected, storageClass)
+			}
+		})
+	}
+}
+
+func TestCreatePreAllocatedDataVolumeSource(t *testing.T) {
+	testCases := []struct {
+		name       string
+		dataVolume kubermaticv1.PreAllocatedDataVolume
+		expected   cdiv1beta1.DataVolumeSource
+	}{
+		{
+			name: "HTTP source",
+			dataVolume: kubermaticv1.PreAllocatedDataVolume{
+				Name: "dv",
+				URL:  "http://images.example.com/ubuntu.img",
+				Size: "10Gi",
+			},
+			expected: cdiv1beta1.DataVolumeSource{
+				HTTP: &cdiv1beta1.DataVolumeSourceHTTP{URL: "http://images.example.com/ubuntu.img"},
+			},
+		},
+		{
+			name: "PVC source",
+			dataVolume: kubermaticv1.PreAllocatedDataVolume{
+				Name:      "dv",
+				SourcePVC: &kubermaticv1.PreAllocatedDataVolumeSourcePVC{Namespace: "images", Name: "ubuntu"},
+				Size:      "10Gi",
+			},
+			expected: cdiv1beta1.DataVolumeSource{
+				PVC: &cdiv1beta1.DataVolumeSourcePVC{Namespace: "images", Name: "ubuntu"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dv, err := createPreAllocatedDataVolume(tc.dataVolume, "cluster-test")
+			if err != nil {
+				t.Fatalf("Failed to create DataVolume: %v", err)
+			}
+
+			if !reflect.DeepEqual(*dv.Spec.Source, tc.expected) {
+				t.Errorf("Expected source %+v, got %+v", tc.expected, *dv.Spec.Source)
+			}
+		})
+	}
+}
+
+func TestCSIRoleCreator(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		disableVolumeDeletion bool
+		expectedVerbs         []string
+	}{
+		{
+			name:          "volume deletion allowed by default",
+			expectedVerbs: []string{"get", "create", "delete"},
+		},
+		{
+			name:                  "volume deletion disabled",
+			disableVolumeDeletion: true,
+			expectedVerbs:         []string{"get", "create"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, create := csiRoleCreator(csiResourceName, tc.disableVolumeDeletion)()
+
+			// start from a role with outdated rules to ensure they are overwritten
+			existing := &rbacv1.Role{
+				Rules: []rbacv1.PolicyRule{{
+					APIGroups: []string{"cdi.kubevirt.io"},
+					Resources: []string{"datavolumes"},
+					Verbs:     []string{"*"},
+				}},
+			}
+
+			role, err := create(existing)
+			if err != nil {
+				t.Fatalf("Failed to create role: %v", err)
+			}
+
+			var verbs []string
+			for _, rule := range role.Rules {
+				if len(rule.Resources) == 1 && rule.Resources[0] == "datavolumes" {
+					verbs = append(verbs, rule.Verbs...)
+				}
+			}
+
+			if !reflect.DeepEqual(verbs, tc.expectedVerbs) {
+				t.Errorf("Expected datavolume verbs %v, got %v", tc.expectedVerbs, verbs)
+			}
+		})
+	}
+}
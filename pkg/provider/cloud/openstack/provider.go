@@ -95,6 +95,7 @@ func NewCloudProvider(
 }
 
 var _ provider.CloudProvider = &Provider{}
+var _ provider.CredentialValidator = &Provider{}
 
 // DefaultCloudSpec adds defaults to the cloud spec.
 func (os *Provider) DefaultCloudSpec(ctx context.Context, spec *kubermaticv1.CloudSpec) error {
@@ -753,6 +754,14 @@ func addICMPRulesToSecurityGroupIfNecesary(cluster *kubermaticv1.Cluster, secGro
 	return nil
 }
 
+// ValidateCredentials checks that the credentials in the given CloudSpec can be used to
+// authenticate against OpenStack. No resources are created or modified.
+func (os *Provider) ValidateCredentials(ctx context.Context, spec kubermaticv1.CloudSpec) error {
+	// creating the client already requires a successful authentication
+	_, err := os.getClientFunc(ctx, spec, os.dc, os.secretKeySelector, os.caBundle)
+	return err
+}
+
 // ValidateCloudSpecUpdate verifies whether an update of cloud spec is valid and permitted.
 func (os *Provider) ValidateCloudSpecUpdate(_ context.Context, oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error {
 	if oldSpec.Openstack == nil || newSpec.Openstack == nil {
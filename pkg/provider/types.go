@@ -68,6 +68,23 @@ type ReconcilingCloudProvider interface {
 	ReconcileCluster(context.Context, *kubermaticv1.Cluster, ClusterUpdater) (*kubermaticv1.Cluster, error)
 }
 
+// HealingCloudProvider is a reconciling cloud provider that can additionally heal drift on
+// existing resources (e.g. re-assert tags or firewall rules) without the comparatively expensive
+// full reconciliation. Healing must never create or recreate resources, this is left to
+// ReconcileCluster.
+type HealingCloudProvider interface {
+	ReconcilingCloudProvider
+
+	HealCloudProvider(context.Context, *kubermaticv1.Cluster, ClusterUpdater) (*kubermaticv1.Cluster, error)
+}
+
+// CredentialValidator is a cloud provider that can check whether the credentials in a
+// cloud spec are valid, e.g. to test the connection before a cluster is created. Unlike
+// ValidateCloudSpec, this must only authenticate and never create or mutate resources.
+type CredentialValidator interface {
+	ValidateCredentials(context.Context, kubermaticv1.CloudSpec) error
+}
+
 // UpdaterOption represent an option for the updater function.
 type UpdaterOption string
 
@@ -50,6 +50,8 @@ var (
 			corev1.ResourceCPU:    resource.MustParse("2"),
 		},
 	}
+
+	serviceAccountKeyFile = filepath.Join("/etc/kubernetes/service-account-key", resources.ServiceAccountKeySecretKey)
 )
 
 const (
@@ -283,7 +285,6 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 
 	admissionPlugins.Insert(cluster.Spec.AdmissionPlugins...)
 
-	serviceAccountKeyFile := filepath.Join("/etc/kubernetes/service-account-key", resources.ServiceAccountKeySecretKey)
 	flags := []string{
 		"--etcd-servers", strings.Join(etcdEndpoints, ","),
 		"--etcd-cafile", "/etc/etcd/pki/client/ca.crt",
@@ -352,34 +353,7 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 		flags = append(flags, "--endpoint-reconciler-type", "none")
 	}
 
-	// enable service account signing key and issuer in Kubernetes 1.20 or when
-	// explicitly enabled in the cluster object
-	var audiences []string
-
-	issuer := cluster.Address.URL
-	if saConfig := cluster.Spec.ServiceAccount; saConfig != nil {
-		if saConfig.Issuer != "" {
-			issuer = saConfig.Issuer
-		}
-
-		if len(saConfig.APIAudiences) > 0 {
-			audiences = saConfig.APIAudiences
-		}
-	}
-
-	if len(audiences) == 0 {
-		audiences = []string{issuer}
-	}
-
-	if data.IsKonnectivityEnabled() {
-		audiences = append(audiences, "system:konnectivity-server")
-	}
-
-	flags = append(flags,
-		"--service-account-issuer", issuer,
-		"--service-account-signing-key-file", serviceAccountKeyFile,
-		"--api-audiences", strings.Join(audiences, ","),
-	)
+	flags = append(flags, getServiceAccountFlags(cluster, data.IsKonnectivityEnabled())...)
 
 	if cluster.Spec.Cloud.GCP != nil {
 		flags = append(flags, "--kubelet-preferred-address-types", "InternalIP")
@@ -448,6 +422,48 @@ func getApiserverFlags(data *resources.TemplateData, etcdEndpoints []string, ena
 	return flags, nil
 }
 
+// getServiceAccountFlags returns the flags configuring the service account signing key, issuer and audiences.
+func getServiceAccountFlags(cluster *kubermaticv1.Cluster, isKonnectivityEnabled bool) []string {
+	// enable service account signing key and issuer in Kubernetes 1.20 or when
+	// explicitly enabled in the cluster object
+	var audiences []string
+
+	issuer := cluster.Address.URL
+	jwksURI := ""
+	if saConfig := cluster.Spec.ServiceAccount; saConfig != nil {
+		if saConfig.Issuer != "" {
+			issuer = saConfig.Issuer
+		}
+
+		if len(saConfig.APIAudiences) > 0 {
+			audiences = saConfig.APIAudiences
+		}
+
+		jwksURI = saConfig.JWKSURI
+	}
+
+	if len(audiences) == 0 {
+		audiences = []string{issuer}
+	}
+
+	if isKonnectivityEnabled {
+		audiences = append(audiences, "system:konnectivity-server")
+	}
+
+	flags := []string{
+		"--service-account-issuer", issuer,
+		"--service-account-signing-key-file", serviceAccountKeyFile,
+		"--api-audiences", strings.Join(audiences, ","),
+	}
+
+	// the JWKS is served externally, so the discovery document needs to point there
+	if jwksURI != "" {
+		flags = append(flags, "--service-account-jwks-uri", jwksURI)
+	}
+
+	return flags
+}
+
 // getApiserverOverrideFlags creates all settings that may be overridden by cluster specific componentsOverrideSettings
 // otherwise global overrides or defaults will be set.
 func getApiserverOverrideFlags(data *resources.TemplateData) (kubermaticv1.APIServerSettings, error) {
@@ -779,7 +795,7 @@ func GetEnvVars(data kubeAPIServerEnvData) ([]corev1.EnvVar, error) {
 		vars = append(vars, corev1.EnvVar{Name: "AWS_ASSUME_ROLE_EXTERNAL_ID", Value: cluster.Spec.Cloud.AWS.AssumeRoleExternalID})
 	}
 
-	return append(vars, resources.GetHTTPProxyEnvVarsFromSeed(data.Seed(), data.Cluster().Address.InternalName)...), nil
+	return append(vars, resources.GetControlPlaneHTTPProxyEnvVars(data.Seed(), data.Cluster())...), nil
 }
 
 func intPtr(n int32) *int32 {
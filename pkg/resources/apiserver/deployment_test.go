@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+)
+
+func TestGetServiceAccountFlags(t *testing.T) {
+	testCases := []struct {
+		name           string
+		serviceAccount *kubermaticv1.ServiceAccountSettings
+		konnectivity   bool
+		expectedFlags  []string
+	}{
+		{
+			name: "defaults to the cluster address",
+			expectedFlags: []string{
+				"--service-account-issuer", "https://cluster.example.com:6443",
+				"--service-account-signing-key-file", serviceAccountKeyFile,
+				"--api-audiences", "https://cluster.example.com:6443",
+			},
+		},
+		{
+			name: "konnectivity audience is appended",
+			expectedFlags: []string{
+				"--service-account-issuer", "https://cluster.example.com:6443",
+				"--service-account-signing-key-file", serviceAccountKeyFile,
+				"--api-audiences", "https://cluster.example.com:6443,system:konnectivity-server",
+			},
+			konnectivity: true,
+		},
+		{
+			name: "custom issuer and JWKS URI",
+			serviceAccount: &kubermaticv1.ServiceAccountSettings{
+				Issuer:  "https://issuer.example.com",
+				JWKSURI: "https://issuer.example.com/openid/v1/jwks",
+			},
+			expectedFlags: []string{
+				"--service-account-issuer", "https://issuer.example.com",
+				"--service-account-signing-key-file", serviceAccountKeyFile,
+				"--api-audiences", "https://issuer.example.com",
+				"--service-account-jwks-uri", "https://issuer.example.com/openid/v1/jwks",
+			},
+		},
+		{
+			name: "custom audiences",
+			serviceAccount: &kubermaticv1.ServiceAccountSettings{
+				APIAudiences: []string{"sts.example.com"},
+			},
+			expectedFlags: []string{
+				"--service-account-issuer", "https://cluster.example.com:6443",
+				"--service-account-signing-key-file", serviceAccountKeyFile,
+				"--api-audiences", "sts.example.com",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					ServiceAccount: tc.serviceAccount,
+				},
+				Address: kubermaticv1.ClusterAddress{
+					URL: "https://cluster.example.com:6443",
+				},
+			}
+
+			flags := getServiceAccountFlags(cluster, tc.konnectivity)
+			if diff := deep.Equal(flags, tc.expectedFlags); diff != nil {
+				t.Errorf("Got unexpected flags. Diff: %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"gopkg.in/square/go-jose.v2"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type serviceAccountKeyProvider interface {
+	GetSecretKeyValue(ref *corev1.SecretKeySelector) ([]byte, error)
+}
+
+// ServiceAccountJWKSConfigMapCreator returns a function to create/update a configmap containing the
+// JSON Web Key Set for the service account signing key, so it can be served outside of the apiserver.
+func ServiceAccountJWKSConfigMapCreator(data serviceAccountKeyProvider) reconciling.NamedConfigMapCreatorGetter {
+	return func() (string, reconciling.ConfigMapCreator) {
+		return resources.ServiceAccountJWKSConfigMapName, func(c *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			publicKey, err := data.GetSecretKeyValue(&corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: resources.ServiceAccountKeySecretName},
+				Key:                  resources.ServiceAccountKeyPublicKey,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get service account public key: %w", err)
+			}
+
+			jwks, err := serviceAccountJWKS(publicKey)
+			if err != nil {
+				return nil, err
+			}
+
+			c.Data = map[string]string{
+				resources.ServiceAccountJWKSConfigMapKey: string(jwks),
+			}
+
+			return c, nil
+		}
+	}
+}
+
+// serviceAccountJWKS converts the PEM encoded public key into a JSON Web Key Set. The key ID is
+// derived the same way the apiserver does, so it matches the "kid" header of issued tokens.
+func serviceAccountJWKS(publicKeyPEM []byte) ([]byte, error) {
+	block, _ := pem.Decode(publicKeyPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode service account public key")
+	}
+
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse service account public key: %w", err)
+	}
+
+	keyID := sha256.Sum256(block.Bytes)
+
+	return json.Marshal(jose.JSONWebKeySet{
+		Keys: []jose.JSONWebKey{
+			{
+				Key:       publicKey,
+				KeyID:     base64.RawURLEncoding.EncodeToString(keyID[:]),
+				Algorithm: string(jose.RS256),
+				Use:       "sig",
+			},
+		},
+	})
+}
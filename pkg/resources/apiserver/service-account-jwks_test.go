@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/square/go-jose.v2"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+type fakeServiceAccountKeyProvider struct {
+	secret *corev1.Secret
+}
+
+func (f *fakeServiceAccountKeyProvider) GetSecretKeyValue(ref *corev1.SecretKeySelector) ([]byte, error) {
+	return f.secret.Data[ref.Key], nil
+}
+
+func TestServiceAccountJWKSConfigMapCreator(t *testing.T) {
+	_, createKey := ServiceAccountKeyCreator()()
+	secret, err := createKey(&corev1.Secret{})
+	if err != nil {
+		t.Fatalf("failed to create service account key: %v", err)
+	}
+
+	_, create := ServiceAccountJWKSConfigMapCreator(&fakeServiceAccountKeyProvider{secret: secret})()
+	cm, err := create(&corev1.ConfigMap{})
+	if err != nil {
+		t.Fatalf("failed to create ConfigMap: %v", err)
+	}
+
+	jwks := jose.JSONWebKeySet{}
+	if err := json.Unmarshal([]byte(cm.Data[resources.ServiceAccountJWKSConfigMapKey]), &jwks); err != nil {
+		t.Fatalf("failed to parse JWKS: %v", err)
+	}
+
+	if len(jwks.Keys) != 1 {
+		t.Fatalf("expected exactly one key, got %d", len(jwks.Keys))
+	}
+
+	key := jwks.Keys[0]
+	if !key.IsPublic() {
+		t.Error("expected JWKS to only contain a public key")
+	}
+	if key.KeyID == "" {
+		t.Error("expected key to have a key ID")
+	}
+	if key.Algorithm != string(jose.RS256) {
+		t.Errorf("expected algorithm %q, got %q", jose.RS256, key.Algorithm)
+	}
+}
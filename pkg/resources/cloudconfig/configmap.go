@@ -39,6 +39,25 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// azureCCMCloudConfig extends the machine-controller's Azure cloud-config with fields
+// that are only used by the Azure cloud-controller-manager.
+type azureCCMCloudConfig struct {
+	azure.CloudConfig
+
+	// RouteTableResourceGroup is the resource group of the route table, if it is
+	// not part of the cluster's resource group.
+	RouteTableResourceGroup string `json:"routeTableResourceGroup,omitempty"`
+}
+
+func azureCloudConfigToString(c *azureCCMCloudConfig) (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	return string(b), nil
+}
+
 type configMapCreatorData interface {
 	DC() *kubermaticv1.Datacenter
 	Cluster() *kubermaticv1.Cluster
@@ -65,7 +84,13 @@ func ConfigMapCreator(data configMapCreatorData) reconciling.NamedConfigMapCreat
 
 			cm.Labels = resources.BaseAppLabels(resources.CloudConfigConfigMapName, nil)
 			cm.Data[resources.CloudConfigKey] = cloudConfig
-			cm.Data[FakeVMWareUUIDKeyName] = fakeVMWareUUID
+
+			// the fake UUID is only consumed by the vSphere cloud-provider, see FakeVMWareUUIDKeyName
+			if data.Cluster().Spec.Cloud.VSphere != nil {
+				cm.Data[FakeVMWareUUIDKeyName] = fakeVMWareUUID
+			} else {
+				delete(cm.Data, FakeVMWareUUIDKeyName)
+			}
 
 			return cm, nil
 		}
@@ -174,7 +199,10 @@ func CloudConfig(
 			UseInstanceMetadata:        false,
 			LoadBalancerSku:            string(cloud.Azure.LoadBalancerSKU),
 		}
-		cloudConfig, err = azure.CloudConfigToString(azureCloudConfig)
+		cloudConfig, err = azureCloudConfigToString(&azureCCMCloudConfig{
+			CloudConfig:             *azureCloudConfig,
+			RouteTableResourceGroup: cloud.Azure.RouteTableResourceGroup,
+		})
 		if err != nil {
 			return cloudConfig, err
 		}
@@ -186,6 +214,14 @@ func CloudConfig(
 		if cluster.Spec.Cloud.Openstack.UseOctavia != nil {
 			useOctavia = cluster.Spec.Cloud.Openstack.UseOctavia
 		}
+		floatingNetworkID := dc.Spec.Openstack.FloatingNetworkID
+		if cluster.Spec.Cloud.Openstack.FloatingNetworkID != "" {
+			floatingNetworkID = cluster.Spec.Cloud.Openstack.FloatingNetworkID
+		}
+		lbProvider := dc.Spec.Openstack.LoadBalancerProvider
+		if cluster.Spec.Cloud.Openstack.LoadBalancerProvider != "" {
+			lbProvider = cluster.Spec.Cloud.Openstack.LoadBalancerProvider
+		}
 		openstackCloudConfig := &openstack.CloudConfig{
 			Global: openstack.GlobalOpts{
 				AuthURL:                     dc.Spec.Openstack.AuthURL,
@@ -206,6 +242,8 @@ func CloudConfig(
 			LoadBalancer: openstack.LoadBalancerOpts{
 				ManageSecurityGroups: manageSecurityGroups == nil || *manageSecurityGroups,
 				UseOctavia:           useOctavia,
+				FloatingNetworkID:    floatingNetworkID,
+				LBProvider:           lbProvider,
 			},
 			Version: cluster.Status.Versions.ControlPlane.String(),
 		}
@@ -256,7 +294,8 @@ func CloudConfig(
 		// to limit available zones for the user. So, we will just enable multizone support as a workaround.
 
 		// FIXME: Compare localZone to MachineDeployment.Zone and set multizone to true
-		// when they differ, or if len(dc.Spec.GCP.ZoneSuffixes) > 1
+		// when they differ, or if len(dc.Spec.GCP.ZoneSuffixes) > 1. Regional clusters span all
+		// zones of their region and must always have multizone enabled.
 		multizone := true
 
 		if cloud.GCP.Network == "" || cloud.GCP.Network == gcp.DefaultNetwork {
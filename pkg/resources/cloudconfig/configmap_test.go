@@ -17,6 +17,8 @@ limitations under the License.
 package cloudconfig
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"testing"
 
 	"github.com/go-test/deep"
@@ -372,6 +374,79 @@ func TestOpenStackCloudConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "floating network and lb provider set at seed level",
+			cluster: &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Version: *semver.NewSemverOrDie("v1.1.1"),
+					Cloud: kubermaticv1.CloudSpec{
+						Openstack: &kubermaticv1.OpenstackCloudSpec{},
+					},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					Versions: kubermaticv1.ClusterVersionsStatus{
+						ControlPlane: *semver.NewSemverOrDie("v1.1.1"),
+					},
+				},
+			},
+			dc: &kubermaticv1.Datacenter{
+				Spec: kubermaticv1.DatacenterSpec{
+					Openstack: &kubermaticv1.DatacenterSpecOpenstack{
+						FloatingNetworkID:    "dc-ext-net",
+						LoadBalancerProvider: "amphora",
+					},
+				},
+			},
+			wantConfig: &openstack.CloudConfig{
+				LoadBalancer: openstack.LoadBalancerOpts{
+					LBVersion:         "v2",
+					LBMethod:          "ROUND_ROBIN",
+					FloatingNetworkID: "dc-ext-net",
+					LBProvider:        "amphora",
+				},
+				BlockStorage: openstack.BlockStorageOpts{
+					BSVersion: "auto",
+				},
+			},
+		},
+		{
+			name: "floating network and lb provider set at cluster level",
+			cluster: &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					Version: *semver.NewSemverOrDie("v1.1.1"),
+					Cloud: kubermaticv1.CloudSpec{
+						Openstack: &kubermaticv1.OpenstackCloudSpec{
+							FloatingNetworkID:    "cluster-ext-net",
+							LoadBalancerProvider: "ovn",
+						},
+					},
+				},
+				Status: kubermaticv1.ClusterStatus{
+					Versions: kubermaticv1.ClusterVersionsStatus{
+						ControlPlane: *semver.NewSemverOrDie("v1.1.1"),
+					},
+				},
+			},
+			dc: &kubermaticv1.Datacenter{
+				Spec: kubermaticv1.DatacenterSpec{
+					Openstack: &kubermaticv1.DatacenterSpecOpenstack{
+						FloatingNetworkID:    "dc-ext-net",
+						LoadBalancerProvider: "amphora",
+					},
+				},
+			},
+			wantConfig: &openstack.CloudConfig{
+				LoadBalancer: openstack.LoadBalancerOpts{
+					LBVersion:         "v2",
+					LBMethod:          "ROUND_ROBIN",
+					FloatingNetworkID: "cluster-ext-net",
+					LBProvider:        "ovn",
+				},
+				BlockStorage: openstack.BlockStorageOpts{
+					BSVersion: "auto",
+				},
+			},
+		},
 	}
 
 	for idx := range testCases {
@@ -392,6 +467,160 @@ func TestOpenStackCloudConfig(t *testing.T) {
 	}
 }
 
+// gceCloudConfig mirrors the gcfg keys rendered by the GCE cloud-config template.
+type gceCloudConfig struct {
+	Global struct {
+		ProjectID      string   `gcfg:"project-id"`
+		LocalZone      string   `gcfg:"local-zone"`
+		NetworkName    string   `gcfg:"network-name"`
+		SubnetworkName string   `gcfg:"subnetwork-name"`
+		TokenURL       string   `gcfg:"token-url"`
+		MultiZone      bool     `gcfg:"multizone"`
+		Regional       bool     `gcfg:"regional"`
+		NodeTags       []string `gcfg:"node-tags"`
+	}
+}
+
+func TestGCPCloudConfig(t *testing.T) {
+	serviceAccount := base64.StdEncoding.EncodeToString([]byte(`{"project_id":"kubermatic"}`))
+
+	testCases := []struct {
+		name          string
+		dc            *kubermaticv1.DatacenterSpecGCP
+		wantLocalZone string
+		wantRegional  bool
+	}{
+		{
+			name: "zonal cluster",
+			dc: &kubermaticv1.DatacenterSpecGCP{
+				Region:       "europe-west3",
+				ZoneSuffixes: []string{"c"},
+			},
+			wantLocalZone: "europe-west3-c",
+		},
+		{
+			name: "regional cluster",
+			dc: &kubermaticv1.DatacenterSpecGCP{
+				Region:       "europe-west3",
+				ZoneSuffixes: []string{"a", "b", "c"},
+				Regional:     true,
+			},
+			wantLocalZone: "europe-west3-a",
+			wantRegional:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Cloud: kubermaticv1.CloudSpec{
+						GCP: &kubermaticv1.GCPCloudSpec{},
+					},
+				},
+			}
+			dc := &kubermaticv1.Datacenter{
+				Spec: kubermaticv1.DatacenterSpec{
+					GCP: tc.dc,
+				},
+			}
+			credentials := resources.Credentials{
+				GCP: resources.GCPCredentials{
+					ServiceAccount: serviceAccount,
+				},
+			}
+
+			cloudConfig, err := CloudConfig(cluster, dc, credentials)
+			if err != nil {
+				t.Fatalf("Error trying to get cloud-config: %v", err)
+			}
+
+			actual := gceCloudConfig{}
+			unmarshalINICloudConfig(t, &actual, cloudConfig)
+
+			if actual.Global.LocalZone != tc.wantLocalZone {
+				t.Errorf("Expected local zone %q, got %q", tc.wantLocalZone, actual.Global.LocalZone)
+			}
+			if actual.Global.Regional != tc.wantRegional {
+				t.Errorf("Expected regional to be %v, got %v", tc.wantRegional, actual.Global.Regional)
+			}
+			if !actual.Global.MultiZone {
+				t.Error("Expected multizone to be enabled")
+			}
+			if diff := deep.Equal(actual.Global.NodeTags, []string{"kubernetes-cluster-test"}); len(diff) > 0 {
+				t.Errorf("node tags differ from the expected ones: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAzureCloudConfig(t *testing.T) {
+	testCases := []struct {
+		name                        string
+		routeTableResourceGroup     string
+		wantRouteTableResourceGroup string
+	}{
+		{
+			name:                        "route table in the cluster resource group",
+			wantRouteTableResourceGroup: "",
+		},
+		{
+			name:                        "route table in a separate resource group",
+			routeTableResourceGroup:     "route-table-rg",
+			wantRouteTableResourceGroup: "route-table-rg",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Cloud: kubermaticv1.CloudSpec{
+						Azure: &kubermaticv1.AzureCloudSpec{
+							ResourceGroup:           "cluster-rg",
+							RouteTableName:          "route-table",
+							RouteTableResourceGroup: tc.routeTableResourceGroup,
+						},
+					},
+				},
+			}
+			dc := &kubermaticv1.Datacenter{
+				Spec: kubermaticv1.DatacenterSpec{
+					Azure: &kubermaticv1.DatacenterSpecAzure{
+						Location: "westeurope",
+					},
+				},
+			}
+
+			cloudConfig, err := CloudConfig(cluster, dc, resources.Credentials{})
+			if err != nil {
+				t.Fatalf("Error trying to get cloud-config: %v", err)
+			}
+
+			actual := azureCCMCloudConfig{}
+			if err := json.Unmarshal([]byte(cloudConfig), &actual); err != nil {
+				t.Fatalf("Failed to unmarshal cloud-config: %v", err)
+			}
+
+			if actual.ResourceGroup != "cluster-rg" {
+				t.Errorf("Expected resource group %q, got %q", "cluster-rg", actual.ResourceGroup)
+			}
+			if actual.RouteTableName != "route-table" {
+				t.Errorf("Expected route table %q, got %q", "route-table", actual.RouteTableName)
+			}
+			if actual.RouteTableResourceGroup != tc.wantRouteTableResourceGroup {
+				t.Errorf("Expected route table resource group %q, got %q", tc.wantRouteTableResourceGroup, actual.RouteTableResourceGroup)
+			}
+		})
+	}
+}
+
 func unmarshalINICloudConfig(t *testing.T, config interface{}, rawConfig string) {
 	if err := gcfg.ReadStringInto(config, rawConfig); err != nil {
 		t.Fatalf("error occurred while marshaling config: %v", err)
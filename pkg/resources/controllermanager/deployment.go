@@ -430,7 +430,7 @@ func GetEnvVars(data kubeControllerManagerEnvData) ([]corev1.EnvVar, error) {
 		vars = append(vars, corev1.EnvVar{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/etc/gcp/serviceAccount"})
 	}
 
-	vars = append(vars, resources.GetHTTPProxyEnvVarsFromSeed(data.Seed(), data.Cluster().Address.InternalName)...)
+	vars = append(vars, resources.GetControlPlaneHTTPProxyEnvVars(data.Seed(), data.Cluster())...)
 	return vars, nil
 }
 
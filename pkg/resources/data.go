@@ -77,6 +77,8 @@ type TemplateData struct {
 	dnatControllerImage              string
 	machineControllerImageTag        string
 	machineControllerImageRepository string
+	osmImageTag                      string
+	osmImageRepository               string
 	backupSchedule                   time.Duration
 	versions                         kubermatic.Versions
 	caBundle                         CABundle
@@ -210,6 +212,16 @@ func (td *TemplateDataBuilder) WithMachineControllerImageRepository(repository s
 	return td
 }
 
+func (td *TemplateDataBuilder) WithOperatingSystemManagerImageTag(tag string) *TemplateDataBuilder {
+	td.data.osmImageTag = tag
+	return td
+}
+
+func (td *TemplateDataBuilder) WithOperatingSystemManagerImageRepository(repository string) *TemplateDataBuilder {
+	td.data.osmImageRepository = repository
+	return td
+}
+
 func (td TemplateDataBuilder) Build() *TemplateData {
 	// TODO: Add validation
 	return &td.data
@@ -250,8 +262,13 @@ func (d *TemplateData) DC() *kubermaticv1.Datacenter {
 	return d.dc
 }
 
-// EtcdDiskSize returns the etcd disk size.
+// EtcdDiskSize returns the etcd disk size. The cluster's etcd override takes
+// precedence over the default configured for the controller.
 func (d *TemplateData) EtcdDiskSize() resource.Quantity {
+	if d.cluster != nil && d.cluster.Spec.ComponentsOverride.Etcd.DiskSize != nil {
+		return *d.cluster.Spec.ComponentsOverride.Etcd.DiskSize
+	}
+
 	return d.etcdDiskSize
 }
 
@@ -332,6 +349,14 @@ func (d *TemplateData) MachineControllerImageRepository() string {
 	return d.machineControllerImageRepository
 }
 
+func (d *TemplateData) OperatingSystemManagerImageTag() string {
+	return d.osmImageTag
+}
+
+func (d *TemplateData) OperatingSystemManagerImageRepository() string {
+	return d.osmImageRepository
+}
+
 // ClusterIPByServiceName returns the ClusterIP as string for the
 // Service specified by `name`. Service lookup happens within
 // `Cluster.Status.NamespaceName`. When ClusterIP fails to parse
@@ -32,6 +32,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// defaultDefragmentationSchedule is used unless the cluster overrides the etcd defragmentation schedule.
+	defaultDefragmentationSchedule = "@every 3h"
+)
+
 type cronJobCreatorData interface {
 	Cluster() *kubermaticv1.Cluster
 	ImageRegistry(string) string
@@ -51,7 +56,10 @@ func CronJobCreator(data cronJobCreatorData) reconciling.NamedCronJobCreatorGett
 			job.Spec.ConcurrencyPolicy = batchv1beta1.ForbidConcurrent
 			var historyLimit int32
 			job.Spec.SuccessfulJobsHistoryLimit = &historyLimit
-			job.Spec.Schedule = "@every 3h"
+			job.Spec.Schedule = defaultDefragmentationSchedule
+			if schedule := data.Cluster().Spec.ComponentsOverride.Etcd.DefragmentationSchedule; schedule != "" {
+				job.Spec.Schedule = schedule
+			}
 			job.Spec.JobTemplate.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
 			job.Spec.JobTemplate.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: resources.ImagePullSecretName}}
 			job.Spec.JobTemplate.Spec.Template.Spec.Containers = []corev1.Container{
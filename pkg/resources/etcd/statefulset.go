@@ -307,11 +307,7 @@ func StatefulSetCreator(data etcdStatefulSetCreatorData, enableDataCorruptionChe
 				if storageClass == "" {
 					storageClass = "kubermatic-fast"
 				}
-				diskSize := data.Cluster().Spec.ComponentsOverride.Etcd.DiskSize
-				if diskSize == nil {
-					d := data.EtcdDiskSize()
-					diskSize = &d
-				}
+				diskSize := data.EtcdDiskSize()
 				set.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{
 					{
 						ObjectMeta: metav1.ObjectMeta{
@@ -321,7 +317,7 @@ func StatefulSetCreator(data etcdStatefulSetCreatorData, enableDataCorruptionChe
 							StorageClassName: resources.String(storageClass),
 							AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
 							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{corev1.ResourceStorage: *diskSize},
+								Requests: corev1.ResourceList{corev1.ResourceStorage: diskSize},
 							},
 						},
 					},
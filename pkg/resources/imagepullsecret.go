@@ -17,9 +17,14 @@ limitations under the License.
 package resources
 
 import (
+	"crypto/sha256"
+	"fmt"
+
 	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ImagePullSecretCreator returns a creator function to create a ImagePullSecret.
@@ -38,3 +43,40 @@ func ImagePullSecretCreator(dockerPullConfigJSON []byte) reconciling.NamedSecret
 		}
 	}
 }
+
+// ImagePullSecretRevisionWrapper returns an ObjectModifier that annotates the pod template of Deployments
+// using the dockercfg secret with a hash of the given pull config. When the pull config changes, the
+// annotation changes as well and the Deployment is rolled out with the new credentials.
+func ImagePullSecretRevisionWrapper(dockerPullConfigJSON []byte) reconciling.ObjectModifier {
+	revision := fmt.Sprintf("%x", sha256.Sum256(dockerPullConfigJSON))[:16]
+
+	return func(create reconciling.ObjectCreator) reconciling.ObjectCreator {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			dep, ok := obj.(*appsv1.Deployment)
+			if !ok || !usesImagePullSecret(dep.Spec.Template.Spec) {
+				return obj, nil
+			}
+
+			if dep.Spec.Template.Annotations == nil {
+				dep.Spec.Template.Annotations = map[string]string{}
+			}
+			dep.Spec.Template.Annotations[ImagePullSecretRevisionAnnotation] = revision
+
+			return dep, nil
+		}
+	}
+}
+
+func usesImagePullSecret(podSpec corev1.PodSpec) bool {
+	for _, ref := range podSpec.ImagePullSecrets {
+		if ref.Name == ImagePullSecretName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestImagePullSecretRevisionWrapper(t *testing.T) {
+	deploymentCreator := func(pullSecrets ...string) func(ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			dep := existing.(*appsv1.Deployment)
+			dep.Spec.Template.Spec.ImagePullSecrets = nil
+			for _, name := range pullSecrets {
+				dep.Spec.Template.Spec.ImagePullSecrets = append(dep.Spec.Template.Spec.ImagePullSecrets, corev1.LocalObjectReference{Name: name})
+			}
+			return dep, nil
+		}
+	}
+
+	reconcile := func(t *testing.T, existing *appsv1.Deployment, pullConfig string, pullSecrets ...string) *appsv1.Deployment {
+		create := ImagePullSecretRevisionWrapper([]byte(pullConfig))(deploymentCreator(pullSecrets...))
+		obj, err := create(existing.DeepCopy())
+		if err != nil {
+			t.Fatalf("failed to create Deployment: %v", err)
+		}
+		return obj.(*appsv1.Deployment)
+	}
+
+	t.Run("unchanged pull config keeps the revision", func(t *testing.T) {
+		existing := reconcile(t, &appsv1.Deployment{}, `{"auths":{"a":{}}}`, ImagePullSecretName)
+		updated := reconcile(t, existing, `{"auths":{"a":{}}}`, ImagePullSecretName)
+
+		oldRevision := existing.Spec.Template.Annotations[ImagePullSecretRevisionAnnotation]
+		if oldRevision == "" {
+			t.Fatal("expected Deployment to carry a pull secret revision")
+		}
+		if newRevision := updated.Spec.Template.Annotations[ImagePullSecretRevisionAnnotation]; newRevision != oldRevision {
+			t.Errorf("expected revision to stay %q, got %q", oldRevision, newRevision)
+		}
+	})
+
+	t.Run("changed pull config bumps the revision", func(t *testing.T) {
+		existing := reconcile(t, &appsv1.Deployment{}, `{"auths":{"a":{}}}`, ImagePullSecretName)
+		updated := reconcile(t, existing, `{"auths":{"b":{}}}`, ImagePullSecretName)
+
+		oldRevision := existing.Spec.Template.Annotations[ImagePullSecretRevisionAnnotation]
+		if newRevision := updated.Spec.Template.Annotations[ImagePullSecretRevisionAnnotation]; newRevision == oldRevision {
+			t.Errorf("expected revision to change from %q", oldRevision)
+		}
+	})
+
+	t.Run("deployments without the pull secret are not annotated", func(t *testing.T) {
+		dep := reconcile(t, &appsv1.Deployment{}, `{"auths":{"a":{}}}`, "other-secret")
+
+		if _, ok := dep.Spec.Template.Annotations[ImagePullSecretRevisionAnnotation]; ok {
+			t.Error("expected Deployment to not carry a pull secret revision")
+		}
+	})
+}
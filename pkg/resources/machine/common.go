@@ -437,10 +437,15 @@ func getGCPProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec, dc *ku
 		Subnetwork:            providerconfig.ConfigVarString{Value: c.Spec.Cloud.GCP.Subnetwork},
 		AssignPublicIPAddress: &providerconfig.ConfigVarBool{Value: pointer.Bool(true)},
 		CustomImage:           providerconfig.ConfigVarString{Value: nodeSpec.Cloud.GCP.CustomImage},
-		MultiZone:             providerconfig.ConfigVarBool{Value: pointer.Bool(false)},
-		Regional:              providerconfig.ConfigVarBool{Value: pointer.Bool(false)},
+		// nodes of regional datacenters are spread over all zones of the region, so the
+		// cloud provider on the nodes must not assume a single zone, just like the one
+		// in the control plane
+		MultiZone: providerconfig.ConfigVarBool{Value: pointer.Bool(dc.Spec.GCP.Regional)},
+		Regional:  providerconfig.ConfigVarBool{Value: pointer.Bool(dc.Spec.GCP.Regional)},
 	}
 
+	// the cluster tag is the same for nodes in all zones and is targeted by the cluster's
+	// firewall rules and the node-tags of the cloud-config
 	tags := sets.NewString(nodeSpec.Cloud.GCP.Tags...)
 	tags.Insert(fmt.Sprintf("kubernetes-cluster-%s", c.Name), fmt.Sprintf("system-cluster-%s", c.Name))
 	projectID, ok := c.Labels[kubermaticv1.ProjectIDLabelKey]
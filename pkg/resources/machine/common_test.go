@@ -21,11 +21,14 @@ import (
 	"reflect"
 	"testing"
 
+	gce "github.com/kubermatic/machine-controller/pkg/cloudprovider/provider/gce/types"
 	vsphere "github.com/kubermatic/machine-controller/pkg/cloudprovider/provider/vsphere/types"
 	providerconfigtypes "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/pointer"
 )
 
@@ -139,3 +142,71 @@ func TestGetVSphereProviderSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestGetGCPProviderSpec(t *testing.T) {
+	tests := []struct {
+		name         string
+		regional     bool
+		wantRegional bool
+	}{
+		{
+			name: "zonal datacenter",
+		},
+		{
+			name:         "regional datacenter",
+			regional:     true,
+			wantRegional: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: kubermaticv1.ClusterSpec{
+					Cloud: kubermaticv1.CloudSpec{
+						GCP: &kubermaticv1.GCPCloudSpec{},
+					},
+				},
+			}
+			nodeSpec := apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{
+					GCP: &apiv1.GCPNodeSpec{
+						Zone: "europe-west3-b",
+					},
+				},
+			}
+			dc := &kubermaticv1.Datacenter{
+				Spec: kubermaticv1.DatacenterSpec{
+					GCP: &kubermaticv1.DatacenterSpecGCP{
+						Region:       "europe-west3",
+						ZoneSuffixes: []string{"a", "b", "c"},
+						Regional:     test.regional,
+					},
+				},
+			}
+
+			gotRawExt, err := getGCPProviderSpec(cluster, nodeSpec, dc)
+			if err != nil {
+				t.Fatalf("Failed to get provider spec: %v", err)
+			}
+
+			gotSpec := gce.CloudProviderSpec{}
+			if err := json.Unmarshal(gotRawExt.Raw, &gotSpec); err != nil {
+				t.Fatalf("Failed to unmarshal provider spec: %v", err)
+			}
+
+			if regional := *gotSpec.Regional.Value; regional != test.wantRegional {
+				t.Errorf("Expected regional to be %v, got %v", test.wantRegional, regional)
+			}
+			if multizone := *gotSpec.MultiZone.Value; multizone != test.wantRegional {
+				t.Errorf("Expected multizone to be %v, got %v", test.wantRegional, multizone)
+			}
+			if !sets.NewString(gotSpec.Tags...).Has("kubernetes-cluster-test") {
+				t.Errorf("Expected the cluster tag to be set, got %v", gotSpec.Tags)
+			}
+		})
+	}
+}
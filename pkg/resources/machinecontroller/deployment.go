@@ -120,7 +120,7 @@ func DeploymentCreatorWithoutInitWrapper(data machinecontrollerData) reconciling
 				},
 			}
 
-			clusterDNSIP := resources.NodeLocalDNSCacheAddress
+			clusterDNSIP := resources.UserClusterNodeLocalDNSCacheAddress(data.Cluster())
 			if !data.NodeLocalDNSCacheEnabled() {
 				clusterDNSIP, err = resources.UserClusterDNSResolverIP(data.Cluster())
 				if err != nil {
@@ -280,7 +280,7 @@ func getEnvVars(data machinecontrollerData) ([]corev1.EnvVar, error) {
 		vars = append(vars, corev1.EnvVar{Name: "VCD_ORG", Value: credentials.VMwareCloudDirector.Organization})
 		vars = append(vars, corev1.EnvVar{Name: "VCD_VDC", Value: credentials.VMwareCloudDirector.VDC})
 	}
-	vars = append(vars, resources.GetHTTPProxyEnvVarsFromSeed(data.Seed(), data.Cluster().Address.InternalName)...)
+	vars = append(vars, resources.GetControlPlaneHTTPProxyEnvVars(data.Seed(), data.Cluster())...)
 
 	vars = resources.SanitizeEnvVars(vars)
 	vars = append(vars, corev1.EnvVar{Name: "POD_NAMESPACE", ValueFrom: &corev1.EnvVarSource{FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"}}})
@@ -18,6 +18,7 @@ package metricsserver
 
 import (
 	"fmt"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -56,6 +57,10 @@ const (
 	servingCertMountFolder = "/etc/serving-cert"
 
 	tag = "v0.6.1"
+
+	// DefaultMetricResolution is the interval in which metrics are scraped from the kubelets
+	// if the cluster does not configure it.
+	DefaultMetricResolution = 15 * time.Second
 )
 
 // metricsServerData is the data needed to construct the metrics-server components.
@@ -83,6 +88,26 @@ func TLSServingCertSecretCreator(caGetter servingcerthelper.CAGetter) reconcilin
 		nil)
 }
 
+// KubeletInsecureTLS returns whether the metrics-server skips verifying the kubelet serving
+// certificates, which it does unless the cluster configures otherwise.
+func KubeletInsecureTLS(settings *kubermaticv1.MetricsServerSettings) bool {
+	if settings == nil || settings.KubeletInsecureTLS == nil {
+		return true
+	}
+
+	return *settings.KubeletInsecureTLS
+}
+
+// MetricResolution returns the --metric-resolution flag value for the cluster, falling back
+// to DefaultMetricResolution if it is not configured.
+func MetricResolution(settings *kubermaticv1.MetricsServerSettings) string {
+	if settings == nil || settings.MetricResolution == nil {
+		return DefaultMetricResolution.String()
+	}
+
+	return settings.MetricResolution.Duration.String()
+}
+
 // DeploymentCreator returns the function to create and update the metrics server deployment.
 func DeploymentCreator(data metricsServerData) reconciling.NamedDeploymentCreatorGetter {
 	return func() (string, reconciling.DeploymentCreator) {
@@ -114,20 +139,7 @@ func DeploymentCreator(data metricsServerData) reconciling.NamedDeploymentCreato
 					Name:    name,
 					Image:   data.ImageRegistry(resources.RegistryK8SGCR) + "/metrics-server/metrics-server:" + tag,
 					Command: []string{"/metrics-server"},
-					Args: []string{
-						"--kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
-						"--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
-						"--authorization-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
-						"--kubelet-insecure-tls",
-						"--kubelet-use-node-status-port",
-						"--secure-port", "4443",
-						"--metric-resolution", "15s",
-						// We use the same as the API server as we use the same dnat-controller
-						"--kubelet-preferred-address-types", "ExternalIP,InternalIP",
-						"--v", "1",
-						"--tls-cert-file", servingCertMountFolder + "/" + resources.ServingCertSecretKey,
-						"--tls-private-key-file", servingCertMountFolder + "/" + resources.ServingCertKeySecretKey,
-					},
+					Args:    getArgs(data.Cluster().Spec.MetricsServer),
 					Ports: []corev1.ContainerPort{
 						{
 							ContainerPort: 4443,
@@ -210,6 +222,28 @@ func DeploymentCreator(data metricsServerData) reconciling.NamedDeploymentCreato
 	}
 }
 
+func getArgs(settings *kubermaticv1.MetricsServerSettings) []string {
+	args := []string{
+		"--kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+		"--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+		"--authorization-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+	}
+	if KubeletInsecureTLS(settings) {
+		args = append(args, "--kubelet-insecure-tls")
+	}
+
+	return append(args,
+		"--kubelet-use-node-status-port",
+		"--secure-port", "4443",
+		"--metric-resolution", MetricResolution(settings),
+		// We use the same as the API server as we use the same dnat-controller
+		"--kubelet-preferred-address-types", "ExternalIP,InternalIP",
+		"--v", "1",
+		"--tls-cert-file", servingCertMountFolder+"/"+resources.ServingCertSecretKey,
+		"--tls-private-key-file", servingCertMountFolder+"/"+resources.ServingCertKeySecretKey,
+	)
+}
+
 func getVolumes(isKonnectivityEnabled bool) []corev1.Volume {
 	vs := []corev1.Volume{
 		{
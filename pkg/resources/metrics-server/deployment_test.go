@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricsserver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestGetArgs(t *testing.T) {
+	testCases := []struct {
+		name     string
+		settings *kubermaticv1.MetricsServerSettings
+		expected []string
+	}{
+		{
+			name: "defaults",
+			expected: []string{
+				"--kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--authorization-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--kubelet-insecure-tls",
+				"--kubelet-use-node-status-port",
+				"--secure-port", "4443",
+				"--metric-resolution", "15s",
+				"--kubelet-preferred-address-types", "ExternalIP,InternalIP",
+				"--v", "1",
+				"--tls-cert-file", "/etc/serving-cert/serving.crt",
+				"--tls-private-key-file", "/etc/serving-cert/serving.key",
+			},
+		},
+		{
+			name: "custom resolution",
+			settings: &kubermaticv1.MetricsServerSettings{
+				MetricResolution: &metav1.Duration{Duration: 30 * time.Second},
+			},
+			expected: []string{
+				"--kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--authorization-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--kubelet-insecure-tls",
+				"--kubelet-use-node-status-port",
+				"--secure-port", "4443",
+				"--metric-resolution", "30s",
+				"--kubelet-preferred-address-types", "ExternalIP,InternalIP",
+				"--v", "1",
+				"--tls-cert-file", "/etc/serving-cert/serving.crt",
+				"--tls-private-key-file", "/etc/serving-cert/serving.key",
+			},
+		},
+		{
+			name: "kubelet TLS verification enabled",
+			settings: &kubermaticv1.MetricsServerSettings{
+				KubeletInsecureTLS: pointer.Bool(false),
+			},
+			expected: []string{
+				"--kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--authentication-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--authorization-kubeconfig", "/etc/kubernetes/kubeconfig/kubeconfig",
+				"--kubelet-use-node-status-port",
+				"--secure-port", "4443",
+				"--metric-resolution", "15s",
+				"--kubelet-preferred-address-types", "ExternalIP,InternalIP",
+				"--v", "1",
+				"--tls-cert-file", "/etc/serving-cert/serving.crt",
+				"--tls-private-key-file", "/etc/serving-cert/serving.key",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := getArgs(tc.settings)
+			if !reflect.DeepEqual(args, tc.expected) {
+				t.Errorf("Expected args %v, got %v", tc.expected, args)
+			}
+		})
+	}
+}
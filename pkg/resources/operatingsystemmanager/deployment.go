@@ -52,6 +52,8 @@ var (
 const (
 	Name = "operating-system-manager"
 	Tag  = "v0.4.2"
+
+	defaultLogLevel int32 = 4
 )
 
 type operatingSystemManagerData interface {
@@ -62,6 +64,8 @@ type operatingSystemManagerData interface {
 	NodeLocalDNSCacheEnabled() bool
 	DC() *kubermaticv1.Datacenter
 	ComputedNodePortRange() string
+	OperatingSystemManagerImageTag() string
+	OperatingSystemManagerImageRepository() string
 }
 
 // DeploymentCreator returns the function to create and update the operating system manager deployment.
@@ -94,6 +98,9 @@ func DeploymentCreatorWithoutInitWrapper(data operatingSystemManagerData) reconc
 			dep.Labels = resources.BaseAppLabels(Name, nil)
 
 			dep.Spec.Replicas = resources.Int32(1)
+			if replicas := data.Cluster().Spec.ComponentsOverride.OperatingSystemManager.Replicas; replicas != nil {
+				dep.Spec.Replicas = replicas
+			}
 			dep.Spec.Selector = &metav1.LabelSelector{
 				MatchLabels: resources.BaseAppLabels(Name, nil),
 			}
@@ -115,7 +122,7 @@ func DeploymentCreatorWithoutInitWrapper(data operatingSystemManagerData) reconc
 				},
 			}
 
-			clusterDNSIP := resources.NodeLocalDNSCacheAddress
+			clusterDNSIP := resources.UserClusterNodeLocalDNSCacheAddress(data.Cluster())
 			if !data.NodeLocalDNSCacheEnabled() {
 				clusterDNSIP, err = resources.UserClusterDNSResolverIP(data.Cluster())
 				if err != nil {
@@ -131,6 +138,13 @@ func DeploymentCreatorWithoutInitWrapper(data operatingSystemManagerData) reconc
 			dep.Spec.Template.Spec.InitContainers = []corev1.Container{}
 
 			repository := data.ImageRegistry(resources.RegistryQuay) + "/kubermatic/operating-system-manager"
+			if r := data.OperatingSystemManagerImageRepository(); r != "" {
+				repository = r
+			}
+			tag := Tag
+			if t := data.OperatingSystemManagerImageTag(); t != "" {
+				tag = t
+			}
 
 			cloudProviderName, err := provider.ClusterCloudProviderName(data.Cluster().Spec.Cloud)
 			if err != nil {
@@ -154,9 +168,9 @@ func DeploymentCreatorWithoutInitWrapper(data operatingSystemManagerData) reconc
 			dep.Spec.Template.Spec.Containers = []corev1.Container{
 				{
 					Name:    Name,
-					Image:   repository + ":" + Tag,
+					Image:   repository + ":" + tag,
 					Command: []string{"/usr/local/bin/osm-controller"},
-					Args:    getFlags(data.DC().Node, cs, data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureExternalCloudProvider]),
+					Args:    getFlags(data.DC().Node, cs, data.Cluster().Spec.Features[kubermaticv1.ClusterFeatureExternalCloudProvider], *dep.Spec.Replicas, data.Cluster().Spec.ComponentsOverride.OperatingSystemManager),
 					Env:     envVars,
 					LivenessProbe: &corev1.Probe{
 						ProbeHandler: corev1.ProbeHandler{
@@ -198,7 +212,7 @@ func DeploymentCreatorWithoutInitWrapper(data operatingSystemManagerData) reconc
 
 			dep.Spec.Template.Spec.ServiceAccountName = serviceAccountName
 
-			err = resources.SetResourceRequirements(dep.Spec.Template.Spec.Containers, controllerResourceRequirements, nil, dep.Annotations)
+			err = resources.SetResourceRequirements(dep.Spec.Template.Spec.Containers, controllerResourceRequirements, resources.GetOverrides(data.Cluster().Spec.ComponentsOverride), dep.Annotations)
 			if err != nil {
 				return nil, fmt.Errorf("failed to set resource requirements: %w", err)
 			}
@@ -217,12 +231,17 @@ type clusterSpec struct {
 	podCidr          string
 }
 
-func getFlags(nodeSettings *kubermaticv1.NodeSettings, cs *clusterSpec, externalCloudProvider bool) []string {
+func getFlags(nodeSettings *kubermaticv1.NodeSettings, cs *clusterSpec, externalCloudProvider bool, replicas int32, settings kubermaticv1.OperatingSystemManagerSettings) []string {
+	logLevel := defaultLogLevel
+	if settings.LogLevel != nil {
+		logLevel = *settings.LogLevel
+	}
+
 	flags := []string{
 		"-worker-cluster-kubeconfig", "/etc/kubernetes/worker-kubeconfig/kubeconfig",
 		"-cluster-dns", cs.clusterDNSIP,
 		"-logtostderr",
-		"-v", "4",
+		"-v", fmt.Sprintf("%d", logLevel),
 		"-health-probe-address", "0.0.0.0:8085",
 		"-metrics-address", "0.0.0.0:8080",
 		"-namespace", fmt.Sprintf("%s-%s", "cluster", cs.Name),
@@ -232,6 +251,11 @@ func getFlags(nodeSettings *kubermaticv1.NodeSettings, cs *clusterSpec, external
 		flags = append(flags, "-external-cloud-provider")
 	}
 
+	// the osm-controller enables leader election by default, so the flag is always set
+	// explicitly; multiple replicas must never reconcile at the same time
+	leaderElect := replicas > 1 || settings.LeaderElection == nil || *settings.LeaderElection
+	flags = append(flags, fmt.Sprintf("-leader-elect=%t", leaderElect))
+
 	if nodeSettings != nil {
 		if !nodeSettings.HTTPProxy.Empty() {
 			flags = append(flags, "-node-http-proxy", nodeSettings.HTTPProxy.String())
@@ -274,11 +298,21 @@ func getEnvVars(data operatingSystemManagerData) ([]corev1.EnvVar, error) {
 		vars = append(vars, corev1.EnvVar{Name: "OS_APPLICATION_CREDENTIAL_ID", Value: credentials.Openstack.ApplicationCredentialID})
 		vars = append(vars, corev1.EnvVar{Name: "OS_APPLICATION_CREDENTIAL_SECRET", Value: credentials.Openstack.ApplicationCredentialSecret})
 	}
+	if data.Cluster().Spec.Cloud.Hetzner != nil {
+		vars = append(vars, corev1.EnvVar{Name: "HZ_TOKEN", Value: credentials.Hetzner.Token})
+	}
+	if data.Cluster().Spec.Cloud.Digitalocean != nil {
+		vars = append(vars, corev1.EnvVar{Name: "DO_TOKEN", Value: credentials.Digitalocean.Token})
+	}
 	if data.Cluster().Spec.Cloud.VSphere != nil {
 		vars = append(vars, corev1.EnvVar{Name: "VSPHERE_ADDRESS", Value: data.DC().Spec.VSphere.Endpoint})
 		vars = append(vars, corev1.EnvVar{Name: "VSPHERE_USERNAME", Value: credentials.VSphere.Username})
 		vars = append(vars, corev1.EnvVar{Name: "VSPHERE_PASSWORD", Value: credentials.VSphere.Password})
 	}
+	if data.Cluster().Spec.Cloud.Packet != nil {
+		vars = append(vars, corev1.EnvVar{Name: "PACKET_API_KEY", Value: credentials.Packet.APIKey})
+		vars = append(vars, corev1.EnvVar{Name: "PACKET_PROJECT_ID", Value: credentials.Packet.ProjectID})
+	}
 	if data.Cluster().Spec.Cloud.GCP != nil {
 		vars = append(vars, corev1.EnvVar{Name: "GOOGLE_SERVICE_ACCOUNT", Value: credentials.GCP.ServiceAccount})
 	}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operatingsystemmanager
+
+import (
+	"fmt"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
+)
+
+func TestGetFlags(t *testing.T) {
+	testCases := []struct {
+		name                string
+		replicas            int32
+		settings            kubermaticv1.OperatingSystemManagerSettings
+		expectedLogLevel    string
+		expectLeaderElected bool
+	}{
+		{
+			name:                "defaults",
+			replicas:            1,
+			expectedLogLevel:    "4",
+			expectLeaderElected: true,
+		},
+		{
+			name:                "multiple replicas",
+			replicas:            3,
+			expectedLogLevel:    "4",
+			expectLeaderElected: true,
+		},
+		{
+			name:     "custom log level",
+			replicas: 1,
+			settings: kubermaticv1.OperatingSystemManagerSettings{
+				LogLevel: pointer.Int32(6),
+			},
+			expectedLogLevel:    "6",
+			expectLeaderElected: true,
+		},
+		{
+			name:     "leader election disabled for a single replica",
+			replicas: 1,
+			settings: kubermaticv1.OperatingSystemManagerSettings{
+				LeaderElection: pointer.Bool(false),
+			},
+			expectedLogLevel:    "4",
+			expectLeaderElected: false,
+		},
+		{
+			name:     "leader election enabled for a single replica",
+			replicas: 1,
+			settings: kubermaticv1.OperatingSystemManagerSettings{
+				LeaderElection: pointer.Bool(true),
+			},
+			expectedLogLevel:    "4",
+			expectLeaderElected: true,
+		},
+		{
+			name:     "leader election cannot be disabled for multiple replicas",
+			replicas: 2,
+			settings: kubermaticv1.OperatingSystemManagerSettings{
+				LogLevel:       pointer.Int32(2),
+				LeaderElection: pointer.Bool(false),
+			},
+			expectedLogLevel:    "2",
+			expectLeaderElected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := &clusterSpec{
+				Name:         "test-cluster",
+				clusterDNSIP: "10.240.16.10",
+			}
+
+			flags := getFlags(nil, cs, false, tc.replicas, tc.settings)
+
+			logLevel := ""
+			for i, flag := range flags {
+				if flag == "-v" && i+1 < len(flags) {
+					logLevel = flags[i+1]
+				}
+			}
+			if logLevel != tc.expectedLogLevel {
+				t.Errorf("Expected log level %q, got %q", tc.expectedLogLevel, logLevel)
+			}
+
+			expectedFlag := fmt.Sprintf("-leader-elect=%t", tc.expectLeaderElected)
+			if !sets.NewString(flags...).Has(expectedFlag) {
+				t.Errorf("Expected flag %q to be set, got flags %v", expectedFlag, flags)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 	k8cequality "k8c.io/kubermatic/v2/pkg/apis/equality"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
@@ -61,6 +62,30 @@ func DeepEqual(a, b metav1.Object) bool {
 	return false
 }
 
+// ServiceEqual compares both Services for equality, ignoring the cluster IPs and node ports
+// which the API server assigns if the generated Service does not specify them.
+func ServiceEqual(generated, existing *corev1.Service) bool {
+	generated = generated.DeepCopy()
+
+	if generated.Spec.ClusterIP == "" {
+		generated.Spec.ClusterIP = existing.Spec.ClusterIP
+	}
+	if len(generated.Spec.ClusterIPs) == 0 {
+		generated.Spec.ClusterIPs = existing.Spec.ClusterIPs
+	}
+
+	for i, port := range generated.Spec.Ports {
+		if port.NodePort != 0 || i >= len(existing.Spec.Ports) {
+			continue
+		}
+		if existingPort := existing.Spec.Ports[i]; existingPort.Port == port.Port && existingPort.Protocol == port.Protocol {
+			generated.Spec.Ports[i].NodePort = existingPort.NodePort
+		}
+	}
+
+	return DeepEqual(generated, existing)
+}
+
 func jsonEqual(a, b interface{}) bool {
 	aJSON, err := json.Marshal(a)
 	if err != nil {
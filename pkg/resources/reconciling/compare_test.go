@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciling
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceEqual(t *testing.T) {
+	existing := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Spec: corev1.ServiceSpec{
+			Type:       corev1.ServiceTypeNodePort,
+			ClusterIP:  "10.10.10.10",
+			ClusterIPs: []string{"10.10.10.10"},
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "https",
+					Port:     443,
+					Protocol: corev1.ProtocolTCP,
+					NodePort: 32000,
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		generated func(*corev1.Service)
+		equal     bool
+	}{
+		{
+			name:      "identical Services",
+			generated: func(*corev1.Service) {},
+			equal:     true,
+		},
+		{
+			name: "server-populated fields are not set",
+			generated: func(s *corev1.Service) {
+				s.Spec.ClusterIP = ""
+				s.Spec.ClusterIPs = nil
+				s.Spec.Ports[0].NodePort = 0
+			},
+			equal: true,
+		},
+		{
+			name: "different explicit node port",
+			generated: func(s *corev1.Service) {
+				s.Spec.Ports[0].NodePort = 32001
+			},
+			equal: false,
+		},
+		{
+			name: "different port",
+			generated: func(s *corev1.Service) {
+				s.Spec.Ports[0].Port = 8443
+				s.Spec.Ports[0].NodePort = 0
+			},
+			equal: false,
+		},
+		{
+			name: "different type",
+			generated: func(s *corev1.Service) {
+				s.Spec.Type = corev1.ServiceTypeClusterIP
+				s.Spec.ClusterIP = ""
+			},
+			equal: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			generated := existing.DeepCopy()
+			test.generated(generated)
+
+			if equal := ServiceEqual(generated, existing); equal != test.equal {
+				t.Errorf("Expected ServiceEqual to return %v, got %v", test.equal, equal)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciling
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-test/deep"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DryRunOperation is the kind of change a dry-run reconciliation would have applied.
+type DryRunOperation string
+
+const (
+	DryRunCreate   DryRunOperation = "create"
+	DryRunUpdate   DryRunOperation = "update"
+	DryRunRecreate DryRunOperation = "recreate"
+	DryRunDelete   DryRunOperation = "delete"
+)
+
+// DryRunChange describes a single change that was not applied because of a dry-run.
+type DryRunChange struct {
+	Operation DryRunOperation
+	Kind      string
+	Object    types.NamespacedName
+	// Diff is the difference between the existing and the would-be object, only set for updates.
+	Diff []string
+}
+
+type dryRunContextKey struct{}
+
+type dryRunChanges struct {
+	lock    sync.Mutex
+	changes []DryRunChange
+}
+
+// WithDryRun returns a context that makes all Ensure*/Reconcile*/Delete* functions of this
+// package only send dry-run requests to the API server. The changes that would have been
+// made are collected and can be retrieved using DryRunChanges.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunContextKey{}, &dryRunChanges{})
+}
+
+// IsDryRun returns true if the context was created using WithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	return getDryRunChanges(ctx) != nil
+}
+
+// DryRunChanges returns all changes that were skipped in the given dry-run context.
+func DryRunChanges(ctx context.Context) []DryRunChange {
+	state := getDryRunChanges(ctx)
+	if state == nil {
+		return nil
+	}
+
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	return append([]DryRunChange{}, state.changes...)
+}
+
+func getDryRunChanges(ctx context.Context) *dryRunChanges {
+	state, _ := ctx.Value(dryRunContextKey{}).(*dryRunChanges)
+	return state
+}
+
+func recordDryRunChange(ctx context.Context, operation DryRunOperation, obj ctrlruntimeclient.Object, diff []string) {
+	change := DryRunChange{
+		Operation: operation,
+		Kind:      fmt.Sprintf("%T", obj),
+		Object:    types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()},
+		Diff:      diff,
+	}
+
+	objectLogger(obj).Infow("dry-run: skipped changing resource", "operation", operation, "diff", diff)
+
+	state := getDryRunChanges(ctx)
+	state.lock.Lock()
+	defer state.lock.Unlock()
+
+	state.changes = append(state.changes, change)
+}
+
+// dryRunDiff returns the difference between the existing object and the object the API server
+// returned for the dry-run request, ignoring the managed fields which always change. For Secrets
+// only the paths of the changed fields are returned, so no secret values end up in the logs.
+func dryRunDiff(existing, updated ctrlruntimeclient.Object) []string {
+	existing = existing.DeepCopyObject().(ctrlruntimeclient.Object)
+	updated = updated.DeepCopyObject().(ctrlruntimeclient.Object)
+
+	existing.SetManagedFields(nil)
+	updated.SetManagedFields(nil)
+
+	diff := deep.Equal(existing, updated)
+
+	if _, isSecret := updated.(*corev1.Secret); isSecret {
+		for i, line := range diff {
+			// deep.Equal reports changes as "<path>: <old> != <new>"
+			if idx := strings.Index(line, ": "); idx >= 0 {
+				diff[i] = line[:idx] + ": <redacted>"
+			}
+		}
+	}
+
+	return diff
+}
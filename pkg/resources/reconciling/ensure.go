@@ -75,8 +75,23 @@ func objectLogger(obj ctrlruntimeclient.Object) *zap.SugaredLogger {
 	return logger.With("name", obj.GetName())
 }
 
+// EqualityFunc decides whether the generated object matches the existing one, so no update is required.
+type EqualityFunc = func(generated, existing ctrlruntimeclient.Object) bool
+
 // EnsureNamedObject will generate the Object with the passed create function & create or update it in Kubernetes if necessary.
 func EnsureNamedObject(ctx context.Context, namespacedName types.NamespacedName, rawcreate ObjectCreator, client ctrlruntimeclient.Client, emptyObject ctrlruntimeclient.Object, requiresRecreate bool) error {
+	return EnsureNamedObjectWithEquality(ctx, namespacedName, rawcreate, client, emptyObject, requiresRecreate, nil)
+}
+
+// EnsureNamedObjectWithEquality works like EnsureNamedObject, but uses the given EqualityFunc to determine
+// whether the existing object needs to be updated. If equal is nil, DeepEqual is used.
+func EnsureNamedObjectWithEquality(ctx context.Context, namespacedName types.NamespacedName, rawcreate ObjectCreator, client ctrlruntimeclient.Client, emptyObject ctrlruntimeclient.Object, requiresRecreate bool, equal EqualityFunc) error {
+	if equal == nil {
+		equal = func(generated, existing ctrlruntimeclient.Object) bool {
+			return DeepEqual(generated, existing)
+		}
+	}
+
 	// A wrapper to ensure we always set the Namespace and Name. This is useful as we call create twice
 	create := createWithNamespace(rawcreate, namespacedName.Namespace)
 	create = createWithName(create, namespacedName.Name)
@@ -96,6 +111,13 @@ func EnsureNamedObject(ctx context.Context, namespacedName types.NamespacedName,
 		if err != nil {
 			return fmt.Errorf("failed to generate object: %w", err)
 		}
+		if IsDryRun(ctx) {
+			if err := client.Create(ctx, obj, ctrlruntimeclient.DryRunAll); err != nil {
+				return fmt.Errorf("failed to create %T '%s' (dry-run): %w", obj, namespacedName.String(), err)
+			}
+			recordDryRunChange(ctx, DryRunCreate, obj, nil)
+			return nil
+		}
 		if err := client.Create(ctx, obj); err != nil {
 			return fmt.Errorf("failed to create %T '%s': %w", obj, namespacedName.String(), err)
 		}
@@ -117,7 +139,25 @@ func EnsureNamedObject(ctx context.Context, namespacedName types.NamespacedName,
 		return fmt.Errorf("failed to build Object(%T) '%s': %w", existingObject, namespacedName.String(), err)
 	}
 
-	if DeepEqual(obj.(metav1.Object), existingObject.(metav1.Object)) {
+	if equal(obj, existingObject) {
+		return nil
+	}
+
+	if IsDryRun(ctx) {
+		// recreating cannot be dry-run, so only the client-side difference is known
+		if requiresRecreate {
+			recordDryRunChange(ctx, DryRunRecreate, obj, dryRunDiff(existingObject, obj))
+			return nil
+		}
+
+		if err := client.Update(ctx, obj, ctrlruntimeclient.DryRunAll); err != nil {
+			return fmt.Errorf("failed to update object %T %q (dry-run): %w", obj, namespacedName.String(), err)
+		}
+
+		// the API server might have defaulted all differences away
+		if diff := dryRunDiff(existingObject, obj); len(diff) > 0 {
+			recordDryRunChange(ctx, DryRunUpdate, obj, diff)
+		}
 		return nil
 	}
 
@@ -160,6 +200,99 @@ func EnsureNamedObject(ctx context.Context, namespacedName types.NamespacedName,
 	return nil
 }
 
+// ReconcileObjects will create and update the objects coming from the passed creator getters. It is the
+// shared implementation behind the generated, typed Reconcile* functions. emptyObject must be a pointer to
+// an empty object of the reconciled type and the optional defaulting function is applied to each creator.
+// The optional equality function replaces DeepEqual when deciding whether an object needs an update.
+// For cluster-scoped resources namespace must be empty; no namespace is set on the objects then.
+func ReconcileObjects[T ctrlruntimeclient.Object](
+	ctx context.Context,
+	namedGetters []func() (string, func(T) (T, error)),
+	namespace string,
+	client ctrlruntimeclient.Client,
+	emptyObject T,
+	requiresRecreate bool,
+	defaulting func(func(T) (T, error)) func(T) (T, error),
+	equal func(generated, existing T) bool,
+	objectModifiers ...ObjectModifier,
+) error {
+	kind := reflect.TypeOf(emptyObject).Elem().Name()
+
+	var equalObjects EqualityFunc
+	if equal != nil {
+		equalObjects = func(generated, existing ctrlruntimeclient.Object) bool {
+			return equal(generated.(T), existing.(T))
+		}
+	}
+
+	for _, get := range namedGetters {
+		name, create := get()
+		if defaulting != nil {
+			create = defaulting(create)
+		}
+
+		createObject := typedObjectWrapper(create, emptyObject)
+		if namespace != metav1.NamespaceNone {
+			createObject = createWithNamespace(createObject, namespace)
+		}
+		createObject = createWithName(createObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			createObject = objectModifier(createObject)
+		}
+
+		if err := EnsureNamedObjectWithEquality(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, emptyObject.DeepCopyObject().(T), requiresRecreate, equalObjects); err != nil {
+			return fmt.Errorf("failed to ensure %s %s/%s: %w", kind, namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
+// DeleteObjects deletes the objects with the given names. It is the shared implementation behind the
+// generated, typed Delete* functions. emptyObject must be a pointer to an empty object of the deleted
+// type. Objects that do not exist are ignored. For cluster-scoped resources namespace must be empty.
+func DeleteObjects[T ctrlruntimeclient.Object](ctx context.Context, names []string, namespace string, client ctrlruntimeclient.Client, emptyObject T) error {
+	kind := reflect.TypeOf(emptyObject).Elem().Name()
+
+	for _, name := range names {
+		obj := emptyObject.DeepCopyObject().(T)
+		obj.SetName(name)
+		obj.SetNamespace(namespace)
+
+		var opts []ctrlruntimeclient.DeleteOption
+		if IsDryRun(ctx) {
+			opts = append(opts, ctrlruntimeclient.DryRunAll)
+		}
+
+		if err := client.Delete(ctx, obj, opts...); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to delete %s %s/%s: %w", kind, namespace, name, err)
+		}
+
+		if IsDryRun(ctx) {
+			recordDryRunChange(ctx, DryRunDelete, obj, nil)
+			continue
+		}
+
+		objectLogger(obj).Info("deleted resource")
+	}
+
+	return nil
+}
+
+// typedObjectWrapper adds a wrapper so a typed creator matches ObjectCreator.
+func typedObjectWrapper[T ctrlruntimeclient.Object](create func(T) (T, error), emptyObject T) ObjectCreator {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return create(existing.(T))
+		}
+		return create(emptyObject.DeepCopyObject().(T))
+	}
+}
+
 func waitUntilUpdateIsInCacheConditionFunc(
 	ctx context.Context,
 	client ctrlruntimeclient.Client,
@@ -18,11 +18,14 @@ package reconciling
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/go-test/deep"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -227,3 +230,194 @@ func TestEnsureObjectByAnnotation(t *testing.T) {
 		})
 	}
 }
+
+func TestReconcileObjects(t *testing.T) {
+	const testNamespace = "default"
+
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing",
+			Namespace: testNamespace,
+		},
+		Data: map[string]string{
+			"foo": "hopefully-gets-overwritten",
+		},
+	}
+
+	creatorGetter := func(name string) func() (string, func(*corev1.ConfigMap) (*corev1.ConfigMap, error)) {
+		return func() (string, func(*corev1.ConfigMap) (*corev1.ConfigMap, error)) {
+			return name, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+				cm.Data = map[string]string{"foo": "bar"}
+				return cm, nil
+			}
+		}
+	}
+
+	defaulting := func(create func(*corev1.ConfigMap) (*corev1.ConfigMap, error)) func(*corev1.ConfigMap) (*corev1.ConfigMap, error) {
+		return func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			cm, err := create(cm)
+			if err != nil {
+				return nil, err
+			}
+			cm.Data["defaulted"] = "true"
+			return cm, nil
+		}
+	}
+
+	client := fakectrlruntimeclient.NewClientBuilder().WithObjects(existing).Build()
+	ctx := context.Background()
+
+	getters := []func() (string, func(*corev1.ConfigMap) (*corev1.ConfigMap, error)){
+		creatorGetter("existing"),
+		creatorGetter("new"),
+	}
+	if err := ReconcileObjects(ctx, getters, testNamespace, client, &corev1.ConfigMap{}, false, defaulting, nil); err != nil {
+		t.Fatalf("ReconcileObjects returned an error while none was expected: %v", err)
+	}
+
+	expectedData := map[string]string{"foo": "bar", "defaulted": "true"}
+	for _, name := range []string{"existing", "new"} {
+		got := &corev1.ConfigMap{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: name}, got); err != nil {
+			t.Fatalf("Failed to get ConfigMap %q from the client: %v", name, err)
+		}
+		if diff := deep.Equal(got.Data, expectedData); diff != nil {
+			t.Errorf("ConfigMap %q does not contain the expected data. Diff: \n%v", name, diff)
+		}
+	}
+}
+
+func TestReconcileClusterScopedObjects(t *testing.T) {
+	client := fakectrlruntimeclient.NewClientBuilder().Build()
+	ctx := context.Background()
+
+	creators := []NamedClusterRoleCreatorGetter{
+		func() (string, ClusterRoleCreator) {
+			return "test", func(cr *rbacv1.ClusterRole) (*rbacv1.ClusterRole, error) {
+				cr.Labels = map[string]string{"foo": "bar"}
+				return cr, nil
+			}
+		},
+	}
+	if err := ReconcileClusterRoles(ctx, creators, client); err != nil {
+		t.Fatalf("ReconcileClusterRoles returned an error while none was expected: %v", err)
+	}
+
+	got := &rbacv1.ClusterRole{}
+	if err := client.Get(ctx, types.NamespacedName{Name: "test"}, got); err != nil {
+		t.Fatalf("Failed to get the ClusterRole from the client: %v", err)
+	}
+	if got.Namespace != "" {
+		t.Errorf("Expected cluster-scoped object to have no namespace, got %q", got.Namespace)
+	}
+}
+
+func TestDeleteObjects(t *testing.T) {
+	const testNamespace = "default"
+
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing",
+			Namespace: testNamespace,
+		},
+	}
+	unrelated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unrelated",
+			Namespace: testNamespace,
+		},
+	}
+
+	client := fakectrlruntimeclient.NewClientBuilder().WithObjects(existing, unrelated).Build()
+	ctx := context.Background()
+
+	if err := DeleteSecrets(ctx, []string{"existing", "does-not-exist"}, testNamespace, client); err != nil {
+		t.Fatalf("DeleteSecrets returned an error while none was expected: %v", err)
+	}
+
+	if err := client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "existing"}, &corev1.Secret{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected Secret to be deleted, but got: %v", err)
+	}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "unrelated"}, &corev1.Secret{}); err != nil {
+		t.Errorf("Expected unrelated Secret to be kept, but got: %v", err)
+	}
+}
+
+func TestReconcileObjectsDryRun(t *testing.T) {
+	const testNamespace = "default"
+
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "existing",
+			Namespace: testNamespace,
+		},
+		Data: map[string]string{
+			"foo": "must-not-be-overwritten",
+		},
+	}
+
+	creatorGetter := func(name string) func() (string, func(*corev1.ConfigMap) (*corev1.ConfigMap, error)) {
+		return func() (string, func(*corev1.ConfigMap) (*corev1.ConfigMap, error)) {
+			return name, func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+				cm.Data = map[string]string{"foo": "bar"}
+				return cm, nil
+			}
+		}
+	}
+
+	client := fakectrlruntimeclient.NewClientBuilder().WithObjects(existing).Build()
+	ctx := WithDryRun(context.Background())
+
+	getters := []func() (string, func(*corev1.ConfigMap) (*corev1.ConfigMap, error)){
+		creatorGetter("existing"),
+		creatorGetter("new"),
+	}
+	if err := ReconcileObjects(ctx, getters, testNamespace, client, &corev1.ConfigMap{}, false, nil, nil); err != nil {
+		t.Fatalf("ReconcileObjects returned an error while none was expected: %v", err)
+	}
+
+	got := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "existing"}, got); err != nil {
+		t.Fatalf("Failed to get ConfigMap from the client: %v", err)
+	}
+	if diff := deep.Equal(got.Data, existing.Data); diff != nil {
+		t.Errorf("ConfigMap was modified during dry-run. Diff: \n%v", diff)
+	}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "new"}, &corev1.ConfigMap{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Expected ConfigMap to not be created during dry-run, but got: %v", err)
+	}
+
+	var operations []DryRunOperation
+	for _, change := range DryRunChanges(ctx) {
+		operations = append(operations, change.Operation)
+	}
+	if diff := deep.Equal(operations, []DryRunOperation{DryRunUpdate, DryRunCreate}); diff != nil {
+		t.Errorf("Dry-run did not record the expected changes. Diff: \n%v", diff)
+	}
+}
+
+func TestDryRunDiffRedactsSecrets(t *testing.T) {
+	existing := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"key": []byte("old-secret-value"),
+		},
+	}
+
+	updated := existing.DeepCopy()
+	updated.Data["key"] = []byte("new-secret-value")
+
+	diff := dryRunDiff(existing, updated)
+	if len(diff) == 0 {
+		t.Fatal("Expected the changed Secret to be reported")
+	}
+
+	for _, line := range diff {
+		if !strings.HasSuffix(line, ": <redacted>") {
+			t.Errorf("Expected secret values to be redacted, got %q", line)
+		}
+	}
+}
@@ -23,10 +23,12 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	utilpointer "k8s.io/utils/pointer"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // OwnerRefWrapper is responsible for wrapping a ObjectCreator function, solely to set the OwnerReference to the cluster object.
@@ -44,6 +46,26 @@ func OwnerRefWrapper(ref metav1.OwnerReference) ObjectModifier {
 	}
 }
 
+// OwnerRefModifier returns an ObjectModifier that sets a controller owner reference pointing
+// to the given owner on the created object. Other owner references are kept. The scheme is
+// used to determine the owner's GroupVersionKind.
+func OwnerRefModifier(owner metav1.Object, scheme *runtime.Scheme) ObjectModifier {
+	return func(create ObjectCreator) ObjectCreator {
+		return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+			obj, err := create(existing)
+			if err != nil {
+				return obj, err
+			}
+
+			if err := controllerutil.SetControllerReference(owner, obj, scheme); err != nil {
+				return nil, fmt.Errorf("failed to set owner reference: %w", err)
+			}
+
+			return obj, nil
+		}
+	}
+}
+
 // ImagePullSecretsWrapper is generating a new ObjectModifier that wraps an ObjectCreator
 // and takes care of adding the secret names provided to the ImagePullSecrets.
 //
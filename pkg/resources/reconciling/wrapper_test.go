@@ -29,8 +29,10 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/pointer"
 	utilpointer "k8s.io/utils/pointer"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -934,6 +936,96 @@ func TestImagePullSecretsWrapper(t *testing.T) {
 	}
 }
 
+func TestOwnerRefModifier(t *testing.T) {
+	const namespace = "default"
+
+	owner := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "owner",
+			Namespace: namespace,
+			UID:       "owner-uid",
+		},
+	}
+
+	otherRef := metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Name:       "other",
+		UID:        "other-uid",
+	}
+
+	creatorGetter := func() (string, ConfigMapCreator) {
+		return "test", func(cm *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+			cm.Data = map[string]string{"foo": "bar"}
+			return cm, nil
+		}
+	}
+
+	tests := []struct {
+		name           string
+		existingObject ctrlruntimeclient.Object
+		wantRefs       int
+	}{
+		{
+			name:     "owner reference is set on create",
+			wantRefs: 1,
+		},
+		{
+			name: "owner reference is preserved on update",
+			existingObject: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "test",
+					Namespace:       namespace,
+					OwnerReferences: []metav1.OwnerReference{otherRef},
+				},
+				Data: map[string]string{"foo": "outdated"},
+			},
+			wantRefs: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientBuilder := controllerruntimefake.NewClientBuilder()
+			if tt.existingObject != nil {
+				clientBuilder.WithObjects(tt.existingObject)
+			}
+			client := clientBuilder.Build()
+			ctx := context.Background()
+
+			getters := []NamedConfigMapCreatorGetter{creatorGetter}
+			modifier := OwnerRefModifier(owner, scheme.Scheme)
+
+			// reconcile twice to ensure that subsequent updates keep the reference
+			for i := 0; i < 2; i++ {
+				if err := ReconcileConfigMaps(ctx, getters, namespace, client, modifier); err != nil {
+					t.Fatalf("Failed to reconcile: %v", err)
+				}
+			}
+
+			cm := &corev1.ConfigMap{}
+			if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: "test"}, cm); err != nil {
+				t.Fatalf("Failed to get ConfigMap: %v", err)
+			}
+
+			if len(cm.OwnerReferences) != tt.wantRefs {
+				t.Fatalf("Expected %d owner references, got: %v", tt.wantRefs, cm.OwnerReferences)
+			}
+
+			ref := metav1.GetControllerOf(cm)
+			if ref == nil {
+				t.Fatal("Expected a controller owner reference, got none")
+			}
+			if ref.UID != owner.UID || ref.Kind != "Secret" || ref.Name != owner.Name {
+				t.Errorf("Expected controller reference to point to the owner, got: %+v", ref)
+			}
+			if cm.Data["foo"] != "bar" {
+				t.Errorf("Expected ConfigMap to be updated, got data: %v", cm.Data)
+			}
+		})
+	}
+}
+
 // identityCreator is an ObjectModifier that returns the input object
 // untouched.
 // TODO May be useful to move this in a test package?
@@ -3,12 +3,12 @@ package reconciling
 
 import (
 	"context"
-	"fmt"
 
-	"k8s.io/apimachinery/pkg/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	gatekeeperv1 "github.com/open-policy-agent/frameworks/constraint/pkg/apis/templates/v1"
+	gatekeeperconfigv1alpha1 "github.com/open-policy-agent/gatekeeper/apis/config/v1alpha1"
 	appskubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/apps.kubermatic/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
@@ -44,23 +44,8 @@ func NamespaceObjectWrapper(create NamespaceCreator) ObjectCreator {
 }
 
 // ReconcileNamespaces will create and update the Namespaces coming from the passed NamespaceCreator slice
-func ReconcileNamespaces(ctx context.Context, namedGetters []NamedNamespaceCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := NamespaceObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &corev1.Namespace{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Namespace %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileNamespaces(ctx context.Context, namedGetters []NamedNamespaceCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &corev1.Namespace{}, false, nil, nil, objectModifiers...)
 }
 
 // ServiceCreator defines an interface to create/update Services
@@ -82,22 +67,7 @@ func ServiceObjectWrapper(create ServiceCreator) ObjectCreator {
 
 // ReconcileServices will create and update the Services coming from the passed ServiceCreator slice
 func ReconcileServices(ctx context.Context, namedGetters []NamedServiceCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := ServiceObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &corev1.Service{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Service %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &corev1.Service{}, false, nil, ServiceEqual, objectModifiers...)
 }
 
 // SecretCreator defines an interface to create/update Secrets
@@ -119,22 +89,12 @@ func SecretObjectWrapper(create SecretCreator) ObjectCreator {
 
 // ReconcileSecrets will create and update the Secrets coming from the passed SecretCreator slice
 func ReconcileSecrets(ctx context.Context, namedGetters []NamedSecretCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := SecretObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &corev1.Secret{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Secret %s/%s: %w", namespace, name, err)
-		}
-	}
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &corev1.Secret{}, false, nil, nil, objectModifiers...)
+}
 
-	return nil
+// DeleteSecrets will delete the Secrets with the passed names, ignoring those that do not exist
+func DeleteSecrets(ctx context.Context, names []string, namespace string, client ctrlruntimeclient.Client) error {
+	return DeleteObjects(ctx, names, namespace, client, &corev1.Secret{})
 }
 
 // ConfigMapCreator defines an interface to create/update ConfigMaps
@@ -156,22 +116,7 @@ func ConfigMapObjectWrapper(create ConfigMapCreator) ObjectCreator {
 
 // ReconcileConfigMaps will create and update the ConfigMaps coming from the passed ConfigMapCreator slice
 func ReconcileConfigMaps(ctx context.Context, namedGetters []NamedConfigMapCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := ConfigMapObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &corev1.ConfigMap{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ConfigMap %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &corev1.ConfigMap{}, false, nil, nil, objectModifiers...)
 }
 
 // ServiceAccountCreator defines an interface to create/update ServiceAccounts
@@ -193,22 +138,7 @@ func ServiceAccountObjectWrapper(create ServiceAccountCreator) ObjectCreator {
 
 // ReconcileServiceAccounts will create and update the ServiceAccounts coming from the passed ServiceAccountCreator slice
 func ReconcileServiceAccounts(ctx context.Context, namedGetters []NamedServiceAccountCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := ServiceAccountObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &corev1.ServiceAccount{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ServiceAccount %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &corev1.ServiceAccount{}, false, nil, nil, objectModifiers...)
 }
 
 // EndpointsCreator defines an interface to create/update Endpointss
@@ -230,22 +160,7 @@ func EndpointsObjectWrapper(create EndpointsCreator) ObjectCreator {
 
 // ReconcileEndpoints will create and update the Endpoints coming from the passed EndpointsCreator slice
 func ReconcileEndpoints(ctx context.Context, namedGetters []NamedEndpointsCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := EndpointsObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &corev1.Endpoints{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Endpoints %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &corev1.Endpoints{}, false, nil, nil, objectModifiers...)
 }
 
 // EndpointSliceCreator defines an interface to create/update EndpointSlices
@@ -267,22 +182,7 @@ func EndpointSliceObjectWrapper(create EndpointSliceCreator) ObjectCreator {
 
 // ReconcileEndpointSlices will create and update the EndpointSlices coming from the passed EndpointSliceCreator slice
 func ReconcileEndpointSlices(ctx context.Context, namedGetters []NamedEndpointSliceCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := EndpointSliceObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &discovery.EndpointSlice{}, false); err != nil {
-			return fmt.Errorf("failed to ensure EndpointSlice %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &discovery.EndpointSlice{}, false, nil, nil, objectModifiers...)
 }
 
 // StatefulSetCreator defines an interface to create/update StatefulSets
@@ -304,23 +204,7 @@ func StatefulSetObjectWrapper(create StatefulSetCreator) ObjectCreator {
 
 // ReconcileStatefulSets will create and update the StatefulSets coming from the passed StatefulSetCreator slice
 func ReconcileStatefulSets(ctx context.Context, namedGetters []NamedStatefulSetCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		create = DefaultStatefulSet(create)
-		createObject := StatefulSetObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &appsv1.StatefulSet{}, false); err != nil {
-			return fmt.Errorf("failed to ensure StatefulSet %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &appsv1.StatefulSet{}, false, DefaultStatefulSet, nil, objectModifiers...)
 }
 
 // DeploymentCreator defines an interface to create/update Deployments
@@ -342,23 +226,7 @@ func DeploymentObjectWrapper(create DeploymentCreator) ObjectCreator {
 
 // ReconcileDeployments will create and update the Deployments coming from the passed DeploymentCreator slice
 func ReconcileDeployments(ctx context.Context, namedGetters []NamedDeploymentCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		create = DefaultDeployment(create)
-		createObject := DeploymentObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &appsv1.Deployment{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Deployment %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &appsv1.Deployment{}, false, DefaultDeployment, nil, objectModifiers...)
 }
 
 // DaemonSetCreator defines an interface to create/update DaemonSets
@@ -380,23 +248,7 @@ func DaemonSetObjectWrapper(create DaemonSetCreator) ObjectCreator {
 
 // ReconcileDaemonSets will create and update the DaemonSets coming from the passed DaemonSetCreator slice
 func ReconcileDaemonSets(ctx context.Context, namedGetters []NamedDaemonSetCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		create = DefaultDaemonSet(create)
-		createObject := DaemonSetObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &appsv1.DaemonSet{}, false); err != nil {
-			return fmt.Errorf("failed to ensure DaemonSet %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &appsv1.DaemonSet{}, false, DefaultDaemonSet, nil, objectModifiers...)
 }
 
 // PodDisruptionBudgetCreator defines an interface to create/update PodDisruptionBudgets
@@ -418,22 +270,7 @@ func PodDisruptionBudgetObjectWrapper(create PodDisruptionBudgetCreator) ObjectC
 
 // ReconcilePodDisruptionBudgets will create and update the PodDisruptionBudgets coming from the passed PodDisruptionBudgetCreator slice
 func ReconcilePodDisruptionBudgets(ctx context.Context, namedGetters []NamedPodDisruptionBudgetCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := PodDisruptionBudgetObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &policyv1beta1.PodDisruptionBudget{}, true); err != nil {
-			return fmt.Errorf("failed to ensure PodDisruptionBudget %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &policyv1beta1.PodDisruptionBudget{}, true, nil, nil, objectModifiers...)
 }
 
 // VerticalPodAutoscalerCreator defines an interface to create/update VerticalPodAutoscalers
@@ -455,22 +292,7 @@ func VerticalPodAutoscalerObjectWrapper(create VerticalPodAutoscalerCreator) Obj
 
 // ReconcileVerticalPodAutoscalers will create and update the VerticalPodAutoscalers coming from the passed VerticalPodAutoscalerCreator slice
 func ReconcileVerticalPodAutoscalers(ctx context.Context, namedGetters []NamedVerticalPodAutoscalerCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := VerticalPodAutoscalerObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &autoscalingv1.VerticalPodAutoscaler{}, false); err != nil {
-			return fmt.Errorf("failed to ensure VerticalPodAutoscaler %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &autoscalingv1.VerticalPodAutoscaler{}, false, nil, nil, objectModifiers...)
 }
 
 // ClusterRoleBindingCreator defines an interface to create/update ClusterRoleBindings
@@ -491,23 +313,8 @@ func ClusterRoleBindingObjectWrapper(create ClusterRoleBindingCreator) ObjectCre
 }
 
 // ReconcileClusterRoleBindings will create and update the ClusterRoleBindings coming from the passed ClusterRoleBindingCreator slice
-func ReconcileClusterRoleBindings(ctx context.Context, namedGetters []NamedClusterRoleBindingCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := ClusterRoleBindingObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &rbacv1.ClusterRoleBinding{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ClusterRoleBinding %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileClusterRoleBindings(ctx context.Context, namedGetters []NamedClusterRoleBindingCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &rbacv1.ClusterRoleBinding{}, false, nil, nil, objectModifiers...)
 }
 
 // ClusterRoleCreator defines an interface to create/update ClusterRoles
@@ -528,23 +335,8 @@ func ClusterRoleObjectWrapper(create ClusterRoleCreator) ObjectCreator {
 }
 
 // ReconcileClusterRoles will create and update the ClusterRoles coming from the passed ClusterRoleCreator slice
-func ReconcileClusterRoles(ctx context.Context, namedGetters []NamedClusterRoleCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := ClusterRoleObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &rbacv1.ClusterRole{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ClusterRole %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileClusterRoles(ctx context.Context, namedGetters []NamedClusterRoleCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &rbacv1.ClusterRole{}, false, nil, nil, objectModifiers...)
 }
 
 // RoleCreator defines an interface to create/update Roles
@@ -566,22 +358,7 @@ func RoleObjectWrapper(create RoleCreator) ObjectCreator {
 
 // ReconcileRoles will create and update the Roles coming from the passed RoleCreator slice
 func ReconcileRoles(ctx context.Context, namedGetters []NamedRoleCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := RoleObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &rbacv1.Role{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Role %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &rbacv1.Role{}, false, nil, nil, objectModifiers...)
 }
 
 // RoleBindingCreator defines an interface to create/update RoleBindings
@@ -603,22 +380,7 @@ func RoleBindingObjectWrapper(create RoleBindingCreator) ObjectCreator {
 
 // ReconcileRoleBindings will create and update the RoleBindings coming from the passed RoleBindingCreator slice
 func ReconcileRoleBindings(ctx context.Context, namedGetters []NamedRoleBindingCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := RoleBindingObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &rbacv1.RoleBinding{}, false); err != nil {
-			return fmt.Errorf("failed to ensure RoleBinding %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &rbacv1.RoleBinding{}, false, nil, nil, objectModifiers...)
 }
 
 // CustomResourceDefinitionCreator defines an interface to create/update CustomResourceDefinitions
@@ -639,23 +401,8 @@ func CustomResourceDefinitionObjectWrapper(create CustomResourceDefinitionCreato
 }
 
 // ReconcileCustomResourceDefinitions will create and update the CustomResourceDefinitions coming from the passed CustomResourceDefinitionCreator slice
-func ReconcileCustomResourceDefinitions(ctx context.Context, namedGetters []NamedCustomResourceDefinitionCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := CustomResourceDefinitionObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &apiextensionsv1.CustomResourceDefinition{}, false); err != nil {
-			return fmt.Errorf("failed to ensure CustomResourceDefinition %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileCustomResourceDefinitions(ctx context.Context, namedGetters []NamedCustomResourceDefinitionCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &apiextensionsv1.CustomResourceDefinition{}, false, nil, nil, objectModifiers...)
 }
 
 // CronJobCreator defines an interface to create/update CronJobs
@@ -677,23 +424,7 @@ func CronJobObjectWrapper(create CronJobCreator) ObjectCreator {
 
 // ReconcileCronJobs will create and update the CronJobs coming from the passed CronJobCreator slice
 func ReconcileCronJobs(ctx context.Context, namedGetters []NamedCronJobCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		create = DefaultCronJob(create)
-		createObject := CronJobObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &batchv1beta1.CronJob{}, false); err != nil {
-			return fmt.Errorf("failed to ensure CronJob %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &batchv1beta1.CronJob{}, false, DefaultCronJob, nil, objectModifiers...)
 }
 
 // MutatingWebhookConfigurationCreator defines an interface to create/update MutatingWebhookConfigurations
@@ -714,23 +445,13 @@ func MutatingWebhookConfigurationObjectWrapper(create MutatingWebhookConfigurati
 }
 
 // ReconcileMutatingWebhookConfigurations will create and update the MutatingWebhookConfigurations coming from the passed MutatingWebhookConfigurationCreator slice
-func ReconcileMutatingWebhookConfigurations(ctx context.Context, namedGetters []NamedMutatingWebhookConfigurationCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := MutatingWebhookConfigurationObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &admissionregistrationv1.MutatingWebhookConfiguration{}, false); err != nil {
-			return fmt.Errorf("failed to ensure MutatingWebhookConfiguration %s/%s: %w", namespace, name, err)
-		}
-	}
+func ReconcileMutatingWebhookConfigurations(ctx context.Context, namedGetters []NamedMutatingWebhookConfigurationCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &admissionregistrationv1.MutatingWebhookConfiguration{}, false, nil, nil, objectModifiers...)
+}
 
-	return nil
+// DeleteMutatingWebhookConfigurations will delete the MutatingWebhookConfigurations with the passed names, ignoring those that do not exist
+func DeleteMutatingWebhookConfigurations(ctx context.Context, names []string, client ctrlruntimeclient.Client) error {
+	return DeleteObjects(ctx, names, metav1.NamespaceNone, client, &admissionregistrationv1.MutatingWebhookConfiguration{})
 }
 
 // ValidatingWebhookConfigurationCreator defines an interface to create/update ValidatingWebhookConfigurations
@@ -751,23 +472,8 @@ func ValidatingWebhookConfigurationObjectWrapper(create ValidatingWebhookConfigu
 }
 
 // ReconcileValidatingWebhookConfigurations will create and update the ValidatingWebhookConfigurations coming from the passed ValidatingWebhookConfigurationCreator slice
-func ReconcileValidatingWebhookConfigurations(ctx context.Context, namedGetters []NamedValidatingWebhookConfigurationCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := ValidatingWebhookConfigurationObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &admissionregistrationv1.ValidatingWebhookConfiguration{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ValidatingWebhookConfiguration %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileValidatingWebhookConfigurations(ctx context.Context, namedGetters []NamedValidatingWebhookConfigurationCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &admissionregistrationv1.ValidatingWebhookConfiguration{}, false, nil, nil, objectModifiers...)
 }
 
 // APIServiceCreator defines an interface to create/update APIServices
@@ -788,23 +494,8 @@ func APIServiceObjectWrapper(create APIServiceCreator) ObjectCreator {
 }
 
 // ReconcileAPIServices will create and update the APIServices coming from the passed APIServiceCreator slice
-func ReconcileAPIServices(ctx context.Context, namedGetters []NamedAPIServiceCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := APIServiceObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &apiregistrationv1.APIService{}, false); err != nil {
-			return fmt.Errorf("failed to ensure APIService %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileAPIServices(ctx context.Context, namedGetters []NamedAPIServiceCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &apiregistrationv1.APIService{}, false, nil, nil, objectModifiers...)
 }
 
 // IngressCreator defines an interface to create/update Ingresss
@@ -826,22 +517,7 @@ func IngressObjectWrapper(create IngressCreator) ObjectCreator {
 
 // ReconcileIngresses will create and update the Ingresses coming from the passed IngressCreator slice
 func ReconcileIngresses(ctx context.Context, namedGetters []NamedIngressCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := IngressObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &networkingv1.Ingress{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Ingress %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &networkingv1.Ingress{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticConfigurationCreator defines an interface to create/update KubermaticConfigurations
@@ -863,22 +539,7 @@ func KubermaticConfigurationObjectWrapper(create KubermaticConfigurationCreator)
 
 // ReconcileKubermaticConfigurations will create and update the KubermaticConfigurations coming from the passed KubermaticConfigurationCreator slice
 func ReconcileKubermaticConfigurations(ctx context.Context, namedGetters []NamedKubermaticConfigurationCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticConfigurationObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.KubermaticConfiguration{}, false); err != nil {
-			return fmt.Errorf("failed to ensure KubermaticConfiguration %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &kubermaticv1.KubermaticConfiguration{}, false, nil, nil, objectModifiers...)
 }
 
 // SeedCreator defines an interface to create/update Seeds
@@ -900,22 +561,7 @@ func SeedObjectWrapper(create SeedCreator) ObjectCreator {
 
 // ReconcileSeeds will create and update the Seeds coming from the passed SeedCreator slice
 func ReconcileSeeds(ctx context.Context, namedGetters []NamedSeedCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := SeedObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.Seed{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Seed %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &kubermaticv1.Seed{}, false, nil, nil, objectModifiers...)
 }
 
 // EtcdBackupConfigCreator defines an interface to create/update EtcdBackupConfigs
@@ -937,22 +583,7 @@ func EtcdBackupConfigObjectWrapper(create EtcdBackupConfigCreator) ObjectCreator
 
 // ReconcileEtcdBackupConfigs will create and update the EtcdBackupConfigs coming from the passed EtcdBackupConfigCreator slice
 func ReconcileEtcdBackupConfigs(ctx context.Context, namedGetters []NamedEtcdBackupConfigCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := EtcdBackupConfigObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.EtcdBackupConfig{}, false); err != nil {
-			return fmt.Errorf("failed to ensure EtcdBackupConfig %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &kubermaticv1.EtcdBackupConfig{}, false, nil, nil, objectModifiers...)
 }
 
 // ConstraintTemplateCreator defines an interface to create/update ConstraintTemplates
@@ -973,23 +604,8 @@ func ConstraintTemplateObjectWrapper(create ConstraintTemplateCreator) ObjectCre
 }
 
 // ReconcileConstraintTemplates will create and update the ConstraintTemplates coming from the passed ConstraintTemplateCreator slice
-func ReconcileConstraintTemplates(ctx context.Context, namedGetters []NamedConstraintTemplateCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := ConstraintTemplateObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &gatekeeperv1.ConstraintTemplate{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ConstraintTemplate %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileConstraintTemplates(ctx context.Context, namedGetters []NamedConstraintTemplateCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &gatekeeperv1.ConstraintTemplate{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1ConstraintTemplateCreator defines an interface to create/update ConstraintTemplates
@@ -1010,23 +626,30 @@ func KubermaticV1ConstraintTemplateObjectWrapper(create KubermaticV1ConstraintTe
 }
 
 // ReconcileKubermaticV1ConstraintTemplates will create and update the KubermaticV1ConstraintTemplates coming from the passed KubermaticV1ConstraintTemplateCreator slice
-func ReconcileKubermaticV1ConstraintTemplates(ctx context.Context, namedGetters []NamedKubermaticV1ConstraintTemplateCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1ConstraintTemplateObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
+func ReconcileKubermaticV1ConstraintTemplates(ctx context.Context, namedGetters []NamedKubermaticV1ConstraintTemplateCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &kubermaticv1.ConstraintTemplate{}, false, nil, nil, objectModifiers...)
+}
+
+// GatekeeperV1alpha1ConfigCreator defines an interface to create/update Configs
+type GatekeeperV1alpha1ConfigCreator = func(existing *gatekeeperconfigv1alpha1.Config) (*gatekeeperconfigv1alpha1.Config, error)
 
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.ConstraintTemplate{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ConstraintTemplate %s/%s: %w", namespace, name, err)
+// NamedGatekeeperV1alpha1ConfigCreatorGetter returns the name of the resource and the corresponding creator function
+type NamedGatekeeperV1alpha1ConfigCreatorGetter = func() (name string, create GatekeeperV1alpha1ConfigCreator)
+
+// GatekeeperV1alpha1ConfigObjectWrapper adds a wrapper so the GatekeeperV1alpha1ConfigCreator matches ObjectCreator.
+// This is needed as Go does not support function interface matching.
+func GatekeeperV1alpha1ConfigObjectWrapper(create GatekeeperV1alpha1ConfigCreator) ObjectCreator {
+	return func(existing ctrlruntimeclient.Object) (ctrlruntimeclient.Object, error) {
+		if existing != nil {
+			return create(existing.(*gatekeeperconfigv1alpha1.Config))
 		}
+		return create(&gatekeeperconfigv1alpha1.Config{})
 	}
+}
 
-	return nil
+// ReconcileGatekeeperV1alpha1Configs will create and update the GatekeeperV1alpha1Configs coming from the passed GatekeeperV1alpha1ConfigCreator slice
+func ReconcileGatekeeperV1alpha1Configs(ctx context.Context, namedGetters []NamedGatekeeperV1alpha1ConfigCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &gatekeeperconfigv1alpha1.Config{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1ProjectCreator defines an interface to create/update Projects
@@ -1047,23 +670,8 @@ func KubermaticV1ProjectObjectWrapper(create KubermaticV1ProjectCreator) ObjectC
 }
 
 // ReconcileKubermaticV1Projects will create and update the KubermaticV1Projects coming from the passed KubermaticV1ProjectCreator slice
-func ReconcileKubermaticV1Projects(ctx context.Context, namedGetters []NamedKubermaticV1ProjectCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1ProjectObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.Project{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Project %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileKubermaticV1Projects(ctx context.Context, namedGetters []NamedKubermaticV1ProjectCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &kubermaticv1.Project{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1UserProjectBindingCreator defines an interface to create/update UserProjectBindings
@@ -1084,23 +692,8 @@ func KubermaticV1UserProjectBindingObjectWrapper(create KubermaticV1UserProjectB
 }
 
 // ReconcileKubermaticV1UserProjectBindings will create and update the KubermaticV1UserProjectBindings coming from the passed KubermaticV1UserProjectBindingCreator slice
-func ReconcileKubermaticV1UserProjectBindings(ctx context.Context, namedGetters []NamedKubermaticV1UserProjectBindingCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1UserProjectBindingObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.UserProjectBinding{}, false); err != nil {
-			return fmt.Errorf("failed to ensure UserProjectBinding %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileKubermaticV1UserProjectBindings(ctx context.Context, namedGetters []NamedKubermaticV1UserProjectBindingCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &kubermaticv1.UserProjectBinding{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1ConstraintCreator defines an interface to create/update Constraints
@@ -1122,22 +715,7 @@ func KubermaticV1ConstraintObjectWrapper(create KubermaticV1ConstraintCreator) O
 
 // ReconcileKubermaticV1Constraints will create and update the KubermaticV1Constraints coming from the passed KubermaticV1ConstraintCreator slice
 func ReconcileKubermaticV1Constraints(ctx context.Context, namedGetters []NamedKubermaticV1ConstraintCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1ConstraintObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.Constraint{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Constraint %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &kubermaticv1.Constraint{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1UserCreator defines an interface to create/update Users
@@ -1158,23 +736,8 @@ func KubermaticV1UserObjectWrapper(create KubermaticV1UserCreator) ObjectCreator
 }
 
 // ReconcileKubermaticV1Users will create and update the KubermaticV1Users coming from the passed KubermaticV1UserCreator slice
-func ReconcileKubermaticV1Users(ctx context.Context, namedGetters []NamedKubermaticV1UserCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1UserObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.User{}, false); err != nil {
-			return fmt.Errorf("failed to ensure User %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileKubermaticV1Users(ctx context.Context, namedGetters []NamedKubermaticV1UserCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &kubermaticv1.User{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1ClusterTemplateCreator defines an interface to create/update ClusterTemplates
@@ -1195,23 +758,13 @@ func KubermaticV1ClusterTemplateObjectWrapper(create KubermaticV1ClusterTemplate
 }
 
 // ReconcileKubermaticV1ClusterTemplates will create and update the KubermaticV1ClusterTemplates coming from the passed KubermaticV1ClusterTemplateCreator slice
-func ReconcileKubermaticV1ClusterTemplates(ctx context.Context, namedGetters []NamedKubermaticV1ClusterTemplateCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1ClusterTemplateObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.ClusterTemplate{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ClusterTemplate %s/%s: %w", namespace, name, err)
-		}
-	}
+func ReconcileKubermaticV1ClusterTemplates(ctx context.Context, namedGetters []NamedKubermaticV1ClusterTemplateCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &kubermaticv1.ClusterTemplate{}, false, nil, nil, objectModifiers...)
+}
 
-	return nil
+// DeleteKubermaticV1ClusterTemplates will delete the KubermaticV1ClusterTemplates with the passed names, ignoring those that do not exist
+func DeleteKubermaticV1ClusterTemplates(ctx context.Context, names []string, client ctrlruntimeclient.Client) error {
+	return DeleteObjects(ctx, names, metav1.NamespaceNone, client, &kubermaticv1.ClusterTemplate{})
 }
 
 // NetworkPolicyCreator defines an interface to create/update NetworkPolicys
@@ -1233,22 +786,7 @@ func NetworkPolicyObjectWrapper(create NetworkPolicyCreator) ObjectCreator {
 
 // ReconcileNetworkPolicies will create and update the NetworkPolicies coming from the passed NetworkPolicyCreator slice
 func ReconcileNetworkPolicies(ctx context.Context, namedGetters []NamedNetworkPolicyCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := NetworkPolicyObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &networkingv1.NetworkPolicy{}, false); err != nil {
-			return fmt.Errorf("failed to ensure NetworkPolicy %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &networkingv1.NetworkPolicy{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1RuleGroupCreator defines an interface to create/update RuleGroups
@@ -1270,22 +808,7 @@ func KubermaticV1RuleGroupObjectWrapper(create KubermaticV1RuleGroupCreator) Obj
 
 // ReconcileKubermaticV1RuleGroups will create and update the KubermaticV1RuleGroups coming from the passed KubermaticV1RuleGroupCreator slice
 func ReconcileKubermaticV1RuleGroups(ctx context.Context, namedGetters []NamedKubermaticV1RuleGroupCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1RuleGroupObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.RuleGroup{}, false); err != nil {
-			return fmt.Errorf("failed to ensure RuleGroup %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &kubermaticv1.RuleGroup{}, false, nil, nil, objectModifiers...)
 }
 
 // AppsKubermaticV1ApplicationDefinitionCreator defines an interface to create/update ApplicationDefinitions
@@ -1306,23 +829,8 @@ func AppsKubermaticV1ApplicationDefinitionObjectWrapper(create AppsKubermaticV1A
 }
 
 // ReconcileAppsKubermaticV1ApplicationDefinitions will create and update the AppsKubermaticV1ApplicationDefinitions coming from the passed AppsKubermaticV1ApplicationDefinitionCreator slice
-func ReconcileAppsKubermaticV1ApplicationDefinitions(ctx context.Context, namedGetters []NamedAppsKubermaticV1ApplicationDefinitionCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := AppsKubermaticV1ApplicationDefinitionObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &appskubermaticv1.ApplicationDefinition{}, false); err != nil {
-			return fmt.Errorf("failed to ensure ApplicationDefinition %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileAppsKubermaticV1ApplicationDefinitions(ctx context.Context, namedGetters []NamedAppsKubermaticV1ApplicationDefinitionCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &appskubermaticv1.ApplicationDefinition{}, false, nil, nil, objectModifiers...)
 }
 
 // KubeVirtV1VirtualMachineInstancePresetCreator defines an interface to create/update VirtualMachineInstancePresets
@@ -1344,22 +852,7 @@ func KubeVirtV1VirtualMachineInstancePresetObjectWrapper(create KubeVirtV1Virtua
 
 // ReconcileKubeVirtV1VirtualMachineInstancePresets will create and update the KubeVirtV1VirtualMachineInstancePresets coming from the passed KubeVirtV1VirtualMachineInstancePresetCreator slice
 func ReconcileKubeVirtV1VirtualMachineInstancePresets(ctx context.Context, namedGetters []NamedKubeVirtV1VirtualMachineInstancePresetCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubeVirtV1VirtualMachineInstancePresetObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubevirtv1.VirtualMachineInstancePreset{}, false); err != nil {
-			return fmt.Errorf("failed to ensure VirtualMachineInstancePreset %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &kubevirtv1.VirtualMachineInstancePreset{}, false, nil, nil, objectModifiers...)
 }
 
 // KubermaticV1PresetCreator defines an interface to create/update Presets
@@ -1380,23 +873,8 @@ func KubermaticV1PresetObjectWrapper(create KubermaticV1PresetCreator) ObjectCre
 }
 
 // ReconcileKubermaticV1Presets will create and update the KubermaticV1Presets coming from the passed KubermaticV1PresetCreator slice
-func ReconcileKubermaticV1Presets(ctx context.Context, namedGetters []NamedKubermaticV1PresetCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := KubermaticV1PresetObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &kubermaticv1.Preset{}, false); err != nil {
-			return fmt.Errorf("failed to ensure Preset %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+func ReconcileKubermaticV1Presets(ctx context.Context, namedGetters []NamedKubermaticV1PresetCreatorGetter, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	return ReconcileObjects(ctx, namedGetters, metav1.NamespaceNone, client, &kubermaticv1.Preset{}, false, nil, nil, objectModifiers...)
 }
 
 // CDIv1beta1DataVolumeCreator defines an interface to create/update DataVolumes
@@ -1418,20 +896,5 @@ func CDIv1beta1DataVolumeObjectWrapper(create CDIv1beta1DataVolumeCreator) Objec
 
 // ReconcileCDIv1beta1DataVolumes will create and update the CDIv1beta1DataVolumes coming from the passed CDIv1beta1DataVolumeCreator slice
 func ReconcileCDIv1beta1DataVolumes(ctx context.Context, namedGetters []NamedCDIv1beta1DataVolumeCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
-	for _, get := range namedGetters {
-		name, create := get()
-		createObject := CDIv1beta1DataVolumeObjectWrapper(create)
-		createObject = createWithNamespace(createObject, namespace)
-		createObject = createWithName(createObject, name)
-
-		for _, objectModifier := range objectModifiers {
-			createObject = objectModifier(createObject)
-		}
-
-		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &cdiv1beta1.DataVolume{}, false); err != nil {
-			return fmt.Errorf("failed to ensure DataVolume %s/%s: %w", namespace, name, err)
-		}
-	}
-
-	return nil
+	return ReconcileObjects(ctx, namedGetters, namespace, client, &cdiv1beta1.DataVolume{}, false, nil, nil, objectModifiers...)
 }
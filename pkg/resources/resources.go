@@ -54,6 +54,8 @@ const (
 	// ApiServer secure port.
 	APIServerSecurePort = 6443
 
+	// NodeLocalDNSCacheAddress is the default address of the NodeLocal DNS Cache, see
+	// UserClusterNodeLocalDNSCacheAddress.
 	NodeLocalDNSCacheAddress = "169.254.20.10"
 )
 
@@ -102,6 +104,9 @@ const (
 	EtcdDefaultBackupConfigName = "default-backups"
 	// EtcdTLSEnabledAnnotation is the annotation assigned to etcd Pods that run with a TLS peer endpoint.
 	EtcdTLSEnabledAnnotation = "etcd.kubermatic.k8c.io/tls-peer-enabled"
+	// EtcdLauncherStatusPort is the port on which the etcd-launcher serves the status of the etcd members.
+	// The status is only served on localhost, so it has to be accessed via a port-forward.
+	EtcdLauncherStatusPort = 2377
 	// EncryptionConfigurationSecretName is the name of secret storing the API server's EncryptionConfiguration.
 	EncryptionConfigurationSecretName = "apiserver-encryption-configuration"
 	// EncryptionConfigurationKeyName is the name of the secret key that is used to store the configuration file for encryption-at-rest.
@@ -135,6 +140,9 @@ const (
 	AdminKubeconfigSecretName = "admin-kubeconfig"
 	// ViewerKubeconfigSecretName is the name for the secret containing the viewer kubeconfig.
 	ViewerKubeconfigSecretName = "viewer-kubeconfig"
+	// ViewerServiceAccountKubeconfigSecretName is the name for the secret containing the read-only kubeconfig
+	// backed by a ServiceAccount inside the user cluster.
+	ViewerServiceAccountKubeconfigSecretName = "viewer-serviceaccount-kubeconfig"
 	// SchedulerKubeconfigSecretName is the name for the secret containing the kubeconfig used by the scheduler.
 	SchedulerKubeconfigSecretName = "scheduler-kubeconfig"
 	// KubeletDnatControllerKubeconfigSecretName is the name for the secret containing the kubeconfig used by the kubeletdnatcontroller.
@@ -170,6 +178,9 @@ const (
 
 	// ImagePullSecretName specifies the name of the dockercfg secret used to access the private repo.
 	ImagePullSecretName = "dockercfg"
+	// ImagePullSecretRevisionAnnotation is the pod template annotation carrying a hash of the dockercfg secret,
+	// so that Deployments using it are rolled out when the pull credentials change.
+	ImagePullSecretRevisionAnnotation = "kubermatic.k8c.io/image-pull-secret-revision"
 
 	// FrontProxyCASecretName is the name for the secret containing the front proxy ca.
 	FrontProxyCASecretName = "front-proxy-ca"
@@ -181,6 +192,9 @@ const (
 	KubeletClientCertificatesSecretName = "kubelet-client-certificates"
 	// ServiceAccountKeySecretName is the name for the secret containing the service account key.
 	ServiceAccountKeySecretName = "service-account-key"
+	// ServiceAccountJWKSConfigMapName is the name for the configmap containing the JSON Web Key Set
+	// used to verify service account tokens.
+	ServiceAccountJWKSConfigMapName = "service-account-jwks"
 	// TokensSecretName is the name for the secret containing the user tokens.
 	TokensSecretName = "tokens"
 	// ViewerTokenSecretName is the name for the secret containing the viewer token.
@@ -363,6 +377,8 @@ const (
 	GatekeeperServiceAccountName = "gatekeeper-admin"
 	// GatekeeperNamespace is the main gatkeeper namespace where the gatekeeper config is stored.
 	GatekeeperNamespace = "gatekeeper-system"
+	// GatekeeperConfigName is the name of the Gatekeeper Config; Gatekeeper only reads the Config with this name.
+	GatekeeperConfigName = "config"
 	// ExperimentalEnableMutation enables gatekeeper to validate created kubernetes resources and also modify them based on defined mutation policies.
 	ExperimentalEnableMutation = false
 	// AuditMatchKindOnly enables gatekeeper to only audit resources in OPA cache.
@@ -482,6 +498,8 @@ const (
 	ServiceAccountKeySecretKey = "sa.key"
 	// ServiceAccountKeyPublicKey is the public key for the service account signer key.
 	ServiceAccountKeyPublicKey = "sa.pub"
+	// ServiceAccountJWKSConfigMapKey jwks.json.
+	ServiceAccountJWKSConfigMapKey = "jwks.json"
 	// KubeconfigSecretKey kubeconfig.
 	KubeconfigSecretKey = "kubeconfig"
 	// TokensSecretKey tokens.csv.
@@ -603,6 +621,8 @@ const (
 
 	DigitaloceanToken = "token"
 
+	FakeToken = "token"
+
 	GCPServiceAccount = "serviceAccount"
 
 	HetznerToken = "token"
@@ -952,6 +972,16 @@ func UserClusterDNSResolverIP(cluster *kubermaticv1.Cluster) (string, error) {
 	return ip.String(), nil
 }
 
+// UserClusterNodeLocalDNSCacheAddress returns the address of the NodeLocal DNS Cache
+// configured for the cluster, falling back to NodeLocalDNSCacheAddress for clusters
+// that have not been defaulted yet.
+func UserClusterNodeLocalDNSCacheAddress(cluster *kubermaticv1.Cluster) string {
+	if address := cluster.Spec.ClusterNetwork.NodeLocalDNSCacheAddress; address != "" {
+		return address
+	}
+	return NodeLocalDNSCacheAddress
+}
+
 // InClusterApiserverIP returns the first usable IP of the service cidr.
 // Its the in cluster IP for the apiserver.
 func InClusterApiserverIP(cluster *kubermaticv1.Cluster) (*net.IP, error) {
@@ -1294,13 +1324,32 @@ func GetPodTemplateLabels(
 }
 
 func GetHTTPProxyEnvVarsFromSeed(seed *kubermaticv1.Seed, inClusterAPIServerURL string) []corev1.EnvVar {
-	if seed.Spec.ProxySettings.Empty() {
+	return getHTTPProxyEnvVars(seed.Spec.ProxySettings, inClusterAPIServerURL)
+}
+
+// GetControlPlaneHTTPProxyEnvVars returns the proxy environment variables for the control plane
+// components of the given cluster. The cluster's proxy settings take precedence over the
+// settings of the seed.
+func GetControlPlaneHTTPProxyEnvVars(seed *kubermaticv1.Seed, cluster *kubermaticv1.Cluster) []corev1.EnvVar {
+	settings := seed.Spec.ProxySettings
+	if !cluster.Spec.ProxySettings.Empty() {
+		settings = cluster.Spec.ProxySettings.DeepCopy()
+		if seed.Spec.ProxySettings != nil {
+			seed.Spec.ProxySettings.Merge(settings)
+		}
+	}
+
+	return getHTTPProxyEnvVars(settings, cluster.Address.InternalName)
+}
+
+func getHTTPProxyEnvVars(settings *kubermaticv1.ProxySettings, inClusterAPIServerURL string) []corev1.EnvVar {
+	if settings.Empty() {
 		return nil
 	}
 	var envVars []corev1.EnvVar
 
-	if !seed.Spec.ProxySettings.HTTPProxy.Empty() {
-		value := seed.Spec.ProxySettings.HTTPProxy.String()
+	if !settings.HTTPProxy.Empty() {
+		value := settings.HTTPProxy.String()
 		envVars = []corev1.EnvVar{
 			{
 				Name:  "HTTP_PROXY",
@@ -1322,8 +1371,8 @@ func GetHTTPProxyEnvVarsFromSeed(seed *kubermaticv1.Seed, inClusterAPIServerURL
 	}
 
 	noProxyValue := inClusterAPIServerURL
-	if !seed.Spec.ProxySettings.NoProxy.Empty() {
-		noProxyValue += "," + seed.Spec.ProxySettings.NoProxy.String()
+	if !settings.NoProxy.Empty() {
+		noProxyValue += "," + settings.NoProxy.String()
 	}
 	envVars = append(envVars,
 		corev1.EnvVar{Name: "NO_PROXY", Value: noProxyValue},
@@ -1395,6 +1444,9 @@ func GetOverrides(componentSettings kubermaticv1.ComponentSettings) map[string]*
 	if componentSettings.Prometheus.Resources != nil {
 		r[PrometheusStatefulSetName] = componentSettings.Prometheus.Resources.DeepCopy()
 	}
+	if componentSettings.OperatingSystemManager.Resources != nil {
+		r[OperatingSystemManagerDeploymentName] = componentSettings.OperatingSystemManager.Resources.DeepCopy()
+	}
 	if componentSettings.NodePortProxyEnvoy.Resources.Requests != nil ||
 		componentSettings.NodePortProxyEnvoy.Resources.Limits != nil {
 		r[NodePortProxyEnvoyContainerName] = componentSettings.NodePortProxyEnvoy.Resources.DeepCopy()
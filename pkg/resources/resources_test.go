@@ -579,3 +579,92 @@ func TestSetResourceRequirementsDoesNotChangeDefaults(t *testing.T) {
 		t.Fatalf("The defaults have changed: %v\n", diff)
 	}
 }
+
+func TestGetControlPlaneHTTPProxyEnvVars(t *testing.T) {
+	seedProxy := kubermaticv1.NewProxyValue("http://seed-proxy:3128")
+	seedNoProxy := kubermaticv1.NewProxyValue("seed.internal")
+	clusterProxy := kubermaticv1.NewProxyValue("http://cluster-proxy:3128")
+	clusterNoProxy := kubermaticv1.NewProxyValue("10.0.0.0/8,*.corp.example.com")
+
+	testCases := []struct {
+		name          string
+		seedProxy     *kubermaticv1.ProxySettings
+		clusterProxy  *kubermaticv1.ProxySettings
+		expectedProxy string
+		expectedNo    string
+	}{
+		{
+			name: "no proxy configured",
+		},
+		{
+			name: "seed proxy only",
+			seedProxy: &kubermaticv1.ProxySettings{
+				HTTPProxy: seedProxy,
+				NoProxy:   seedNoProxy,
+			},
+			expectedProxy: "http://seed-proxy:3128",
+			expectedNo:    "apiserver-external.cluster-abc.svc.cluster.local.,seed.internal",
+		},
+		{
+			name: "cluster proxy only",
+			clusterProxy: &kubermaticv1.ProxySettings{
+				HTTPProxy: clusterProxy,
+				NoProxy:   clusterNoProxy,
+			},
+			expectedProxy: "http://cluster-proxy:3128",
+			expectedNo:    "apiserver-external.cluster-abc.svc.cluster.local.,10.0.0.0/8,*.corp.example.com",
+		},
+		{
+			name: "cluster proxy takes precedence, missing settings are inherited from the seed",
+			seedProxy: &kubermaticv1.ProxySettings{
+				HTTPProxy: seedProxy,
+				NoProxy:   seedNoProxy,
+			},
+			clusterProxy: &kubermaticv1.ProxySettings{
+				HTTPProxy: clusterProxy,
+			},
+			expectedProxy: "http://cluster-proxy:3128",
+			expectedNo:    "apiserver-external.cluster-abc.svc.cluster.local.,seed.internal",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seed := &kubermaticv1.Seed{
+				Spec: kubermaticv1.SeedSpec{
+					ProxySettings: tc.seedProxy,
+				},
+			}
+			cluster := &kubermaticv1.Cluster{
+				Spec: kubermaticv1.ClusterSpec{
+					ProxySettings: tc.clusterProxy,
+				},
+				Address: kubermaticv1.ClusterAddress{
+					InternalName: "apiserver-external.cluster-abc.svc.cluster.local.",
+				},
+			}
+
+			envVars := map[string]string{}
+			for _, envVar := range GetControlPlaneHTTPProxyEnvVars(seed, cluster) {
+				envVars[envVar.Name] = envVar.Value
+			}
+
+			for _, name := range []string{"HTTP_PROXY", "HTTPS_PROXY", "http_proxy", "https_proxy"} {
+				if value := envVars[name]; value != tc.expectedProxy {
+					t.Errorf("Expected %s to be %q, got %q", name, tc.expectedProxy, value)
+				}
+			}
+
+			for _, name := range []string{"NO_PROXY", "no_proxy"} {
+				if value := envVars[name]; value != tc.expectedNo {
+					t.Errorf("Expected %s to be %q, got %q", name, tc.expectedNo, value)
+				}
+			}
+
+			// the seed's settings must not be modified
+			if tc.seedProxy != nil && seed.Spec.ProxySettings.HTTPProxy.String() != seedProxy.String() {
+				t.Errorf("Expected seed proxy settings to be unchanged, got %q", seed.Spec.ProxySettings.HTTPProxy.String())
+			}
+		})
+	}
+}
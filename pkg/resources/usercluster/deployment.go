@@ -141,6 +141,7 @@ func DeploymentCreator(data userclusterControllerData) reconciling.NamedDeployme
 				fmt.Sprintf("-opa-integration=%t", data.Cluster().Spec.OPAIntegration != nil && data.Cluster().Spec.OPAIntegration.Enabled),
 				fmt.Sprintf("-ca-bundle=/opt/ca-bundle/%s", resources.CABundleConfigMapKey),
 				fmt.Sprintf("-node-local-dns-cache=%t", data.NodeLocalDNSCacheEnabled()),
+				"-node-local-dns-cache-address", resources.UserClusterNodeLocalDNSCacheAddress(data.Cluster()),
 			}, getNetworkArgs(data)...)
 
 			if email := data.Cluster().Status.UserEmail; email != "" {
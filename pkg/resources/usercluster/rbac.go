@@ -67,6 +67,7 @@ func RoleCreator() (string, reconciling.RoleCreator) {
 				Resources: []string{"secrets"},
 				ResourceNames: []string{
 					resources.AdminKubeconfigSecretName,
+					resources.ViewerServiceAccountKubeconfigSecretName,
 				},
 				Verbs: []string{"update"},
 			},
@@ -20,12 +20,19 @@ package etcdlauncher
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"sync"
 	"testing"
 	"time"
 
+	"go.uber.org/zap"
+
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/utils"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -36,7 +43,10 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimeconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
 )
 
 var (
@@ -47,10 +57,11 @@ var (
 )
 
 const (
-	scaleUpCount           = 5
-	scaleDownCount         = 3
-	minioBackupDestination = "minio"
-	namespaceName          = "backup-test"
+	scaleUpCount                    = 5
+	scaleDownCount                  = 3
+	minioBackupDestination          = "minio"
+	secondaryMinioBackupDestination = "minio-secondary"
+	namespaceName                   = "backup-test"
 )
 
 func TestBackup(t *testing.T) {
@@ -113,7 +124,7 @@ func TestBackup(t *testing.T) {
 	t.Log("created test namespace")
 
 	// create etcd backup that will be restored later
-	err, backup := createBackup(ctx, t, client, cluster)
+	err, backup := createBackup(ctx, t, client, cluster, minioBackupDestination, false)
 	if err != nil {
 		t.Fatalf("failed to create etcd backup: %v", err)
 	}
@@ -156,6 +167,223 @@ func TestBackup(t *testing.T) {
 	t.Log("tests succeeded")
 }
 
+func TestBackupMultipleDestinations(t *testing.T) {
+	ctx := context.Background()
+
+	client, _, _, err := utils.GetClients()
+	if err != nil {
+		t.Fatalf("failed to get client for seed cluster: %v", err)
+	}
+
+	// login
+	masterToken, err := utils.RetrieveMasterToken(ctx)
+	if err != nil {
+		t.Fatalf("failed to get master token: %v", err)
+	}
+	testClient := utils.NewTestClient(masterToken, t)
+
+	// create dummy project
+	t.Log("creating project...")
+	project, err := testClient.CreateProject(rand.String(10))
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	defer cleanupProject(t, project.ID)
+
+	// create dummy cluster (NB: If these tests fail, the etcd ring can be
+	// _so_ dead that any cleanup attempt is futile; make sure to not create
+	// any cloud resources, as they might be orphaned)
+
+	t.Log("creating cluster...")
+	apiCluster, err := testClient.CreateHetznerCluster(project.ID, datacenter, rand.String(10), credential, version, location, 0)
+	if err != nil {
+		t.Fatalf("failed to create cluster: %v", err)
+	}
+
+	// wait for the cluster to become healthy
+	if err := testClient.WaitForClusterHealthy(project.ID, datacenter, apiCluster.ID); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+
+	// get the cluster object (the CRD, not the API's representation)
+	cluster := &kubermaticv1.Cluster{}
+	if err := client.Get(ctx, types.NamespacedName{Name: apiCluster.ID}, cluster); err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+
+	t.Log("creating client for user cluster...")
+	userClient, err := testClient.GetUserClusterClient(datacenter, project.ID, apiCluster.ID)
+	if err != nil {
+		t.Fatalf("error creating user cluster client: %v", err)
+	}
+
+	// Create a resource on the cluster so that we can see that the backup works
+	testNamespace := &corev1.Namespace{}
+	testNamespace.Name = namespaceName
+	err = userClient.Create(ctx, testNamespace)
+	if err != nil {
+		t.Fatalf("failed to create test namespace: %v", err)
+	}
+	t.Log("created test namespace")
+
+	// create an etcd backup in each destination; createBackup waits for
+	// every backup to be completed
+	backups := map[string]*kubermaticv1.EtcdBackupConfig{}
+	for _, destination := range []string{minioBackupDestination, secondaryMinioBackupDestination} {
+		err, backup := createBackup(ctx, t, client, cluster, destination, false)
+		if err != nil {
+			t.Fatalf("failed to create etcd backup in destination %q: %v", destination, err)
+		}
+		backups[destination] = backup
+	}
+	t.Log("created etcd backups in all destinations")
+
+	// delete the test resource
+	err = userClient.Delete(ctx, testNamespace)
+	if err != nil {
+		t.Fatalf("failed to delete test namespace: %v", err)
+	}
+	t.Log("deleted test namespace")
+
+	if err := enableLauncher(ctx, t, client, cluster); err != nil {
+		t.Fatalf("failed to enable etcd-launcher: %v", err)
+	}
+
+	if err := waitForClusterHealthy(ctx, t, client, cluster); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+
+	// restore from the backup in the non-default destination
+	if err := restoreBackup(ctx, t, client, cluster, backups[secondaryMinioBackupDestination]); err != nil {
+		t.Fatalf("failed to restore etcd backup: %v", err)
+	}
+	t.Log("restored etcd backup")
+
+	if err := waitForClusterHealthy(ctx, t, client, cluster); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+
+	// check if resource was restored
+	restoredNamespace := &corev1.Namespace{}
+	err = userClient.Get(ctx, types.NamespacedName{Name: namespaceName}, restoredNamespace)
+	if err != nil {
+		t.Fatalf("failed to get restored test namespace: %v", err)
+	}
+	t.Log("deleted namespace was restored by backup")
+
+	t.Log("tests succeeded")
+}
+
+func TestBackupCompression(t *testing.T) {
+	ctx := context.Background()
+
+	client, _, _, err := utils.GetClients()
+	if err != nil {
+		t.Fatalf("failed to get client for seed cluster: %v", err)
+	}
+
+	// login
+	masterToken, err := utils.RetrieveMasterToken(ctx)
+	if err != nil {
+		t.Fatalf("failed to get master token: %v", err)
+	}
+	testClient := utils.NewTestClient(masterToken, t)
+
+	// create dummy project
+	t.Log("creating project...")
+	project, err := testClient.CreateProject(rand.String(10))
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	defer cleanupProject(t, project.ID)
+
+	// create dummy cluster (NB: If these tests fail, the etcd ring can be
+	// _so_ dead that any cleanup attempt is futile; make sure to not create
+	// any cloud resources, as they might be orphaned)
+
+	t.Log("creating cluster...")
+	apiCluster, err := testClient.CreateHetznerCluster(project.ID, datacenter, rand.String(10), credential, version, location, 0)
+	if err != nil {
+		t.Fatalf("failed to create cluster: %v", err)
+	}
+
+	// wait for the cluster to become healthy
+	if err := testClient.WaitForClusterHealthy(project.ID, datacenter, apiCluster.ID); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+
+	// get the cluster object (the CRD, not the API's representation)
+	cluster := &kubermaticv1.Cluster{}
+	if err := client.Get(ctx, types.NamespacedName{Name: apiCluster.ID}, cluster); err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+
+	t.Log("creating client for user cluster...")
+	userClient, err := testClient.GetUserClusterClient(datacenter, project.ID, apiCluster.ID)
+	if err != nil {
+		t.Fatalf("error creating user cluster client: %v", err)
+	}
+
+	// Create a resource on the cluster so that we can see that the backup works
+	testNamespace := &corev1.Namespace{}
+	testNamespace.Name = namespaceName
+	err = userClient.Create(ctx, testNamespace)
+	if err != nil {
+		t.Fatalf("failed to create test namespace: %v", err)
+	}
+	t.Log("created test namespace")
+
+	// create an uncompressed backup, like older versions did, and a compressed one
+	err, uncompressedBackup := createBackup(ctx, t, client, cluster, minioBackupDestination, false)
+	if err != nil {
+		t.Fatalf("failed to create uncompressed etcd backup: %v", err)
+	}
+
+	err, compressedBackup := createBackup(ctx, t, client, cluster, minioBackupDestination, true)
+	if err != nil {
+		t.Fatalf("failed to create compressed etcd backup: %v", err)
+	}
+	t.Log("created etcd backups")
+
+	if err := enableLauncher(ctx, t, client, cluster); err != nil {
+		t.Fatalf("failed to enable etcd-launcher: %v", err)
+	}
+
+	if err := waitForClusterHealthy(ctx, t, client, cluster); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+
+	// restore the compressed backup first and the uncompressed one afterwards,
+	// so both formats are restored by the same etcd-launcher
+	for _, backup := range []*kubermaticv1.EtcdBackupConfig{compressedBackup, uncompressedBackup} {
+		// delete the test resource
+		err = userClient.Delete(ctx, testNamespace)
+		if err != nil {
+			t.Fatalf("failed to delete test namespace: %v", err)
+		}
+		t.Log("deleted test namespace")
+
+		if err := restoreBackup(ctx, t, client, cluster, backup); err != nil {
+			t.Fatalf("failed to restore etcd backup %q: %v", backup.Name, err)
+		}
+		t.Logf("restored etcd backup %q", backup.Name)
+
+		if err := waitForClusterHealthy(ctx, t, client, cluster); err != nil {
+			t.Fatalf("cluster did not become healthy: %v", err)
+		}
+
+		// check if resource was restored
+		restoredNamespace := &corev1.Namespace{}
+		err = userClient.Get(ctx, types.NamespacedName{Name: namespaceName}, restoredNamespace)
+		if err != nil {
+			t.Fatalf("failed to get restored test namespace: %v", err)
+		}
+		t.Logf("deleted namespace was restored by backup %q", backup.Name)
+	}
+
+	t.Log("tests succeeded")
+}
+
 func TestScaling(t *testing.T) {
 	ctx := context.Background()
 
@@ -301,11 +529,84 @@ func TestRecovery(t *testing.T) {
 	}
 }
 
-func createBackup(ctx context.Context, t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (error, *kubermaticv1.EtcdBackupConfig) {
-	t.Log("creating backup of etcd data...")
+func TestQuorumLossRecovery(t *testing.T) {
+	ctx := context.Background()
+
+	client, _, _, err := utils.GetClients()
+	if err != nil {
+		t.Fatalf("failed to get client for seed cluster: %v", err)
+	}
+
+	// login
+	masterToken, err := utils.RetrieveMasterToken(ctx)
+	if err != nil {
+		t.Fatalf("failed to get master token: %v", err)
+	}
+	testClient := utils.NewTestClient(masterToken, t)
+
+	// create dummy project
+	t.Log("creating project...")
+	project, err := testClient.CreateProject(rand.String(10))
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	defer cleanupProject(t, project.ID)
+
+	// create dummy cluster (NB: If these tests fail, the etcd ring can be
+	// _so_ dead that any cleanup attempt is futile; make sure to not create
+	// any cloud resources, as they might be orphaned)
+
+	t.Log("creating cluster...")
+	apiCluster, err := testClient.CreateHetznerCluster(project.ID, datacenter, rand.String(10), credential, version, location, 0)
+	if err != nil {
+		t.Fatalf("failed to create cluster: %v", err)
+	}
+
+	// wait for the cluster to become healthy
+	if err := testClient.WaitForClusterHealthy(project.ID, datacenter, apiCluster.ID); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+
+	// get the cluster object (the CRD, not the API's representation)
+	cluster := &kubermaticv1.Cluster{}
+	if err := client.Get(ctx, types.NamespacedName{Name: apiCluster.ID}, cluster); err != nil {
+		t.Fatalf("failed to get cluster: %v", err)
+	}
+
+	if err := enableLauncher(ctx, t, client, cluster); err != nil {
+		t.Fatalf("failed to enable etcd-launcher: %v", err)
+	}
+
+	if err := waitForClusterHealthy(ctx, t, client, cluster); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+
+	// create a backup to fall back to in case the ring cannot recover on its own
+	err, backup := createBackup(ctx, t, client, cluster, minioBackupDestination, false)
+	if err != nil {
+		t.Fatalf("failed to create etcd backup: %v", err)
+	}
+
+	if err := breakAndRecoverQuorum(ctx, t, client, cluster, backup); err != nil {
+		t.Fatalf("failed to recover from quorum loss: %v", err)
+	}
+
+	if err := waitForClusterHealthy(ctx, t, client, cluster); err != nil {
+		t.Fatalf("cluster did not become healthy: %v", err)
+	}
+}
+
+func createBackup(ctx context.Context, t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, destination string, compress bool) (error, *kubermaticv1.EtcdBackupConfig) {
+	t.Logf("creating backup of etcd data in destination %q (compressed: %v)...", destination, compress)
+
+	name := fmt.Sprintf("etcd-e2e-backup-%s", destination)
+	if compress {
+		name += "-compressed"
+	}
+
 	backup := &kubermaticv1.EtcdBackupConfig{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "etcd-e2e-backup",
+			Name:      name,
 			Namespace: cluster.Status.NamespaceName,
 		},
 		Spec: kubermaticv1.EtcdBackupConfigSpec{
@@ -317,7 +618,8 @@ func createBackup(ctx context.Context, t *testing.T, client ctrlruntimeclient.Cl
 				APIVersion:      cluster.APIVersion,
 				ResourceVersion: cluster.ResourceVersion,
 			},
-			Destination: minioBackupDestination,
+			Destination: destination,
+			Compress:    compress,
 		},
 	}
 
@@ -336,7 +638,7 @@ func restoreBackup(ctx context.Context, t *testing.T, client ctrlruntimeclient.C
 	t.Log("restoring etcd cluster from backup...")
 	restore := &kubermaticv1.EtcdRestore{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "etcd-e2e-restore",
+			Name:      fmt.Sprintf("%s-restore", backup.Name),
 			Namespace: backup.Namespace,
 		},
 		Spec: kubermaticv1.EtcdRestoreSpec{
@@ -349,7 +651,7 @@ func restoreBackup(ctx context.Context, t *testing.T, client ctrlruntimeclient.C
 				ResourceVersion: cluster.ResourceVersion,
 			},
 			BackupName:  backup.Status.CurrentBackups[0].BackupName,
-			Destination: minioBackupDestination,
+			Destination: backup.Spec.Destination,
 		},
 	}
 
@@ -469,6 +771,38 @@ func breakAndRecoverPVC(ctx context.Context, t *testing.T, client ctrlruntimecli
 	return nil
 }
 
+func breakAndRecoverQuorum(ctx context.Context, t *testing.T, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, backup *kubermaticv1.EtcdBackupConfig) error {
+	size := kubermaticv1.DefaultEtcdClusterSize
+	if cluster.Spec.ComponentsOverride.Etcd.ClusterSize != nil {
+		size = int(*cluster.Spec.ComponentsOverride.Etcd.ClusterSize)
+	}
+
+	// delete the PVCs of a majority of the etcd nodes, so the ring loses quorum
+	lost := size/2 + 1
+	t.Logf("testing etcd-launcher recovery from quorum loss (deleting %d of %d PVCs)...", lost, size)
+	if err := deleteEtcdPVCs(ctx, client, cluster, lost); err != nil {
+		return fmt.Errorf("failed to delete etcd node PVCs: %w", err)
+	}
+
+	time.Sleep(30 * time.Second)
+
+	// the remaining member may be able to re-seed the lost ones; if it cannot,
+	// the only correct way out is restoring from a backup
+	if err := waitForClusterHealthy(ctx, t, client, cluster); err == nil {
+		t.Log("etcd cluster recovered from quorum loss without a restore.")
+		return nil
+	}
+
+	t.Log("etcd cluster did not recover on its own, restoring from backup...")
+	if err := restoreBackup(ctx, t, client, cluster, backup); err != nil {
+		return fmt.Errorf("failed to restore etcd backup: %w", err)
+	}
+
+	t.Log("etcd cluster recovered from quorum loss via restore successfully.")
+
+	return nil
+}
+
 // enable etcd launcher for the cluster.
 func enableLauncherForCluster(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
 	return setClusterLauncherFeature(ctx, client, cluster, true)
@@ -509,8 +843,110 @@ func isClusterEtcdHealthy(ctx context.Context, client ctrlruntimeclient.Client,
 
 	// we are healthy if the cluster controller is happy and the sts has ready replicas
 	// matching the cluster's expected etcd cluster size
-	return cluster.Status.ExtendedHealth.Etcd == kubermaticv1.HealthStatusUp &&
-		clusterSize == sts.Status.ReadyReplicas, nil
+	if cluster.Status.ExtendedHealth.Etcd != kubermaticv1.HealthStatusUp || clusterSize != sts.Status.ReadyReplicas {
+		return false, nil
+	}
+
+	if !cluster.Spec.Features[kubermaticv1.ClusterFeatureEtcdLauncher] {
+		return true, nil
+	}
+
+	// with etcd-launcher, additionally make sure the ring has a leader and no unhealthy members;
+	// the status endpoint is not reachable while the StatefulSet is rolled out, so errors only
+	// mean that the ring is not healthy yet
+	status, err := getLauncherStatus(ctx, cluster)
+	if err != nil {
+		return false, nil
+	}
+
+	hasLeader := false
+	for _, member := range status.Members {
+		if !member.Healthy {
+			return false, nil
+		}
+		if member.Leader {
+			hasLeader = true
+		}
+	}
+
+	return hasLeader && int32(len(status.Members)) == clusterSize, nil
+}
+
+// launcherStatus is the response of the etcd-launcher's status endpoint.
+type launcherStatus struct {
+	Healthy bool `json:"healthy"`
+	Members []struct {
+		ID      string `json:"id"`
+		Name    string `json:"name"`
+		Leader  bool   `json:"leader"`
+		DBSize  int64  `json:"dbSize"`
+		Healthy bool   `json:"healthy"`
+		Error   string `json:"error,omitempty"`
+	} `json:"members"`
+}
+
+var (
+	launcherClientsOnce sync.Once
+	launcherClientset   kubernetes.Interface
+	launcherConfig      *rest.Config
+	launcherClientsErr  error
+)
+
+// getLauncherClients returns the clientset used to port-forward to the etcd-launchers,
+// creating it on first use.
+func getLauncherClients() (kubernetes.Interface, *rest.Config, error) {
+	launcherClientsOnce.Do(func() {
+		launcherConfig = ctrlruntimeconfig.GetConfigOrDie()
+		launcherClientset, launcherClientsErr = kubernetes.NewForConfig(launcherConfig)
+	})
+
+	return launcherClientset, launcherConfig, launcherClientsErr
+}
+
+// getLauncherStatus fetches the member status from a ready etcd-launcher. The status
+// is only served on localhost inside the Pod, so a port-forward is used to reach it.
+func getLauncherStatus(ctx context.Context, cluster *kubermaticv1.Cluster) (*launcherStatus, error) {
+	clientset, config, err := getLauncherClients()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+
+	forwarder, stopChan, err := common.GetPortForwarder(ctx, clientset.CoreV1(), config, clusterNamespace(cluster), "app=etcd", resources.EtcdLauncherStatusPort)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create port-forward: %w", err)
+	}
+	defer close(stopChan)
+
+	if err := common.ForwardPort(zap.NewNop().Sugar(), forwarder); err != nil {
+		return nil, fmt.Errorf("failed to forward port: %w", err)
+	}
+
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get forwarded port: %w", err)
+	}
+	if len(ports) != 1 {
+		return nil, fmt.Errorf("expected one forwarded port, got %d", len(ports))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://127.0.0.1:%d/status", ports[0].Local), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// unhealthy rings are reported with a non-2xx code, but still include the status
+	status := &launcherStatus{}
+	if err := json.NewDecoder(resp.Body).Decode(status); err != nil {
+		return nil, fmt.Errorf("failed to decode status (HTTP %d): %w", resp.StatusCode, err)
+	}
+
+	return status, nil
 }
 
 func isStrictTLSEnabled(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (bool, error) {
@@ -730,6 +1166,12 @@ func forceDeleteEtcdPV(ctx context.Context, client ctrlruntimeclient.Client, clu
 }
 
 func deleteEtcdPVC(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
+	return deleteEtcdPVCs(ctx, client, cluster, 1)
+}
+
+// deleteEtcdPVCs deletes the PVCs (and the corresponding pods) of count randomly
+// chosen etcd members and waits until all of the PVCs have been recreated.
+func deleteEtcdPVCs(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, count int) error {
 	ns := clusterNamespace(cluster)
 
 	selector, err := labels.Parse("app=etcd")
@@ -746,37 +1188,47 @@ func deleteEtcdPVC(ctx context.Context, client ctrlruntimeclient.Client, cluster
 		return fmt.Errorf("failed to list PVCs or empty list in cluster namespace: %w", err)
 	}
 
-	// pick a random PVC and get the corresponding pod
-	index := rand.Intn(len(pvcList.Items))
-	pvc := pvcList.Items[index]
-	oldPvc := pvc.DeepCopy()
+	if count > len(pvcList.Items) {
+		return fmt.Errorf("cannot delete %d PVCs, only %d exist", count, len(pvcList.Items))
+	}
 
 	podList := &corev1.PodList{}
 	if err := client.List(ctx, podList, opt); err != nil || len(podList.Items) != len(pvcList.Items) {
 		return fmt.Errorf("failed to list etcd pods or bad number of pods: %w", err)
 	}
 
-	pod := podList.Items[index]
+	// pick random PVCs and get the corresponding pods
+	oldPvcs := []*corev1.PersistentVolumeClaim{}
+	for _, index := range rand.Perm(len(pvcList.Items))[:count] {
+		pvc := pvcList.Items[index]
+		pod := podList.Items[index]
 
-	// first, we delete it
-	if err := client.Delete(ctx, &pvc); err != nil {
-		return fmt.Errorf("failed to delete etcd node PVC %s: %w", pvc.Name, err)
-	}
+		// first, we delete it
+		if err := client.Delete(ctx, &pvc); err != nil {
+			return fmt.Errorf("failed to delete etcd node PVC %s: %w", pvc.Name, err)
+		}
 
-	// now, we delete the pod so the PVC can be finalised
-	if err := client.Delete(ctx, &pod); err != nil {
-		return fmt.Errorf("failed to delete etcd pod %s: %w", pod.Name, err)
+		// now, we delete the pod so the PVC can be finalised
+		if err := client.Delete(ctx, &pod); err != nil {
+			return fmt.Errorf("failed to delete etcd pod %s: %w", pod.Name, err)
+		}
+
+		oldPvcs = append(oldPvcs, pvc.DeepCopy())
 	}
 
-	// make sure the PVC is recreated by checking the CreationTimestamp against a DeepCopy
+	// make sure the PVCs are recreated by checking the CreationTimestamp against a DeepCopy
 	// created of the PVC resource.
 	return wait.PollImmediate(2*time.Second, 3*time.Minute, func() (bool, error) {
-		if err := client.Get(ctx, types.NamespacedName{Name: pvc.Name, Namespace: pvc.Namespace}, &pvc); err == nil {
-			if oldPvc.ObjectMeta.CreationTimestamp.Before(&pvc.ObjectMeta.CreationTimestamp) {
-				return true, nil
+		for _, oldPvc := range oldPvcs {
+			pvc := &corev1.PersistentVolumeClaim{}
+			if err := client.Get(ctx, types.NamespacedName{Name: oldPvc.Name, Namespace: oldPvc.Namespace}, pvc); err != nil {
+				return false, nil
+			}
+			if !oldPvc.ObjectMeta.CreationTimestamp.Before(&pvc.ObjectMeta.CreationTimestamp) {
+				return false, nil
 			}
 		}
-		return false, nil
+		return true, nil
 	})
 }
 
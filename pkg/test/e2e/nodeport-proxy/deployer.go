@@ -124,13 +124,13 @@ func (d *Deployer) SetUp(ctx context.Context) error {
 	if err := reconciling.ReconcileClusterRoles(ctx,
 		[]reconciling.NamedClusterRoleCreatorGetter{
 			nodeportproxy.ClusterRoleCreator(cfg),
-		}, "", d.Client, recorderFunc); err != nil {
+		}, d.Client, recorderFunc); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRole: %w", err)
 	}
 	if err := reconciling.ReconcileClusterRoleBindings(ctx,
 		[]reconciling.NamedClusterRoleBindingCreatorGetter{
 			nodeportproxy.ClusterRoleBindingCreator(cfg),
-		}, "", d.Client, recorderFunc); err != nil {
+		}, d.Client, recorderFunc); err != nil {
 		return fmt.Errorf("failed to reconcile ClusterRoleBinding: %w", err)
 	}
 	if err := reconciling.ReconcileServices(ctx,
@@ -50,6 +50,10 @@ type AzureCloudSpec struct {
 	// the generated route table's name. If no subnet is defined at cluster creation, this field should be empty as well.
 	RouteTableName string `json:"routeTable,omitempty"`
 
+	// Optional: RouteTableResourceGroup optionally defines a second resource group that the route table referenced by `routeTable` lives in.
+	// If left empty, the route table uses the resource group defined by `resourceGroup`.
+	RouteTableResourceGroup string `json:"routeTableResourceGroup,omitempty"`
+
 	// The name of a security group associated with the subnet referenced by `subnet`.
 	// If set to empty string at cluster creation, a new security group will be created and this field will be updated to
 	// the generated security group's name. If no subnet is defined at cluster creation, this field should be empty as well.
@@ -38,6 +38,13 @@ type ClusterNetworkingConfig struct {
 	// +optional
 	NodeCIDRMaskSizeIPV6 int32 `json:"nodeCidrMaskSizeIPv6,omitempty"`
 
+	// NodeLocalDNSCacheAddress is the IPv4 link-local address the NodeLocal DNS Cache listens on
+	// and that is configured as the cluster DNS server on all nodes. Only used if the NodeLocal
+	// DNS Cache is enabled. If not set, 169.254.20.10 is used. Cannot be changed later on, as
+	// existing nodes keep the address they were provisioned with.
+	// +optional
+	NodeLocalDNSCacheAddress string `json:"nodeLocalDNSCacheAddress,omitempty"`
+
 	// NodeLocalDNSCacheEnabled controls whether the NodeLocal DNS Cache feature is enabled.
 	// Defaults to true.
 	NodeLocalDNSCacheEnabled bool `json:"nodeLocalDNSCacheEnabled,omitempty"`
@@ -23,6 +23,11 @@ type DatacenterSpecKubevirt struct {
 	// policy selected with DNSPolicy.
 	DNSPolicy string `json:"dnsPolicy,omitempty"`
 
+	// Optional: DisableVolumeDeletion removes the permission to delete DataVolumes from the Role used by the
+	// KubeVirt CSI driver in the infra cluster. Volumes of deleted user cluster PVCs then have to be cleaned
+	// up manually. Defaults to false.
+	DisableVolumeDeletion bool `json:"disableVolumeDeletion,omitempty"`
+
 	// dns config
 	DNSConfig *PodDNSConfig `json:"dnsConfig,omitempty"`
 }
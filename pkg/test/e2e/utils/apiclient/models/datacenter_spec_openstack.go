@@ -33,9 +33,17 @@ type DatacenterSpecOpenstack struct {
 	// Optional
 	EnforceFloatingIP bool `json:"enforceFloatingIP,omitempty"`
 
+	// Optional: Gets mapped to the "floating-network-id" setting in the cloud config.
+	// ID of the external network the CCM allocates floating IPs for load balancers from.
+	FloatingNetworkID string `json:"floatingNetworkID,omitempty"`
+
 	// Optional
 	IgnoreVolumeAZ bool `json:"ignoreVolumeAZ,omitempty"`
 
+	// Optional: Gets mapped to the "lb-provider" setting in the cloud config,
+	// for example "amphora" or "ovn".
+	LoadBalancerProvider string `json:"loadBalancerProvider,omitempty"`
+
 	// Optional: Gets mapped to the "manage-security-groups" setting in the cloud config.
 	// See https://kubernetes.io/docs/concepts/cluster-administration/cloud-providers/#load-balancer
 	// This setting defaults to true.
@@ -18,6 +18,9 @@ import (
 // swagger:model ExternalClusterStatus
 type ExternalClusterStatus struct {
 
+	// ProviderState is the unmodified state reported by the cloud provider.
+	ProviderState string `json:"providerState,omitempty"`
+
 	// status message
 	StatusMessage string `json:"statusMessage,omitempty"`
 
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -19,15 +20,71 @@ type FakeCloudSpec struct {
 
 	// token
 	Token string `json:"token,omitempty"`
+
+	// credentials reference
+	CredentialsReference *GlobalSecretKeySelector `json:"credentialsReference,omitempty"`
 }
 
 // Validate validates this fake cloud spec
 func (m *FakeCloudSpec) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCredentialsReference(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FakeCloudSpec) validateCredentialsReference(formats strfmt.Registry) error {
+	if swag.IsZero(m.CredentialsReference) { // not required
+		return nil
+	}
+
+	if m.CredentialsReference != nil {
+		if err := m.CredentialsReference.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("credentialsReference")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("credentialsReference")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this fake cloud spec based on context it is used
+// ContextValidate validate this fake cloud spec based on the context it is used
 func (m *FakeCloudSpec) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCredentialsReference(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FakeCloudSpec) contextValidateCredentialsReference(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.CredentialsReference != nil {
+		if err := m.CredentialsReference.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("credentialsReference")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("credentialsReference")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -36,6 +36,14 @@ type OpenstackCloudSpec struct {
 	// Note that the network is external if the "External" field is set to true
 	FloatingIPPool string `json:"floatingIPPool,omitempty"`
 
+	// FloatingNetworkID is the ID of the external network the cloud controller
+	// manager allocates floating IPs for LoadBalancer services from.
+	//
+	// Takes precedence over the 'floatingNetworkID' provided at datacenter
+	// level if both are specified.
+	// +optional
+	FloatingNetworkID string `json:"floatingNetworkID,omitempty"`
+
 	// IPv6SubnetID holds the ID of the subnet used for IPv6 networking.
 	// If not provided, a new subnet will be created if IPv6 is enabled.
 	// +optional
@@ -46,6 +54,14 @@ type OpenstackCloudSpec struct {
 	// +optional
 	IPV6SubnetPool string `json:"ipv6SubnetPool,omitempty"`
 
+	// LoadBalancerProvider is the Octavia provider used for LoadBalancer
+	// services, for example "amphora" or "ovn".
+	//
+	// Takes precedence over the 'loadBalancerProvider' provided at datacenter
+	// level if both are specified.
+	// +optional
+	LoadBalancerProvider string `json:"loadBalancerProvider,omitempty"`
+
 	// Network holds the name of the internal network
 	// When specified, all worker nodes will be attached to this network. If not specified, a network, subnet & router will be created
 	//
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -26,17 +27,74 @@ type PreAllocatedDataVolume struct {
 	// storage class
 	StorageClass string `json:"storageClass,omitempty"`
 
-	// URL
+	// URL is the HTTP(S) URL of the image to import into the DataVolume.
+	// Exactly one of URL and SourcePVC must be set.
 	URL string `json:"url,omitempty"`
+
+	// source p v c
+	SourcePVC *PreAllocatedDataVolumeSourcePVC `json:"sourcePVC,omitempty"`
 }
 
 // Validate validates this pre allocated data volume
 func (m *PreAllocatedDataVolume) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSourcePVC(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PreAllocatedDataVolume) validateSourcePVC(formats strfmt.Registry) error {
+	if swag.IsZero(m.SourcePVC) { // not required
+		return nil
+	}
+
+	if m.SourcePVC != nil {
+		if err := m.SourcePVC.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sourcePVC")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sourcePVC")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this pre allocated data volume based on context it is used
+// ContextValidate validate this pre allocated data volume based on the context it is used
 func (m *PreAllocatedDataVolume) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSourcePVC(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PreAllocatedDataVolume) contextValidateSourcePVC(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.SourcePVC != nil {
+		if err := m.SourcePVC.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sourcePVC")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sourcePVC")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -14,9 +14,11 @@ import (
 
 // UpdateWindow UpdateWindow allows defining windows for maintenance tasks related to OS updates.
 //
-// This is only applied to cluster nodes using Flatcar Linux.
-// The reference time for this is the node system time and might differ from
-// the user's timezone, which needs to be considered when configuring a window.
+// This is applied to cluster nodes using Flatcar Linux and to control plane version
+// updates, which are deferred until the window opens.
+// The reference time for this is the node system time (or the seed system time for the
+// control plane) and might differ from the user's timezone, which needs to be considered
+// when configuring a window.
 //
 // swagger:model UpdateWindow
 type UpdateWindow struct {
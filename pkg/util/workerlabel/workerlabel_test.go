@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workerlabel
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestPredicates(t *testing.T) {
+	testCases := []struct {
+		name       string
+		workerName string
+		labels     map[string]string
+		expected   bool
+	}{
+		{
+			name:       "object with matching worker-name label is included",
+			workerName: "worker-a",
+			labels:     map[string]string{kubermaticv1.WorkerNameLabelKey: "worker-a"},
+			expected:   true,
+		},
+		{
+			name:       "object with different worker-name label is excluded",
+			workerName: "worker-a",
+			labels:     map[string]string{kubermaticv1.WorkerNameLabelKey: "worker-b"},
+			expected:   false,
+		},
+		{
+			name:       "object without worker-name label is excluded when a worker name is set",
+			workerName: "worker-a",
+			expected:   false,
+		},
+		{
+			name:     "object without worker-name label is included when no worker name is set",
+			expected: true,
+		},
+		{
+			name:     "object with worker-name label is excluded when no worker name is set",
+			labels:   map[string]string{kubermaticv1.WorkerNameLabelKey: "worker-a"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "test-cluster",
+					Labels: tc.labels,
+				},
+			}
+
+			p := Predicates(tc.workerName)
+
+			if result := p.Create(event.CreateEvent{Object: cluster}); result != tc.expected {
+				t.Errorf("Expected create event to return %v, got %v", tc.expected, result)
+			}
+			if result := p.Update(event.UpdateEvent{ObjectOld: cluster, ObjectNew: cluster}); result != tc.expected {
+				t.Errorf("Expected update event to return %v, got %v", tc.expected, result)
+			}
+			if result := p.Delete(event.DeleteEvent{Object: cluster}); result != tc.expected {
+				t.Errorf("Expected delete event to return %v, got %v", tc.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"encoding/json"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateAddon validates the Addon's spec, so that invalid addons are rejected
+// before the addon controller tries to apply them.
+func ValidateAddon(addon *kubermaticv1.Addon) field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := field.ErrorList{}
+
+	if addon.Spec.Name == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("name"), "no addon name specified"))
+	}
+
+	if addon.Spec.Variables != nil && len(addon.Spec.Variables.Raw) > 0 && !json.Valid(addon.Spec.Variables.Raw) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("variables"), string(addon.Spec.Variables.Raw), "variables must be valid JSON"))
+	}
+
+	for i, gvk := range addon.Spec.RequiredResourceTypes {
+		gvkPath := specPath.Child("requiredResourceTypes").Index(i)
+
+		if gvk.Group == "" {
+			allErrs = append(allErrs, field.Required(gvkPath.Child("group"), "no group specified"))
+		}
+		if gvk.Version == "" {
+			allErrs = append(allErrs, field.Required(gvkPath.Child("version"), "no version specified"))
+		}
+		if gvk.Kind == "" {
+			allErrs = append(allErrs, field.Required(gvkPath.Child("kind"), "no kind specified"))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestValidateAddon(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    kubermaticv1.AddonSpec
+		wantErr bool
+	}{
+		{
+			name: "valid addon",
+			spec: kubermaticv1.AddonSpec{
+				Name:      "canal",
+				Variables: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				RequiredResourceTypes: []kubermaticv1.GroupVersionKind{
+					{Group: "cluster.k8s.io", Version: "v1alpha1", Kind: "MachineDeployment"},
+				},
+			},
+		},
+		{
+			name: "valid addon with empty variables",
+			spec: kubermaticv1.AddonSpec{
+				Name:      "canal",
+				Variables: &runtime.RawExtension{},
+			},
+		},
+		{
+			name:    "missing name",
+			spec:    kubermaticv1.AddonSpec{},
+			wantErr: true,
+		},
+		{
+			name: "variables are not valid JSON",
+			spec: kubermaticv1.AddonSpec{
+				Name:      "canal",
+				Variables: &runtime.RawExtension{Raw: []byte(`{"foo":`)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "required resource type without group",
+			spec: kubermaticv1.AddonSpec{
+				Name: "canal",
+				RequiredResourceTypes: []kubermaticv1.GroupVersionKind{
+					{Version: "v1", Kind: "ConfigMap"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "required resource type without kind",
+			spec: kubermaticv1.AddonSpec{
+				Name: "canal",
+				RequiredResourceTypes: []kubermaticv1.GroupVersionKind{
+					{Group: "cluster.k8s.io", Version: "v1alpha1"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateAddon(&kubermaticv1.Addon{Spec: tt.spec})
+
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Expected error: %v, got: %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
@@ -20,35 +20,80 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
 
 	semverlib "github.com/Masterminds/semver/v3"
 	"github.com/coreos/locksmith/pkg/timeutil"
+	providerconfig "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
 	"k8c.io/kubermatic/v2/pkg/version"
 	"k8c.io/kubermatic/v2/pkg/version/cni"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kubenetutil "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
 	// ErrCloudChangeNotAllowed describes that it is not allowed to change the cloud provider.
-	ErrCloudChangeNotAllowed  = errors.New("not allowed to change the cloud provider")
+	ErrCloudChangeNotAllowed = errors.New("not allowed to change the cloud provider")
+	// ErrDatacenterChangeNotAllowed describes that it is not allowed to change the datacenter.
+	ErrDatacenterChangeNotAllowed = errors.New("changing the datacenter is not allowed")
+
 	azureLoadBalancerSKUTypes = sets.NewString("", string(kubermaticv1.AzureStandardLBSKU), string(kubermaticv1.AzureBasicLBSKU))
 
 	// UnsafeCNIUpgradeLabel allows unsafe CNI version upgrade (difference in versions more than one minor version).
 	UnsafeCNIUpgradeLabel = "unsafe-cni-upgrade"
 	// UnsafeCNIMigrationLabel allows unsafe CNI type migration.
 	UnsafeCNIMigrationLabel = "unsafe-cni-migration"
+	// UnsafeVersionDowngradeLabel allows downgrading the control plane version.
+	UnsafeVersionDowngradeLabel = "unsafe-version-downgrade"
+
+	// supportedContainerRuntimes lists the container runtimes that can be provisioned on
+	// each operating system. SLES nodes always use the docker installation shipped with the image.
+	supportedContainerRuntimes = map[providerconfig.OperatingSystem]sets.String{
+		providerconfig.OperatingSystemUbuntu:       sets.NewString(resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd),
+		providerconfig.OperatingSystemCentOS:       sets.NewString(resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd),
+		providerconfig.OperatingSystemAmazonLinux2: sets.NewString(resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd),
+		providerconfig.OperatingSystemRHEL:         sets.NewString(resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd),
+		providerconfig.OperatingSystemFlatcar:      sets.NewString(resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd),
+		providerconfig.OperatingSystemRockyLinux:   sets.NewString(resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd),
+		providerconfig.OperatingSystemSLES:         sets.NewString(resources.ContainerRuntimeDocker),
+	}
+
+	// supportedIPVSSchedulers lists the IPVS schedulers kube-proxy can be configured with.
+	supportedIPVSSchedulers = sets.NewString("rr", "lc", "dh", "sh", "sed", "nq")
+)
+
+const (
+	// maxConntrackMaxPerCore and maxConntrackMin bound the kube-proxy conntrack settings
+	// to values that do not exhaust the node memory.
+	maxConntrackMaxPerCore = 1048576
+	maxConntrackMin        = 16777216
+
+	// minMetricResolution and maxMetricResolution bound the metrics-server scrape interval
+	// to values that neither overload the kubelets nor render autoscaling useless.
+	minMetricResolution = 10 * time.Second
+	maxMetricResolution = 5 * time.Minute
 )
 
 // ValidateClusterSpec validates the given cluster spec. If this is not called from within another validation
@@ -108,6 +153,20 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 
 	allErrs = append(allErrs, ValidateLeaderElectionSettings(&spec.ComponentsOverride.ControllerManager.LeaderElectionSettings, parentFieldPath.Child("componentsOverride", "controllerManager", "leaderElection"))...)
 	allErrs = append(allErrs, ValidateLeaderElectionSettings(&spec.ComponentsOverride.Scheduler.LeaderElectionSettings, parentFieldPath.Child("componentsOverride", "scheduler", "leaderElection"))...)
+	allErrs = append(allErrs, ValidateEtcdDefragmentationSchedule(spec.ComponentsOverride.Etcd.DefragmentationSchedule, parentFieldPath.Child("componentsOverride", "etcd", "defragmentationSchedule"))...)
+	allErrs = append(allErrs, validateComponentSettings(&spec.ComponentsOverride, parentFieldPath.Child("componentsOverride"))...)
+
+	if spec.ProxySettings != nil {
+		allErrs = append(allErrs, ValidateProxySettings(spec.ProxySettings, parentFieldPath.Child("proxySettings"))...)
+	}
+
+	if spec.MetricsServer != nil {
+		allErrs = append(allErrs, validateMetricsServerSettings(spec.MetricsServer, parentFieldPath.Child("metricsServer"))...)
+	}
+
+	if spec.OPAIntegration != nil {
+		allErrs = append(allErrs, ValidateOPASyncResources(spec.OPAIntegration.SyncResources, parentFieldPath.Child("opaIntegration", "syncResources"))...)
+	}
 
 	// general cloud spec logic
 	if errs := ValidateCloudSpec(spec.Cloud, dc, parentFieldPath.Child("cloud")); len(errs) > 0 {
@@ -118,7 +177,15 @@ func ValidateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datace
 		allErrs = append(allErrs, errs...)
 	}
 
-	if errs := ValidateClusterNetworkConfig(&spec.ClusterNetwork, spec.CNIPlugin, parentFieldPath.Child("networkConfig")); len(errs) > 0 {
+	if errs := ValidateClusterNetworkConfig(&spec.ClusterNetwork, parentFieldPath.Child("networkConfig")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := validateKonnectivitySettings(spec, parentFieldPath); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := validateProxyModeCompatibility(spec, parentFieldPath.Child("networkConfig", "proxyMode")); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
 
@@ -146,6 +213,12 @@ func ValidateNewClusterSpec(ctx context.Context, spec *kubermaticv1.ClusterSpec,
 		allErrs = append(allErrs, errs...)
 	}
 
+	allErrs = append(allErrs, ValidateEtcdClusterSize(spec.ComponentsOverride.Etcd.ClusterSize, parentFieldPath.Child("componentsOverride", "etcd", "clusterSize"))...)
+
+	if spec.ServiceAccount != nil {
+		allErrs = append(allErrs, validateServiceAccountSettings(spec.ServiceAccount, parentFieldPath.Child("serviceAccount"))...)
+	}
+
 	if cloudProvider != nil {
 		if err := cloudProvider.ValidateCloudSpec(ctx, spec.Cloud); err != nil {
 			// Just using spec.Cloud for the error leads to a Go-representation of the struct being printed in
@@ -182,7 +255,11 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 
 	// ensure neither cloud nor datacenter were changed
 	if err := ValidateCloudChange(newCluster.Spec.Cloud, oldCluster.Spec.Cloud); err != nil {
-		allErrs = append(allErrs, field.Forbidden(specPath.Child("cloud"), err.Error()))
+		if errors.Is(err, ErrDatacenterChangeNotAllowed) {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("cloud", "dc"), err.Error()))
+		} else {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("cloud"), err.Error()))
+		}
 	}
 
 	if newCluster.Address.AdminToken != "" {
@@ -221,16 +298,9 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 		)...)
 	}
 
-	if oldCluster.Spec.EnableUserSSHKeyAgent != nil {
-		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
-			newCluster.Spec.EnableUserSSHKeyAgent,
-			oldCluster.Spec.EnableUserSSHKeyAgent,
-			specPath.Child("enableUserSSHKeyAgent"),
-		)...)
-	} else if newCluster.Spec.EnableUserSSHKeyAgent != nil && !*newCluster.Spec.EnableUserSSHKeyAgent {
-		path := field.NewPath("cluster", "spec", "enableUserSSHKeyAgent")
-		allErrs = append(allErrs, field.Invalid(path, *newCluster.Spec.EnableUserSSHKeyAgent, "UserSSHKey agent is enabled by default for user clusters created prior KKP 2.16 version"))
-	}
+	allErrs = append(allErrs, validateEtcdClusterSizeUpdate(newCluster.Spec.ComponentsOverride.Etcd.ClusterSize, oldCluster.Spec.ComponentsOverride.Etcd.ClusterSize, specPath.Child("componentsOverride", "etcd", "clusterSize"))...)
+	allErrs = append(allErrs, validateServiceAccountSettingsUpdate(newCluster.Spec.ServiceAccount, oldCluster.Spec.ServiceAccount, specPath.Child("serviceAccount"))...)
+	allErrs = append(allErrs, validateSSHKeyAgentUpdate(newCluster.Spec.EnableUserSSHKeyAgent, oldCluster.Spec.EnableUserSSHKeyAgent, specPath.Child("enableUserSSHKeyAgent"))...)
 
 	// EnableOperatingSystemManager is immutable field as of now but in future this field will be mutable
 	if oldCluster.Spec.EnableOperatingSystemManager != newCluster.Spec.EnableOperatingSystemManager {
@@ -250,6 +320,10 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 		allErrs = append(allErrs, err)
 	}
 
+	if err := validateVersionUpdate(&newCluster.Spec.Version, &oldCluster.Spec.Version, newCluster.Labels); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if errs := validateEncryptionUpdate(newCluster, oldCluster); len(errs) > 0 {
 		allErrs = append(allErrs, errs...)
 	}
@@ -261,7 +335,7 @@ func ValidateClusterUpdate(ctx context.Context, newCluster, oldCluster *kubermat
 	return allErrs
 }
 
-func ValidateClusterNetworkConfig(n *kubermaticv1.ClusterNetworkingConfig, cni *kubermaticv1.CNIPluginSettings, fldPath *field.Path) field.ErrorList {
+func ValidateClusterNetworkConfig(n *kubermaticv1.ClusterNetworkingConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	// Maximum 2 (one IPv4 + one IPv6) CIDR blocks are allowed
 	if len(n.Pods.CIDRBlocks) > 2 {
@@ -295,6 +369,13 @@ func ValidateClusterNetworkConfig(n *kubermaticv1.ClusterNetworkingConfig, cni *
 		allErrs = append(allErrs, err)
 	}
 
+	// Verify that pod and service CIDRs do not overlap
+	for i, cidr := range n.Services.CIDRBlocks {
+		if err := validateCIDRNotOverlapping(cidr, "service", n.Pods.CIDRBlocks, "pod", fldPath.Child("services", "cidrBlocks").Index(i)); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
 	// Verify that IP family is consistent with provided pod CIDRs
 	if (n.IPFamily == kubermaticv1.IPFamilyIPv4) && len(n.Pods.CIDRBlocks) != 1 {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipFamily"), n.IPFamily,
@@ -325,19 +406,151 @@ func ValidateClusterNetworkConfig(n *kubermaticv1.ClusterNetworkingConfig, cni *
 			[]string{resources.IPVSProxyMode, resources.IPTablesProxyMode, resources.EBPFProxyMode}))
 	}
 
-	if n.ProxyMode == resources.EBPFProxyMode && (cni == nil || cni.Type != kubermaticv1.CNIPluginTypeCilium) {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("proxyMode"), n.ProxyMode,
+	if n.IPVS != nil && n.IPVS.Scheduler != "" && !supportedIPVSSchedulers.Has(n.IPVS.Scheduler) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("ipvs", "scheduler"), n.IPVS.Scheduler, supportedIPVSSchedulers.List()))
+	}
+
+	if n.Conntrack != nil {
+		allErrs = append(allErrs, validateConntrackConfiguration(n.Conntrack, fldPath.Child("conntrack"))...)
+	}
+
+	if n.NodeLocalDNSCacheAddress != "" {
+		if ip := net.ParseIP(n.NodeLocalDNSCacheAddress); ip == nil || ip.To4() == nil || !ip.IsLinkLocalUnicast() {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeLocalDNSCacheAddress"), n.NodeLocalDNSCacheAddress, "must be an IPv4 link-local address (169.254.0.0/16)"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateConntrackConfiguration(c *kubermaticv1.ConntrackConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if c.MaxPerCore != nil && (*c.MaxPerCore < 0 || *c.MaxPerCore > maxConntrackMaxPerCore) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPerCore"), *c.MaxPerCore,
+			fmt.Sprintf("must be between 0 and %d", maxConntrackMaxPerCore)))
+	}
+
+	if c.Min != nil && (*c.Min < 0 || *c.Min > maxConntrackMin) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("min"), *c.Min,
+			fmt.Sprintf("must be between 0 and %d", maxConntrackMin)))
+	}
+
+	return allErrs
+}
+
+// validateProxyModeCompatibility ensures that the kube-proxy mode can be used with the configured CNI
+// plugin, control plane tunnel and expose strategy. All rules that involve more than the proxy mode
+// itself are kept here.
+func validateProxyModeCompatibility(spec *kubermaticv1.ClusterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.ClusterNetwork.ProxyMode != resources.EBPFProxyMode {
+		return allErrs
+	}
+
+	if spec.CNIPlugin == nil || spec.CNIPlugin.Type != kubermaticv1.CNIPluginTypeCilium {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
 			fmt.Sprintf("%s proxy mode is valid only for %s CNI", resources.EBPFProxyMode, kubermaticv1.CNIPluginTypeCilium)))
 	}
 
-	if n.ProxyMode == resources.EBPFProxyMode && (n.KonnectivityEnabled == nil || !*n.KonnectivityEnabled) {
-		allErrs = append(allErrs, field.Invalid(fldPath.Child("proxyMode"), n.ProxyMode,
+	if spec.ClusterNetwork.KonnectivityEnabled == nil || !*spec.ClusterNetwork.KonnectivityEnabled {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
 			fmt.Sprintf("%s proxy mode can be used only when Konnectivity is enabled", resources.EBPFProxyMode)))
 	}
 
+	// with kube-proxy replaced, Cilium talks to the API server via the cluster address directly,
+	// which is not reachable from the nodes without going through the tunneling agent
+	if spec.ExposeStrategy == kubermaticv1.ExposeStrategyTunneling {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("%s proxy mode cannot be used with the %s expose strategy", resources.EBPFProxyMode, kubermaticv1.ExposeStrategyTunneling)))
+	}
+
+	return allErrs
+}
+
+// validateKonnectivitySettings ensures that settings which only apply to one of the control plane
+// to node tunnels (Konnectivity or OpenVPN) are not configured while the other one is in use.
+func validateKonnectivitySettings(spec *kubermaticv1.ClusterSpec, parentFieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	konnectivityEnabled := spec.ClusterNetwork.KonnectivityEnabled != nil && *spec.ClusterNetwork.KonnectivityEnabled
+
+	if !konnectivityEnabled && spec.ComponentsOverride.KonnectivityProxy.Resources != nil {
+		allErrs = append(allErrs, field.Forbidden(parentFieldPath.Child("componentsOverride", "konnectivityProxy"),
+			"konnectivity-server settings cannot be configured when Konnectivity is disabled, OpenVPN is used instead"))
+	}
+
+	return allErrs
+}
+
+func validateMetricsServerSettings(settings *kubermaticv1.MetricsServerSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if r := settings.MetricResolution; r != nil && (r.Duration < minMetricResolution || r.Duration > maxMetricResolution) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("metricResolution"), r.Duration.String(),
+			fmt.Sprintf("must be between %v and %v", minMetricResolution, maxMetricResolution)))
+	}
+
 	return allErrs
 }
 
+// EncryptionConfigurationWarnings returns warnings for cluster specs that enable the
+// encryption-at-rest feature without configuring encryption, which is a silent no-op.
+func EncryptionConfigurationWarnings(spec *kubermaticv1.ClusterSpec) []string {
+	if !spec.Features[kubermaticv1.ClusterFeatureEncryptionAtRest] {
+		return nil
+	}
+
+	if spec.EncryptionConfiguration == nil || !spec.EncryptionConfiguration.Enabled {
+		return []string{fmt.Sprintf("feature '%s' is enabled, but no data is encrypted until spec.encryptionConfiguration is enabled and an encryption provider is configured", kubermaticv1.ClusterFeatureEncryptionAtRest)}
+	}
+
+	return nil
+}
+
+// ClusterSpecWarnings returns all non-fatal warnings for the given cluster spec. These are
+// complementary to ValidateClusterSpec and cover configurations that are valid, but likely
+// not what the user intended or scheduled for removal.
+func ClusterSpecWarnings(spec *kubermaticv1.ClusterSpec) []string {
+	warnings := EncryptionConfigurationWarnings(spec)
+	warnings = append(warnings, NodeCapacityWarnings(&spec.ClusterNetwork)...)
+	warnings = append(warnings, DeprecationWarnings(spec)...)
+
+	return warnings
+}
+
+// ClusterUpdateWarnings returns all non-fatal warnings for the given cluster update. These
+// are complementary to ValidateClusterUpdate. Deprecated settings are only warned about if
+// they were newly set or changed, so that unrelated updates do not repeat the same warnings.
+func ClusterUpdateWarnings(newCluster, oldCluster *kubermaticv1.Cluster) []string {
+	warnings := CNIDowngradeWarnings(newCluster, oldCluster)
+
+	existing := sets.NewString(DeprecationWarnings(&oldCluster.Spec)...)
+	for _, warning := range DeprecationWarnings(&newCluster.Spec) {
+		if !existing.Has(warning) {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	return warnings
+}
+
+// DeprecationWarnings returns warnings for deprecated settings that are still accepted.
+func DeprecationWarnings(spec *kubermaticv1.ClusterSpec) []string {
+	var warnings []string
+
+	if spec.ContainerRuntime == resources.ContainerRuntimeDocker {
+		warnings = append(warnings, fmt.Sprintf("container runtime %q is deprecated and not supported from Kubernetes 1.24 on, use %q instead", resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd))
+	}
+
+	if spec.Cloud.Azure != nil && spec.Cloud.Azure.LoadBalancerSKU == kubermaticv1.AzureBasicLBSKU {
+		warnings = append(warnings, fmt.Sprintf("Azure load balancer SKU %q is being retired, use %q instead", kubermaticv1.AzureBasicLBSKU, kubermaticv1.AzureStandardLBSKU))
+	}
+
+	return warnings
+}
+
 func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -366,9 +579,20 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 				}
 
 				if key.Value != "" && key.SecretRef != nil {
-					allErrs = append(allErrs, field.Invalid(childPath, key,
+					allErrs = append(allErrs, field.Invalid(childPath, redactSecretboxKey(key),
 						"'value' and 'secretRef' cannot be set at the same time"))
 				}
+
+				if key.SecretRef != nil {
+					if key.SecretRef.Name == "" {
+						allErrs = append(allErrs, field.Required(childPath.Child("secretRef", "name"),
+							"secretRef name is required"))
+					}
+					if key.SecretRef.Key == "" {
+						allErrs = append(allErrs, field.Required(childPath.Child("secretRef", "key"),
+							"secretRef key is required"))
+					}
+				}
 			}
 		}
 
@@ -378,6 +602,120 @@ func validateEncryptionConfiguration(spec *kubermaticv1.ClusterSpec, fieldPath *
 	return allErrs
 }
 
+// ValidateEncryptionConfigurationSecretRefs checks that all secretbox keys referencing a Secret point to
+// an existing Secret and key in the cluster namespace. Clusters without a namespace (i.e. new clusters)
+// are skipped, as their Secrets cannot exist yet; validateEncryptionConfiguration covers the structure.
+func ValidateEncryptionConfigurationSecretRefs(ctx context.Context, client ctrlruntimeclient.Reader, cluster *kubermaticv1.Cluster) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	config := cluster.Spec.EncryptionConfiguration
+	if config == nil || !config.Enabled || config.Secretbox == nil || cluster.Status.NamespaceName == "" {
+		return allErrs
+	}
+
+	for i, key := range config.Secretbox.Keys {
+		if key.SecretRef == nil || key.SecretRef.Name == "" || key.SecretRef.Key == "" {
+			continue
+		}
+
+		childPath := field.NewPath("spec", "encryptionConfiguration", "secretbox", "keys").Index(i).Child("secretRef")
+
+		secret := &corev1.Secret{}
+		if err := client.Get(ctx, types.NamespacedName{Name: key.SecretRef.Name, Namespace: cluster.Status.NamespaceName}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				allErrs = append(allErrs, field.NotFound(childPath.Child("name"), key.SecretRef.Name))
+			} else {
+				allErrs = append(allErrs, field.InternalError(childPath.Child("name"), err))
+			}
+			continue
+		}
+
+		if _, ok := secret.Data[key.SecretRef.Key]; !ok {
+			allErrs = append(allErrs, field.NotFound(childPath.Child("key"), key.SecretRef.Key))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateCloudSpecCredentialValues validates that the credentials referenced by token-based cloud
+// specs resolve to non-empty values. ValidateCloudSpec only checks that a reference is set, this
+// additionally needs a client to look up the referenced Secret.
+func ValidateCloudSpecCredentialValues(ctx context.Context, client ctrlruntimeclient.Reader, spec kubermaticv1.CloudSpec, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	type credential struct {
+		inlineValue string
+		key         string
+	}
+
+	var (
+		ref         *providerconfig.GlobalSecretKeySelector
+		credentials []credential
+		childPath   *field.Path
+	)
+
+	switch {
+	case spec.Digitalocean != nil:
+		ref = spec.Digitalocean.CredentialsReference
+		credentials = []credential{{spec.Digitalocean.Token, resources.DigitaloceanToken}}
+		childPath = fieldPath.Child("digitalocean", "credentialsReference")
+	case spec.Anexia != nil:
+		ref = spec.Anexia.CredentialsReference
+		credentials = []credential{{spec.Anexia.Token, resources.AnexiaToken}}
+		childPath = fieldPath.Child("anexia", "credentialsReference")
+	case spec.Hetzner != nil:
+		ref = spec.Hetzner.CredentialsReference
+		credentials = []credential{{spec.Hetzner.Token, resources.HetznerToken}}
+		childPath = fieldPath.Child("hetzner", "credentialsReference")
+	case spec.Fake != nil:
+		ref = spec.Fake.CredentialsReference
+		credentials = []credential{{spec.Fake.Token, resources.FakeToken}}
+		childPath = fieldPath.Child("fake", "credentialsReference")
+	case spec.Alibaba != nil:
+		ref = spec.Alibaba.CredentialsReference
+		credentials = []credential{
+			{spec.Alibaba.AccessKeyID, resources.AlibabaAccessKeyID},
+			{spec.Alibaba.AccessKeySecret, resources.AlibabaAccessKeySecret},
+		}
+		childPath = fieldPath.Child("alibaba", "credentialsReference")
+	default:
+		return allErrs
+	}
+
+	if ref == nil {
+		return allErrs
+	}
+
+	getValue := provider.SecretKeySelectorValueFuncFactory(ctx, client)
+
+	for _, c := range credentials {
+		if c.inlineValue != "" {
+			continue
+		}
+
+		value, err := getValue(ref, c.key)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(childPath, ref.Name, err.Error()))
+			continue
+		}
+
+		if strings.TrimSpace(value) == "" {
+			allErrs = append(allErrs, field.Invalid(childPath, ref.Name, fmt.Sprintf("secret key %q must not be empty", c.key)))
+		}
+	}
+
+	return allErrs
+}
+
+// redactSecretboxKey returns a copy of the given key that is safe to include in error messages.
+func redactSecretboxKey(key kubermaticv1.SecretboxKey) kubermaticv1.SecretboxKey {
+	if key.Value != "" {
+		key.Value = "<redacted>"
+	}
+	return key
+}
+
 func validateEncryptionUpdate(oldCluster *kubermaticv1.Cluster, newCluster *kubermaticv1.Cluster) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -444,6 +782,34 @@ func validateClusterCIDRBlocks(cidrBlocks []string, fldPath *field.Path) *field.
 	return nil
 }
 
+// validateCIDRNotOverlapping returns an error if cidr overlaps with any of the otherCIDRs.
+// CIDRs that cannot be parsed are skipped, as they are reported elsewhere.
+func validateCIDRNotOverlapping(cidr, kind string, otherCIDRs []string, otherKind string, fldPath *field.Path) *field.Error {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil
+	}
+
+	for _, otherCIDR := range otherCIDRs {
+		_, otherIPNet, err := net.ParseCIDR(otherCIDR)
+		if err != nil {
+			continue
+		}
+
+		if cidrsOverlap(ipNet, otherIPNet) {
+			return field.Invalid(fldPath, cidr, fmt.Sprintf("%s CIDR %q overlaps with %s CIDR %q", kind, cidr, otherKind, otherCIDR))
+		}
+	}
+
+	return nil
+}
+
+// cidrsOverlap returns true if the two networks share at least one address. As CIDR
+// ranges are aligned, this is the case if one contains the start of the other.
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
 func validateNodeCIDRMaskSize(nodeCIDRMaskSize *int32, podCIDR string, fldPath *field.Path) *field.Error {
 	if podCIDR == "" || nodeCIDRMaskSize == nil {
 		return nil
@@ -461,6 +827,73 @@ func validateNodeCIDRMaskSize(nodeCIDRMaskSize *int32, podCIDR string, fldPath *
 	return nil
 }
 
+// nodeCapacityWarningThreshold is the number of nodes below which a warning is
+// returned for a cluster network that cannot fit more nodes.
+const nodeCapacityWarningThreshold = 16
+
+// maxNodesForPodCIDR returns the number of nodes that fit into the given pod CIDR
+// if every node gets a subnet of the given mask size. ok is false if the maximum
+// cannot be determined, for example because the CIDR is invalid (which is reported
+// by validateNodeCIDRMaskSize).
+func maxNodesForPodCIDR(nodeCIDRMaskSize *int32, podCIDR string) (maxNodes uint64, ok bool) {
+	if podCIDR == "" || nodeCIDRMaskSize == nil {
+		return 0, false
+	}
+	_, podCIDRNet, err := net.ParseCIDR(podCIDR)
+	if err != nil {
+		return 0, false
+	}
+	podCIDRMaskSize, _ := podCIDRNet.Mask.Size()
+
+	diff := int(*nodeCIDRMaskSize) - podCIDRMaskSize
+	if diff <= 0 {
+		return 0, false
+	}
+	if diff >= 64 {
+		return math.MaxUint64, true
+	}
+
+	return 1 << diff, true
+}
+
+// ValidateNodeCapacity rejects cluster networks whose pod CIDRs cannot fit at least minNodes
+// nodes, given the configured node CIDR mask sizes. A minNodes of 0 disables the check.
+func ValidateNodeCapacity(n *kubermaticv1.ClusterNetworkingConfig, minNodes int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if minNodes <= 0 {
+		return allErrs
+	}
+
+	check := func(nodeCIDRMaskSize *int32, podCIDR string, fldPath *field.Path) {
+		if maxNodes, ok := maxNodesForPodCIDR(nodeCIDRMaskSize, podCIDR); ok && maxNodes < uint64(minNodes) {
+			allErrs = append(allErrs, field.Invalid(fldPath, *nodeCIDRMaskSize,
+				fmt.Sprintf("node CIDR mask size (%d) only allows %d nodes within the pod CIDR %q, but at least %d nodes are required", *nodeCIDRMaskSize, maxNodes, podCIDR, minNodes)))
+		}
+	}
+
+	check(n.NodeCIDRMaskSizeIPv4, n.Pods.GetIPv4CIDR(), fldPath.Child("nodeCidrMaskSizeIPv4"))
+	check(n.NodeCIDRMaskSizeIPv6, n.Pods.GetIPv6CIDR(), fldPath.Child("nodeCidrMaskSizeIPv6"))
+
+	return allErrs
+}
+
+// NodeCapacityWarnings returns warnings for cluster networks whose pod CIDRs can only fit
+// very few nodes, given the configured node CIDR mask sizes.
+func NodeCapacityWarnings(n *kubermaticv1.ClusterNetworkingConfig) []string {
+	var warnings []string
+
+	check := func(nodeCIDRMaskSize *int32, podCIDR string) {
+		if maxNodes, ok := maxNodesForPodCIDR(nodeCIDRMaskSize, podCIDR); ok && maxNodes < nodeCapacityWarningThreshold {
+			warnings = append(warnings, fmt.Sprintf("node CIDR mask size %d only allows %d nodes within the pod CIDR %q", *nodeCIDRMaskSize, maxNodes, podCIDR))
+		}
+	}
+
+	check(n.NodeCIDRMaskSizeIPv4, n.Pods.GetIPv4CIDR())
+	check(n.NodeCIDRMaskSizeIPv6, n.Pods.GetIPv6CIDR())
+
+	return warnings
+}
+
 func validateMachineNetworksFromClusterSpec(spec *kubermaticv1.ClusterSpec, parentFieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	networks := spec.MachineNetworks
@@ -480,6 +913,13 @@ func validateMachineNetworksFromClusterSpec(spec *kubermaticv1.ClusterSpec, pare
 			allErrs = append(allErrs, field.Invalid(basePath.Index(i), network.CIDR, fmt.Sprintf("could not parse CIDR: %v", err)))
 		}
 
+		if err := validateCIDRNotOverlapping(network.CIDR, "machine network", spec.ClusterNetwork.Pods.CIDRBlocks, "pod", basePath.Index(i)); err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if err := validateCIDRNotOverlapping(network.CIDR, "machine network", spec.ClusterNetwork.Services.CIDRBlocks, "service", basePath.Index(i)); err != nil {
+			allErrs = append(allErrs, err)
+		}
+
 		if net.ParseIP(network.Gateway) == nil {
 			allErrs = append(allErrs, field.Invalid(basePath.Index(i), network.Gateway, fmt.Sprintf("could not parse gateway: %v", err)))
 		}
@@ -496,10 +936,27 @@ func validateMachineNetworksFromClusterSpec(spec *kubermaticv1.ClusterSpec, pare
 	return allErrs
 }
 
+// validateSSHKeyAgentUpdate validates changes to EnableUserSSHKeyAgent. Once the field is set it
+// is immutable. Clusters created before KKP 2.16 do not have the field set, but always had the
+// agent deployed, so for them the field may only be set to true, which is what they are already
+// running with.
+func validateSSHKeyAgentUpdate(newValue, oldValue *bool, fldPath *field.Path) field.ErrorList {
+	switch {
+	case oldValue != nil:
+		return apimachineryvalidation.ValidateImmutableField(newValue, oldValue, fldPath)
+
+	case newValue != nil && !*newValue:
+		return field.ErrorList{field.Invalid(fldPath, *newValue, "the user SSH key agent cannot be disabled for clusters created before KKP 2.16, as it is already deployed")}
+
+	default:
+		return nil
+	}
+}
+
 // ValidateCloudChange validates if the cloud provider has been changed.
 func ValidateCloudChange(newSpec, oldSpec kubermaticv1.CloudSpec) error {
 	if newSpec.DatacenterName != oldSpec.DatacenterName {
-		return errors.New("changing the datacenter is not allowed")
+		return ErrDatacenterChangeNotAllowed
 	}
 
 	oldCloudProvider, err := provider.ClusterCloudProviderName(oldSpec)
@@ -519,6 +976,21 @@ func ValidateCloudChange(newSpec, oldSpec kubermaticv1.CloudSpec) error {
 	return nil
 }
 
+// ValidateCloudSpecStandalone validates the cloud spec like ValidateCloudSpec, but first derives the
+// provider name if it is not set, just like the mutation webhook does. This is meant for validating
+// specs outside of a cluster (e.g. linting manifests), where the webhook does not run. The given spec
+// is not modified.
+func ValidateCloudSpecStandalone(spec kubermaticv1.CloudSpec, dc *kubermaticv1.Datacenter, parentFieldPath *field.Path) field.ErrorList {
+	if spec.ProviderName == "" {
+		// if the provider cannot be determined, ValidateCloudSpec reports it
+		if providerName, err := provider.ClusterCloudProviderName(spec); err == nil {
+			spec.ProviderName = providerName
+		}
+	}
+
+	return ValidateCloudSpec(spec, dc, parentFieldPath)
+}
+
 // ValidateCloudSpec validates if the cloud spec is valid
 // If this is not called from within another validation
 // routine, parentFieldPath can be nil.
@@ -579,7 +1051,7 @@ func ValidateCloudSpec(spec kubermaticv1.CloudSpec, dc *kubermaticv1.Datacenter,
 	case spec.Fake != nil:
 		providerErr = validateFakeCloudSpec(spec.Fake)
 	case spec.GCP != nil:
-		providerErr = validateGCPCloudSpec(spec.GCP)
+		providerErr = validateGCPCloudSpec(spec.GCP, dc)
 	case spec.Hetzner != nil:
 		providerErr = validateHetznerCloudSpec(spec.Hetzner)
 	case spec.Kubevirt != nil:
@@ -605,7 +1077,75 @@ func ValidateCloudSpec(spec kubermaticv1.CloudSpec, dc *kubermaticv1.Datacenter,
 	return allErrs
 }
 
+// ValidateCloudSpecWithSeeds validates the given cloud spec like ValidateCloudSpec, but resolves the
+// referenced datacenter from all seeds returned by the seedsGetter instead of requiring the caller
+// to look it up first.
+func ValidateCloudSpecWithSeeds(ctx context.Context, spec kubermaticv1.CloudSpec, seedsGetter provider.SeedsGetter, parentFieldPath *field.Path) field.ErrorList {
+	if spec.DatacenterName == "" {
+		return ValidateCloudSpec(spec, nil, parentFieldPath)
+	}
+
+	seeds, err := seedsGetter()
+	if err != nil {
+		return field.ErrorList{field.InternalError(parentFieldPath.Child("dc"), fmt.Errorf("failed to list seeds: %w", err))}
+	}
+
+	var (
+		dc        *kubermaticv1.Datacenter
+		seedNames []string
+	)
+
+	for seedName, seed := range seeds {
+		if seedDC, ok := seed.Spec.Datacenters[spec.DatacenterName]; ok {
+			dc = seedDC.DeepCopy()
+			seedNames = append(seedNames, seedName)
+		}
+	}
+
+	switch len(seedNames) {
+	case 0:
+		return field.ErrorList{field.Invalid(parentFieldPath.Child("dc"), spec.DatacenterName, "datacenter not found in any seed")}
+	case 1:
+		return ValidateCloudSpec(spec, dc, parentFieldPath)
+	default:
+		sort.Strings(seedNames)
+		return field.ErrorList{field.Invalid(parentFieldPath.Child("dc"), spec.DatacenterName,
+			fmt.Sprintf("datacenter name is ambiguous, it is defined in multiple seeds (%s)", strings.Join(seedNames, ", ")))}
+	}
+}
+
+// validateExclusiveCredentials ensures that credentials are either given inline or via a
+// credentials reference, but not both. inlineFields maps the field names to their values.
+func validateExclusiveCredentials(ref *providerconfig.GlobalSecretKeySelector, inlineFields map[string]string) error {
+	if ref == nil || ref.Name == "" {
+		return nil
+	}
+
+	var setFields []string
+	for name, value := range inlineFields {
+		if value != "" {
+			setFields = append(setFields, name)
+		}
+	}
+
+	if len(setFields) > 0 {
+		sort.Strings(setFields)
+		return fmt.Errorf("credentialsReference cannot be used together with inline credentials (%s), use only one of them", strings.Join(setFields, ", "))
+	}
+
+	return nil
+}
+
 func validateOpenStackCloudSpec(spec *kubermaticv1.OpenstackCloudSpec, dc *kubermaticv1.Datacenter) error {
+	if err := validateExclusiveCredentials(spec.CredentialsReference, map[string]string{
+		"username":                    spec.Username,
+		"password":                    spec.Password,
+		"applicationCredentialID":     spec.ApplicationCredentialID,
+		"applicationCredentialSecret": spec.ApplicationCredentialSecret,
+	}); err != nil {
+		return err
+	}
+
 	// validate applicationCredentials
 	if spec.ApplicationCredentialID != "" && spec.ApplicationCredentialSecret == "" {
 		return errors.New("no applicationCredentialSecret specified")
@@ -657,6 +1197,13 @@ func validateOpenStackCloudSpec(spec *kubermaticv1.OpenstackCloudSpec, dc *kuber
 }
 
 func validateAWSCloudSpec(spec *kubermaticv1.AWSCloudSpec) error {
+	if err := validateExclusiveCredentials(spec.CredentialsReference, map[string]string{
+		"accessKeyID":     spec.AccessKeyID,
+		"secretAccessKey": spec.SecretAccessKey,
+	}); err != nil {
+		return err
+	}
+
 	if spec.AccessKeyID == "" {
 		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.AWSAccessKeyID); err != nil {
 			return err
@@ -679,7 +1226,13 @@ func validateAWSCloudSpec(spec *kubermaticv1.AWSCloudSpec) error {
 	return nil
 }
 
-func validateGCPCloudSpec(spec *kubermaticv1.GCPCloudSpec) error {
+func validateGCPCloudSpec(spec *kubermaticv1.GCPCloudSpec, dc *kubermaticv1.Datacenter) error {
+	if err := validateExclusiveCredentials(spec.CredentialsReference, map[string]string{
+		"serviceAccount": spec.ServiceAccount,
+	}); err != nil {
+		return err
+	}
+
 	if spec.ServiceAccount == "" {
 		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.GCPServiceAccount); err != nil {
 			return err
@@ -693,19 +1246,42 @@ func validateGCPCloudSpec(spec *kubermaticv1.GCPCloudSpec) error {
 	if err := spec.NodePortsAllowedIPRanges.Validate(); err != nil {
 		return err
 	}
+	if dc != nil && dc.Spec.GCP != nil && dc.Spec.GCP.Regional {
+		if err := validateGCPRegionalCloudSpec(spec, dc.Spec.GCP); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func validateHetznerCloudSpec(spec *kubermaticv1.HetznerCloudSpec) error {
-	if spec.Token == "" {
-		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.HetznerToken); err != nil {
-			return err
+// validateGCPRegionalCloudSpec validates the cluster's network settings for regional datacenters,
+// whose nodes are spread over all zones of the region. The datacenter's zone suffixes are
+// validated together with the Seed.
+func validateGCPRegionalCloudSpec(spec *kubermaticv1.GCPCloudSpec, dc *kubermaticv1.DatacenterSpecGCP) error {
+	if spec.Subnetwork == "" {
+		return nil
+	}
+
+	// the network is only defaulted if neither network nor subnetwork are set
+	if spec.Network == "" {
+		return errors.New("no network specified for subnetwork")
+	}
+
+	// subnetworks are referenced as "projects/<project>/regions/<region>/subnetworks/<name>"
+	parts := strings.Split(spec.Subnetwork, "/")
+	for i, part := range parts {
+		if part == "regions" && i+1 < len(parts) && parts[i+1] != dc.Region {
+			return fmt.Errorf("subnetwork is in region %q, but the datacenter is in region %q", parts[i+1], dc.Region)
 		}
 	}
 
 	return nil
 }
 
+func validateHetznerCloudSpec(spec *kubermaticv1.HetznerCloudSpec) error {
+	return validateTokenCredentials(spec.Token, spec.CredentialsReference, resources.HetznerToken)
+}
+
 func validatePacketCloudSpec(spec *kubermaticv1.PacketCloudSpec) error {
 	if spec.APIKey == "" {
 		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.PacketAPIKey); err != nil {
@@ -761,6 +1337,15 @@ func validateVMwareCloudDirectorCloudSpec(spec *kubermaticv1.VMwareCloudDirector
 }
 
 func validateAzureCloudSpec(spec *kubermaticv1.AzureCloudSpec) error {
+	if err := validateExclusiveCredentials(spec.CredentialsReference, map[string]string{
+		"tenantID":       spec.TenantID,
+		"subscriptionID": spec.SubscriptionID,
+		"clientID":       spec.ClientID,
+		"clientSecret":   spec.ClientSecret,
+	}); err != nil {
+		return err
+	}
+
 	if spec.TenantID == "" {
 		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.AzureTenantID); err != nil {
 			return err
@@ -797,25 +1382,25 @@ func validateAzureCloudSpec(spec *kubermaticv1.AzureCloudSpec) error {
 }
 
 func validateDigitaloceanCloudSpec(spec *kubermaticv1.DigitaloceanCloudSpec) error {
-	if spec.Token == "" {
-		if spec.CredentialsReference == nil {
-			return errors.New("no token or credentials reference specified")
-		}
-
-		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.DigitaloceanToken); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return validateTokenCredentials(spec.Token, spec.CredentialsReference, resources.DigitaloceanToken)
 }
 
 func validateFakeCloudSpec(spec *kubermaticv1.FakeCloudSpec) error {
-	if spec.Token == "" {
-		return errors.New("no token specified")
+	return validateTokenCredentials(spec.Token, spec.CredentialsReference, resources.FakeToken)
+}
+
+// validateTokenCredentials validates the credentials of providers that authenticate with a
+// single token. The token can be given either inline or via a credentials reference.
+func validateTokenCredentials(token string, credentialsReference *providerconfig.GlobalSecretKeySelector, key string) error {
+	if token != "" {
+		return nil
 	}
 
-	return nil
+	if credentialsReference == nil {
+		return errors.New("no token or credentials reference specified")
+	}
+
+	return kuberneteshelper.ValidateSecretKeySelector(credentialsReference, key)
 }
 
 func validateKubevirtCloudSpec(spec *kubermaticv1.KubevirtCloudSpec) error {
@@ -825,6 +1410,15 @@ func validateKubevirtCloudSpec(spec *kubermaticv1.KubevirtCloudSpec) error {
 		}
 	}
 
+	for _, dv := range spec.PreAllocatedDataVolumes {
+		if (dv.URL == "") == (dv.SourcePVC == nil) {
+			return fmt.Errorf("exactly one of url and sourcePVC must be specified for pre-allocated DataVolume %q", dv.Name)
+		}
+		if dv.SourcePVC != nil && (dv.SourcePVC.Namespace == "" || dv.SourcePVC.Name == "") {
+			return fmt.Errorf("sourcePVC of pre-allocated DataVolume %q must specify a namespace and a name", dv.Name)
+		}
+	}
+
 	return nil
 }
 
@@ -843,17 +1437,7 @@ func validateAlibabaCloudSpec(spec *kubermaticv1.AlibabaCloudSpec) error {
 }
 
 func validateAnexiaCloudSpec(spec *kubermaticv1.AnexiaCloudSpec) error {
-	if spec.Token == "" {
-		if spec.CredentialsReference == nil {
-			return errors.New("no token or credentials reference specified")
-		}
-
-		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.AnexiaToken); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return validateTokenCredentials(spec.Token, spec.CredentialsReference, resources.AnexiaToken)
 }
 
 func validateNutanixCloudSpec(spec *kubermaticv1.NutanixCloudSpec) error {
@@ -910,11 +1494,21 @@ func validateNutanixCloudSpec(spec *kubermaticv1.NutanixCloudSpec) error {
 		return errors.New("CSI Endpoint mut not be empty")
 	}
 
+	// the CSI config is rendered as "endpoint:port", so the endpoint must not contain
+	// a scheme or path
+	if strings.Contains(spec.CSI.Endpoint, "://") || strings.Contains(spec.CSI.Endpoint, "/") {
+		return fmt.Errorf("CSI Endpoint %q must be a host name or IP address without scheme or path", spec.CSI.Endpoint)
+	}
+
 	// should never happen due to defaulting
 	if spec.CSI.Port == nil {
 		return errors.New("CSI Port mut not be empty")
 	}
 
+	if port := *spec.CSI.Port; port < 1 || port > 65535 {
+		return fmt.Errorf("CSI Port %d is invalid, must be between 1 and 65535", port)
+	}
+
 	return nil
 }
 
@@ -928,18 +1522,102 @@ func ValidateUpdateWindow(updateWindow *kubermaticv1.UpdateWindow) error {
 	return nil
 }
 
-func ValidateContainerRuntime(spec *kubermaticv1.ClusterSpec) error {
-	if !sets.NewString("docker", "containerd").Has(spec.ContainerRuntime) {
+// ValidateTunnelingAgentIP validates that the tunneling agent IP does not fall within the
+// pods or services CIDRs of the cluster, as traffic to the agent would not leave the node then.
+func ValidateTunnelingAgentIP(agentIP string, network kubermaticv1.ClusterNetworkingConfig) error {
+	ip := net.ParseIP(agentIP)
+	if ip == nil {
+		return fmt.Errorf("invalid tunneling agent IP %q", agentIP)
+	}
+
+	ranges := map[string][]string{
+		"pods":     network.Pods.CIDRBlocks,
+		"services": network.Services.CIDRBlocks,
+	}
+
+	for _, name := range []string{"pods", "services"} {
+		for _, cidr := range ranges[name] {
+			_, ipNet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return fmt.Errorf("invalid %s CIDR %q: %w", name, cidr, err)
+			}
+
+			if ipNet.Contains(ip) {
+				return fmt.Errorf("tunneling agent IP %s overlaps with the %s CIDR %s", ip, name, cidr)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateContainerRuntime validates the container runtime of the cluster. If a datacenter
+// is given, the runtime must also be supported by at least one of the operating systems
+// the datacenter offers images for.
+func ValidateContainerRuntime(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.Datacenter) error {
+	if !sets.NewString(resources.ContainerRuntimeDocker, resources.ContainerRuntimeContainerd).Has(spec.ContainerRuntime) {
 		return fmt.Errorf("container runtime not supported: %s", spec.ContainerRuntime)
 	}
 
 	// Docker is supported until 1.24.0, excluding 1.24.0
 	gteKube124Condition, _ := semverlib.NewConstraint(">= 1.24")
-	if spec.ContainerRuntime == "docker" && gteKube124Condition.Check(spec.Version.Semver()) {
+	if spec.ContainerRuntime == resources.ContainerRuntimeDocker && gteKube124Condition.Check(spec.Version.Semver()) {
 		return fmt.Errorf("docker not supported from version 1.24: %s", spec.ContainerRuntime)
 	}
 
-	return nil
+	if dc == nil {
+		return nil
+	}
+
+	operatingSystems := datacenterOperatingSystems(dc)
+	if len(operatingSystems) == 0 {
+		// the datacenter does not restrict the operating systems
+		return nil
+	}
+
+	for _, os := range operatingSystems {
+		runtimes, ok := supportedContainerRuntimes[os]
+		// do not block on operating systems we know nothing about
+		if !ok || runtimes.Has(spec.ContainerRuntime) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("container runtime %s is not supported by any of the operating systems configured in the datacenter: %v", spec.ContainerRuntime, operatingSystems)
+}
+
+// datacenterOperatingSystems returns the operating systems the datacenter has images
+// configured for, sorted by name. An empty result means that the datacenter does not
+// restrict the operating system.
+func datacenterOperatingSystems(dc *kubermaticv1.Datacenter) []providerconfig.OperatingSystem {
+	var images kubermaticv1.ImageList
+
+	switch {
+	case dc.Spec.AWS != nil:
+		images = dc.Spec.AWS.Images
+	case dc.Spec.Openstack != nil:
+		images = dc.Spec.Openstack.Images
+	case dc.Spec.Nutanix != nil:
+		images = dc.Spec.Nutanix.Images
+	case dc.Spec.VSphere != nil:
+		images = dc.Spec.VSphere.Templates
+	case dc.Spec.VMwareCloudDirector != nil:
+		images = dc.Spec.VMwareCloudDirector.Templates
+	}
+
+	names := sets.NewString()
+	for os, image := range images {
+		if image != "" {
+			names.Insert(string(os))
+		}
+	}
+
+	result := make([]providerconfig.OperatingSystem, 0, names.Len())
+	for _, os := range names.List() {
+		result = append(result, providerconfig.OperatingSystem(os))
+	}
+
+	return result
 }
 
 func ValidateLeaderElectionSettings(l *kubermaticv1.LeaderElectionSettings, fldPath *field.Path) field.ErrorList {
@@ -964,6 +1642,247 @@ func ValidateLeaderElectionSettings(l *kubermaticv1.LeaderElectionSettings, fldP
 	return allErrs
 }
 
+// ValidateEtcdDefragmentationSchedule validates that the given schedule is empty or a valid cron expression.
+func ValidateEtcdDefragmentationSchedule(schedule string, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if schedule == "" {
+		return allErrs
+	}
+
+	if _, err := GetCronExpressionParser().Parse(schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fieldPath, schedule, fmt.Sprintf("invalid cron expression: %v", err)))
+	}
+
+	return allErrs
+}
+
+// ValidateEtcdClusterSize validates that the given etcd cluster size is positive, not above the
+// maximum and odd, as even-sized clusters tolerate no more failures than the next smaller odd size.
+// Sizes below kubermaticv1.MinEtcdClusterSize are accepted and raised to the minimum by the etcd
+// StatefulSet.
+func ValidateEtcdClusterSize(size *int32, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if size == nil {
+		return allErrs
+	}
+
+	if *size < 1 || *size > kubermaticv1.MaxEtcdClusterSize {
+		allErrs = append(allErrs, field.Invalid(fieldPath, *size, fmt.Sprintf("must be between 1 and %d", kubermaticv1.MaxEtcdClusterSize)))
+	} else if *size%2 == 0 {
+		allErrs = append(allErrs, field.Invalid(fieldPath, *size, "must be an odd number to keep etcd quorum fault-tolerant"))
+	}
+
+	return allErrs
+}
+
+// validateEtcdClusterSizeUpdate only validates the etcd cluster size if it was changed, so that
+// existing clusters with an even size can still be updated.
+func validateEtcdClusterSizeUpdate(newSize, oldSize *int32, fieldPath *field.Path) field.ErrorList {
+	if equality.Semantic.DeepEqual(newSize, oldSize) {
+		return nil
+	}
+
+	return ValidateEtcdClusterSize(newSize, fieldPath)
+}
+
+// validateComponentSettings validates the replicas and resource requirements of all
+// control plane component overrides. Requests exceeding limits would otherwise only
+// surface as control plane pods that never get created.
+func validateComponentSettings(c *kubermaticv1.ComponentSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateDeploymentSettings(&c.Apiserver.DeploymentSettings, fldPath.Child("apiserver"))...)
+	allErrs = append(allErrs, validateDeploymentSettings(&c.ControllerManager.DeploymentSettings, fldPath.Child("controllerManager"))...)
+	allErrs = append(allErrs, validateDeploymentSettings(&c.Scheduler.DeploymentSettings, fldPath.Child("scheduler"))...)
+	allErrs = append(allErrs, validateDeploymentSettings(&c.OperatingSystemManager.DeploymentSettings, fldPath.Child("operatingSystemManager"))...)
+	allErrs = append(allErrs, validateResourceRequirements(c.Etcd.Resources, fldPath.Child("etcd", "resources"))...)
+	allErrs = append(allErrs, validateResourceRequirements(c.Prometheus.Resources, fldPath.Child("prometheus", "resources"))...)
+	allErrs = append(allErrs, validateResourceRequirements(&c.NodePortProxyEnvoy.Resources, fldPath.Child("nodePortProxyEnvoy", "resources"))...)
+	allErrs = append(allErrs, validateResourceRequirements(c.KonnectivityProxy.Resources, fldPath.Child("konnectivityProxy", "resources"))...)
+
+	return allErrs
+}
+
+func validateDeploymentSettings(s *kubermaticv1.DeploymentSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if s.Replicas != nil && *s.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *s.Replicas, "replicas cannot be negative"))
+	}
+
+	allErrs = append(allErrs, validateResourceRequirements(s.Resources, fldPath.Child("resources"))...)
+
+	return allErrs
+}
+
+// validateResourceRequirements ensures that no resource request exceeds its limit.
+func validateResourceRequirements(r *corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if r == nil {
+		return allErrs
+	}
+
+	// sort the resource names to get stable error messages
+	names := make([]string, 0, len(r.Requests))
+	for name := range r.Requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		request := r.Requests[corev1.ResourceName(name)]
+
+		limit, ok := r.Limits[corev1.ResourceName(name)]
+		if ok && request.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requests", name), request.String(), fmt.Sprintf("must be less than or equal to %s limit of %s", name, limit.String())))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateProxySettings validates that the HTTP proxy is a well-formed http(s) URL and that
+// every no-proxy entry is either a CIDR, an IP address or a domain name.
+func ValidateProxySettings(settings *kubermaticv1.ProxySettings, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !settings.HTTPProxy.Empty() {
+		httpProxy := settings.HTTPProxy.String()
+
+		u, err := url.Parse(httpProxy)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("httpProxy"), httpProxy, fmt.Sprintf("invalid URL: %v", err)))
+		} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("httpProxy"), httpProxy, "must be an http or https URL"))
+		}
+	}
+
+	if !settings.NoProxy.Empty() {
+		for _, entry := range strings.Split(settings.NoProxy.String(), ",") {
+			if err := validateNoProxyEntry(strings.TrimSpace(entry)); err != nil {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("noProxy"), entry, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateNoProxyEntry(entry string) error {
+	if entry == "" {
+		return errors.New("must not be empty")
+	}
+
+	if strings.Contains(entry, "/") {
+		if _, _, err := net.ParseCIDR(entry); err != nil {
+			return fmt.Errorf("invalid CIDR: %w", err)
+		}
+		return nil
+	}
+
+	if net.ParseIP(entry) != nil {
+		return nil
+	}
+
+	// domains can be given with a leading wildcard or dot to match all subdomains,
+	// both for hosts and host:port combinations
+	host := entry
+	if h, _, err := net.SplitHostPort(entry); err == nil {
+		host = h
+	}
+	host = strings.TrimPrefix(strings.TrimPrefix(host, "*"), ".")
+
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+
+	if errs := utilvalidation.IsDNS1123Subdomain(strings.ToLower(host)); len(errs) > 0 {
+		return fmt.Errorf("must be a CIDR, IP address or domain name: %s", strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+// validateServiceAccountSettings validates that the service account issuer and JWKS URI are
+// https URLs, as required for the OIDC discovery used by workload identity federation.
+func validateServiceAccountSettings(settings *kubermaticv1.ServiceAccountSettings, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if settings.Issuer != "" {
+		if err := validateHTTPSURL(settings.Issuer); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("issuer"), settings.Issuer, err.Error()))
+		}
+	}
+
+	if settings.JWKSURI != "" {
+		if err := validateHTTPSURL(settings.JWKSURI); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("jwksURI"), settings.JWKSURI, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateServiceAccountSettingsUpdate only validates the service account settings if they
+// were changed, so that existing clusters with a non-https issuer can still be updated.
+func validateServiceAccountSettingsUpdate(newSettings, oldSettings *kubermaticv1.ServiceAccountSettings, fieldPath *field.Path) field.ErrorList {
+	if newSettings == nil || equality.Semantic.DeepEqual(newSettings, oldSettings) {
+		return nil
+	}
+
+	return validateServiceAccountSettings(newSettings, fieldPath)
+}
+
+func validateHTTPSURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return errors.New("must be an https URL")
+	}
+
+	if u.RawQuery != "" || u.Fragment != "" {
+		return errors.New("must not contain a query or fragment")
+	}
+
+	return nil
+}
+
+// ValidateOPASyncResources validates that the resources Gatekeeper should sync into OPA's cache
+// specify a version and kind and are not listed more than once. Whether the resources exist
+// can only be determined in the user cluster, as they might be custom resources.
+func ValidateOPASyncResources(syncResources []kubermaticv1.OPASyncResource, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.NewString()
+
+	for i, r := range syncResources {
+		childPath := fieldPath.Index(i)
+
+		if r.Version == "" {
+			allErrs = append(allErrs, field.Required(childPath.Child("version"), "version must be set"))
+		}
+		if r.Kind == "" {
+			allErrs = append(allErrs, field.Required(childPath.Child("kind"), "kind must be set"))
+		}
+		if r.Version == "" || r.Kind == "" {
+			continue
+		}
+
+		gvk := schema.GroupVersionKind{Group: r.Group, Version: r.Version, Kind: r.Kind}
+		if seen.Has(gvk.String()) {
+			allErrs = append(allErrs, field.Duplicate(childPath, gvk.String()))
+		}
+		seen.Insert(gvk.String())
+	}
+
+	return allErrs
+}
+
 func ValidateNodePortRange(nodePortRange string, fldPath *field.Path) *field.Error {
 	if nodePortRange == "" {
 		return field.Required(fldPath, "node port range is required")
@@ -984,7 +1903,10 @@ func ValidateNodePortRange(nodePortRange string, fldPath *field.Path) *field.Err
 func validateClusterNetworkingConfigUpdateImmutability(c, oldC *kubermaticv1.ClusterNetworkingConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if oldC.IPFamily != "" {
+	// the only allowed change of the IP family is migrating an IPv4 cluster to dual-stack
+	dualStackMigration := oldC.IPFamily == kubermaticv1.IPFamilyIPv4 && c.IPFamily == kubermaticv1.IPFamilyDualStack
+
+	if oldC.IPFamily != "" && !dualStackMigration {
 		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
 			c.IPFamily,
 			oldC.IPFamily,
@@ -992,21 +1914,8 @@ func validateClusterNetworkingConfigUpdateImmutability(c, oldC *kubermaticv1.Clu
 		)...)
 	}
 
-	if len(oldC.Pods.CIDRBlocks) != 0 {
-		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
-			c.Pods.CIDRBlocks,
-			oldC.Pods.CIDRBlocks,
-			fldPath.Child("pods", "cidrBlocks"),
-		)...)
-	}
-
-	if len(oldC.Services.CIDRBlocks) != 0 {
-		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
-			c.Services.CIDRBlocks,
-			oldC.Services.CIDRBlocks,
-			fldPath.Child("services", "cidrBlocks"),
-		)...)
-	}
+	allErrs = append(allErrs, validateCIDRBlocksUpdate(c.Pods.CIDRBlocks, oldC.Pods.CIDRBlocks, dualStackMigration, fldPath.Child("pods", "cidrBlocks"))...)
+	allErrs = append(allErrs, validateCIDRBlocksUpdate(c.Services.CIDRBlocks, oldC.Services.CIDRBlocks, dualStackMigration, fldPath.Child("services", "cidrBlocks"))...)
 
 	if oldC.ProxyMode != "" {
 		allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
@@ -1032,9 +1941,59 @@ func validateClusterNetworkingConfigUpdateImmutability(c, oldC *kubermaticv1.Clu
 		)...)
 	}
 
+	// existing kubelets keep the cluster DNS address they were provisioned with
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(
+		effectiveNodeLocalDNSCacheAddress(c),
+		effectiveNodeLocalDNSCacheAddress(oldC),
+		fldPath.Child("nodeLocalDNSCacheAddress"),
+	)...)
+
 	return allErrs
 }
 
+// effectiveNodeLocalDNSCacheAddress returns the configured NodeLocal DNS Cache address,
+// treating an empty address like the default one.
+func effectiveNodeLocalDNSCacheAddress(n *kubermaticv1.ClusterNetworkingConfig) string {
+	if n.NodeLocalDNSCacheAddress == "" {
+		return resources.NodeLocalDNSCacheAddress
+	}
+
+	return n.NodeLocalDNSCacheAddress
+}
+
+// validateCIDRBlocksUpdate ensures that CIDR blocks cannot be changed once they are set. When
+// migrating a cluster to dual-stack, an IPv6 block may be appended to the existing IPv4 block.
+func validateCIDRBlocksUpdate(blocks, oldBlocks []string, dualStackMigration bool, fldPath *field.Path) field.ErrorList {
+	if len(oldBlocks) == 0 {
+		return nil
+	}
+
+	if dualStackMigration && len(oldBlocks) == 1 && len(blocks) == 2 && blocks[0] == oldBlocks[0] {
+		ip, _, err := net.ParseCIDR(blocks[1])
+		if err != nil || ip.To4() != nil {
+			return field.ErrorList{field.Invalid(fldPath.Index(1), blocks[1], "the CIDR block added when migrating to dual-stack must be an IPv6 CIDR")}
+		}
+
+		return nil
+	}
+
+	return apimachineryvalidation.ValidateImmutableField(blocks, oldBlocks, fldPath)
+}
+
+// validateVersionUpdate forbids control plane downgrades, as Kubernetes does not support them,
+// unless the UnsafeVersionDowngradeLabel is present.
+func validateVersionUpdate(newVersion, oldVersion *semver.Semver, labels map[string]string) *field.Error {
+	if !newVersion.LessThan(oldVersion) {
+		return nil
+	}
+
+	if _, ok := labels[UnsafeVersionDowngradeLabel]; ok {
+		return nil
+	}
+
+	return field.Forbidden(field.NewPath("spec", "version"), fmt.Sprintf("cannot downgrade the control plane from %s to %s, unless %s label is present", oldVersion, newVersion, UnsafeVersionDowngradeLabel))
+}
+
 func validateCNIUpdate(newCni *kubermaticv1.CNIPluginSettings, oldCni *kubermaticv1.CNIPluginSettings, labels map[string]string) *field.Error {
 	basePath := field.NewPath("spec", "cniPlugin")
 
@@ -1075,12 +2034,44 @@ func validateCNIUpdate(newCni *kubermaticv1.CNIPluginSettings, oldCni *kubermati
 			return field.Invalid(basePath.Child("version"), oldCni.Version, fmt.Sprintf("couldn't parse CNI version `%s`: %v", oldCni.Version, err))
 		}
 
-		if newV.Major() != oldV.Major() || (newV.Minor() != oldV.Minor()+1 && oldV.Minor() != newV.Minor()+1) {
+		// arbitrary upgrades and downgrades are possible with the unsafe label
+		if !isSingleMinorCNIVersionChange(oldV, newV) {
 			if _, ok := labels[UnsafeCNIUpgradeLabel]; !ok {
-				return field.Forbidden(basePath.Child("version"), fmt.Sprintf("cannot upgrade CNI from %s to %s, only one minor version difference is allowed unless %s label is present", oldCni.Version, newCni.Version, UnsafeCNIUpgradeLabel))
+				return field.Forbidden(basePath.Child("version"), fmt.Sprintf("cannot change CNI version from %s to %s, only upgrades or downgrades by a single minor version are allowed unless %s label is present", oldCni.Version, newCni.Version, UnsafeCNIUpgradeLabel))
 			}
 		}
 	}
 
 	return nil
 }
+
+// isSingleMinorCNIVersionChange returns true if the versions share the major version and
+// their minor versions differ by exactly one, in either direction.
+func isSingleMinorCNIVersionChange(oldV, newV *semverlib.Version) bool {
+	return newV.Major() == oldV.Major() && (newV.Minor() == oldV.Minor()+1 || oldV.Minor() == newV.Minor()+1)
+}
+
+// CNIDowngradeWarnings returns warnings for cluster updates that downgrade the CNI plugin by more
+// than a single minor version, which is only allowed with the UnsafeCNIUpgradeLabel.
+func CNIDowngradeWarnings(newCluster, oldCluster *kubermaticv1.Cluster) []string {
+	newCni, oldCni := newCluster.Spec.CNIPlugin, oldCluster.Spec.CNIPlugin
+	if newCni == nil || oldCni == nil || newCni.Type != oldCni.Type || newCni.Version == oldCni.Version {
+		return nil
+	}
+
+	newV, err := semverlib.NewVersion(newCni.Version)
+	if err != nil {
+		return nil
+	}
+
+	oldV, err := semverlib.NewVersion(oldCni.Version)
+	if err != nil {
+		return nil
+	}
+
+	if !newV.LessThan(oldV) || isSingleMinorCNIVersionChange(oldV, newV) {
+		return nil
+	}
+
+	return []string{fmt.Sprintf("CNI plugin %s is downgraded from %s to %s by more than one minor version because the %s label is set; this may disrupt the cluster network, remove the label once the downgrade is done", newCni.Type, oldCni.Version, newCni.Version, UnsafeCNIUpgradeLabel)}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"net/url"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateDatacenter validates that the provider-specific settings of a datacenter contain
+// all fields required to create clusters in it. It does not check that exactly one provider
+// is configured, this is done by provider.DatacenterCloudProviderName.
+func ValidateDatacenter(spec *kubermaticv1.DatacenterSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch {
+	case spec.Digitalocean != nil:
+		allErrs = append(allErrs, requireValue(spec.Digitalocean.Region, fldPath.Child("digitalocean", "region"))...)
+
+	case spec.AWS != nil:
+		allErrs = append(allErrs, requireValue(spec.AWS.Region, fldPath.Child("aws", "region"))...)
+
+	case spec.Azure != nil:
+		allErrs = append(allErrs, requireValue(spec.Azure.Location, fldPath.Child("azure", "location"))...)
+
+	case spec.Openstack != nil:
+		allErrs = append(allErrs, requireURL(spec.Openstack.AuthURL, fldPath.Child("openstack", "authURL"))...)
+		allErrs = append(allErrs, requireValue(spec.Openstack.Region, fldPath.Child("openstack", "region"))...)
+
+	case spec.Packet != nil:
+		if len(spec.Packet.Facilities) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("packet", "facilities"), "at least one facility must be specified"))
+		}
+
+	case spec.Hetzner != nil:
+		allErrs = append(allErrs, requireValue(spec.Hetzner.Datacenter, fldPath.Child("hetzner", "datacenter"))...)
+
+	case spec.VSphere != nil:
+		allErrs = append(allErrs, requireURL(spec.VSphere.Endpoint, fldPath.Child("vsphere", "endpoint"))...)
+		allErrs = append(allErrs, requireValue(spec.VSphere.Datacenter, fldPath.Child("vsphere", "datacenter"))...)
+
+	case spec.VMwareCloudDirector != nil:
+		allErrs = append(allErrs, requireURL(spec.VMwareCloudDirector.URL, fldPath.Child("vmwareCloudDirector", "url"))...)
+
+	case spec.GCP != nil:
+		allErrs = append(allErrs, requireValue(spec.GCP.Region, fldPath.Child("gcp", "region"))...)
+		if len(spec.GCP.ZoneSuffixes) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("gcp", "zoneSuffixes"), "at least one zone must be specified"))
+		}
+
+	case spec.Nutanix != nil:
+		allErrs = append(allErrs, requireValue(spec.Nutanix.Endpoint, fldPath.Child("nutanix", "endpoint"))...)
+
+	case spec.Alibaba != nil:
+		allErrs = append(allErrs, requireValue(spec.Alibaba.Region, fldPath.Child("alibaba", "region"))...)
+
+	case spec.Anexia != nil:
+		allErrs = append(allErrs, requireValue(spec.Anexia.LocationID, fldPath.Child("anexia", "locationID"))...)
+	}
+
+	return allErrs
+}
+
+func requireValue(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "no value specified")}
+	}
+
+	return nil
+}
+
+func requireURL(value string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return field.ErrorList{field.Required(fldPath, "no URL specified")}
+	}
+
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return field.ErrorList{field.Invalid(fldPath, value, "must be an absolute URL including the protocol")}
+	}
+
+	return nil
+}
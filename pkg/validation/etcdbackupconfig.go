@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateEtcdBackupConfigSpec validates that the schedule and keep settings of an
+// EtcdBackupConfig are compatible, i.e. that the retention can hold at least one
+// backup given the schedule.
+func ValidateEtcdBackupConfigSpec(spec *kubermaticv1.EtcdBackupConfigSpec, parentFieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Keep != nil {
+		keepPath := parentFieldPath.Child("keep")
+
+		if *spec.Keep < 1 {
+			allErrs = append(allErrs, field.Invalid(keepPath, *spec.Keep, "at least one backup must be kept"))
+		} else if *spec.Keep > kubermaticv1.MaxKeptBackupsCount {
+			allErrs = append(allErrs, field.Invalid(keepPath, *spec.Keep, fmt.Sprintf("at most %d backups can be kept", kubermaticv1.MaxKeptBackupsCount)))
+		}
+	}
+
+	if spec.Schedule == "" {
+		return allErrs
+	}
+
+	schedulePath := parentFieldPath.Child("schedule")
+
+	schedule, err := GetCronExpressionParser().Parse(spec.Schedule)
+	if err != nil {
+		return append(allErrs, field.Invalid(schedulePath, spec.Schedule, fmt.Sprintf("invalid cron expression: %v", err)))
+	}
+
+	// A schedule that never fires (e.g. on February 30th) would never create a
+	// backup, regardless of how many backups are to be kept.
+	if schedule.Next(time.Now()).IsZero() {
+		allErrs = append(allErrs, field.Invalid(schedulePath, spec.Schedule, "schedule never triggers a backup"))
+	}
+
+	return allErrs
+}
+
+// ValidateEtcdBackupConfigDestination validates that the destination of an EtcdBackupConfig
+// is one of the backup destinations configured on the given Seed.
+func ValidateEtcdBackupConfigDestination(destination string, seed *kubermaticv1.Seed, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if destination == "" {
+		return append(allErrs, field.Required(fieldPath, "no backup destination specified"))
+	}
+
+	if seed.GetEtcdBackupDestination(destination) == nil {
+		known := []string{}
+		if seed.Spec.EtcdBackupRestore != nil {
+			for name := range seed.Spec.EtcdBackupRestore.Destinations {
+				known = append(known, name)
+			}
+		}
+		sort.Strings(known)
+
+		allErrs = append(allErrs, field.NotSupported(fieldPath, destination, known))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+func TestValidateEtcdBackupConfigSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    kubermaticv1.EtcdBackupConfigSpec
+		wantErr bool
+	}{
+		{
+			name: "valid one-shot backup",
+			spec: kubermaticv1.EtcdBackupConfigSpec{},
+		},
+		{
+			name: "valid scheduled backup",
+			spec: kubermaticv1.EtcdBackupConfigSpec{
+				Schedule: "0 3 * * *",
+				Keep:     pointer.Int(7),
+			},
+		},
+		{
+			name: "valid infrequent schedule keeping a single backup",
+			spec: kubermaticv1.EtcdBackupConfigSpec{
+				Schedule: "@yearly",
+				Keep:     pointer.Int(1),
+			},
+		},
+		{
+			name: "invalid cron expression",
+			spec: kubermaticv1.EtcdBackupConfigSpec{
+				Schedule: "5 * * * * *",
+			},
+			wantErr: true,
+		},
+		{
+			name: "schedule never triggers a backup",
+			spec: kubermaticv1.EtcdBackupConfigSpec{
+				Schedule: "0 0 30 2 *",
+				Keep:     pointer.Int(20),
+			},
+			wantErr: true,
+		},
+		{
+			name: "no backup is kept",
+			spec: kubermaticv1.EtcdBackupConfigSpec{
+				Schedule: "@monthly",
+				Keep:     pointer.Int(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "more backups kept than allowed",
+			spec: kubermaticv1.EtcdBackupConfigSpec{
+				Schedule: "@hourly",
+				Keep:     pointer.Int(kubermaticv1.MaxKeptBackupsCount + 1),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateEtcdBackupConfigSpec(&tt.spec, field.NewPath("spec"))
+
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Expected error: %v, got: %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+func TestValidateEtcdBackupConfigDestination(t *testing.T) {
+	seed := &kubermaticv1.Seed{
+		Spec: kubermaticv1.SeedSpec{
+			EtcdBackupRestore: &kubermaticv1.EtcdBackupRestore{
+				Destinations: map[string]*kubermaticv1.BackupDestination{
+					"s3": {
+						Endpoint:   "https://s3.example.com",
+						BucketName: "backups",
+					},
+				},
+				DefaultDestination: "s3",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		destination string
+		seed        *kubermaticv1.Seed
+		wantErr     bool
+	}{
+		{
+			name:        "known destination",
+			destination: "s3",
+			seed:        seed,
+		},
+		{
+			name:    "no destination",
+			seed:    seed,
+			wantErr: true,
+		},
+		{
+			name:        "unknown destination",
+			destination: "minio",
+			seed:        seed,
+			wantErr:     true,
+		},
+		{
+			name:        "seed without backup destinations",
+			destination: "s3",
+			seed:        &kubermaticv1.Seed{},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateEtcdBackupConfigDestination(tt.destination, tt.seed, field.NewPath("spec", "destination"))
+
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("Expected error: %v, got: %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
@@ -18,30 +18,86 @@ package validation
 
 import (
 	"fmt"
+	"math"
 	"regexp"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
 )
 
 var MeteringReportNameValidator = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
 
+const (
+	// meteringScheduleLookahead and maxMeteringScheduleRuns bound how many runs of a report
+	// schedule are inspected when comparing the schedule to the report interval.
+	meteringScheduleLookahead = 366 * 24 * time.Hour
+	maxMeteringScheduleRuns   = 10000
+
+	// meteringScheduleTolerance allows schedules based on calendar months, whose runs
+	// are between 28 and 31 days apart, to be combined with an interval of e.g. 30 days.
+	meteringScheduleTolerance = 3 * 24 * time.Hour
+)
+
 func GetCronExpressionParser() cron.Parser {
 	return cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
 }
 
-func ValidateMeteringConfiguration(configuration *kubermaticv1.MeteringConfiguration) error {
+// ValidateMeteringConfiguration validates the report configurations of the given metering
+// configuration. Reports that are unchanged compared to the existing configuration are skipped,
+// so that stricter rules do not block updates of seeds with already existing reports.
+func ValidateMeteringConfiguration(configuration, existing *kubermaticv1.MeteringConfiguration) error {
 	if configuration != nil && len(configuration.ReportConfigurations) > 0 {
 		parser := GetCronExpressionParser()
 		for reportName, reportConfig := range configuration.ReportConfigurations {
+			if existing != nil && equality.Semantic.DeepEqual(existing.ReportConfigurations[reportName], reportConfig) {
+				continue
+			}
 			if !MeteringReportNameValidator.MatchString(reportName) {
 				return fmt.Errorf("metering report configuration name can contain only alphanumeric characters or '-', got: %s", reportName)
 			}
-			if _, err := parser.Parse(reportConfig.Schedule); err != nil {
+			schedule, err := parser.Parse(reportConfig.Schedule)
+			if err != nil {
 				return fmt.Errorf("invalid cron expression format: %s", reportConfig.Schedule)
 			}
+			if reportConfig.Interval == 0 {
+				return fmt.Errorf("metering report configuration %q: interval must be a positive number of days", reportName)
+			}
+
+			// reports only cover the last interval days, so a schedule that runs less often
+			// than that leaves periods of usage that are never reported
+			interval := time.Duration(reportConfig.Interval) * 24 * time.Hour
+			if gap := maxScheduleGap(schedule, time.Now()); gap > interval+meteringScheduleTolerance {
+				return fmt.Errorf("metering report configuration %q: schedule %q runs up to %.0f days apart, but the interval only covers %d days, so usage in between would not be reported",
+					reportName, reportConfig.Schedule, math.Ceil(gap.Hours()/24), reportConfig.Interval)
+			}
 		}
 	}
 	return nil
 }
+
+// maxScheduleGap returns the longest time between two consecutive runs of the schedule
+// within a year from the given time.
+func maxScheduleGap(schedule cron.Schedule, from time.Time) time.Duration {
+	var maxGap time.Duration
+
+	current := schedule.Next(from)
+	end := current.Add(meteringScheduleLookahead)
+
+	for i := 0; i < maxMeteringScheduleRuns && !current.IsZero() && current.Before(end); i++ {
+		next := schedule.Next(current)
+		if next.IsZero() {
+			break
+		}
+
+		if gap := next.Sub(current); gap > maxGap {
+			maxGap = gap
+		}
+		current = next
+	}
+
+	return maxGap
+}
@@ -19,6 +19,9 @@ package version
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	semverlib "github.com/Masterminds/semver/v3"
 
@@ -32,11 +35,26 @@ var (
 	errNoDefaultVersion = errors.New("no default version configured")
 )
 
+// providerVersionsCacheTTL is the duration for which the result of GetVersionsForProvider
+// is cached.
+const providerVersionsCacheTTL = 30 * time.Second
+
 // Manager is a object to handle versions & updates from a predefined config.
 type Manager struct {
 	versions                  []*Version
 	updates                   []*Update
 	providerIncompatibilities []*ProviderIncompatibility
+
+	// providerVersionsCache caches the results of GetVersionsForProvider. As a Manager's
+	// configuration cannot change, a reconfiguration always results in a new Manager
+	// with an empty cache.
+	providerVersionsCache     map[string]cachedVersions
+	providerVersionsCacheLock sync.Mutex
+}
+
+type cachedVersions struct {
+	versions []*Version
+	expires  time.Time
 }
 
 type ProviderIncompatibility struct {
@@ -151,7 +169,34 @@ func (m *Manager) GetVersions() ([]*Version, error) {
 }
 
 // GetVersionsForProvider returns all Versions which don't result in automatic updates.
+// Results are cached for a short time, as this is called for every cluster validation.
 func (m *Manager) GetVersionsForProvider(provider kubermaticv1.ProviderType, conditions ...kubermaticv1.ConditionType) ([]*Version, error) {
+	key := providerVersionsCacheKey(provider, conditions)
+
+	m.providerVersionsCacheLock.Lock()
+	defer m.providerVersionsCacheLock.Unlock()
+
+	if cached, ok := m.providerVersionsCache[key]; ok && time.Now().Before(cached.expires) {
+		return copyVersions(cached.versions), nil
+	}
+
+	versions, err := m.getVersionsForProvider(provider, conditions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if m.providerVersionsCache == nil {
+		m.providerVersionsCache = map[string]cachedVersions{}
+	}
+	m.providerVersionsCache[key] = cachedVersions{
+		versions: versions,
+		expires:  time.Now().Add(providerVersionsCacheTTL),
+	}
+
+	return copyVersions(versions), nil
+}
+
+func (m *Manager) getVersionsForProvider(provider kubermaticv1.ProviderType, conditions ...kubermaticv1.ConditionType) ([]*Version, error) {
 	versions, err := m.GetVersions()
 	if err != nil {
 		return nil, err
@@ -174,6 +219,29 @@ func (m *Manager) GetVersionsForProvider(provider kubermaticv1.ProviderType, con
 	return filtered, nil
 }
 
+func providerVersionsCacheKey(provider kubermaticv1.ProviderType, conditions []kubermaticv1.ConditionType) string {
+	parts := []string{string(provider)}
+	for _, condition := range conditions {
+		parts = append(parts, string(condition))
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// copyVersions returns a deep copy of the given versions, so that callers
+// cannot modify cached results.
+func copyVersions(versions []*Version) []*Version {
+	copied := make([]*Version, 0, len(versions))
+	for _, v := range versions {
+		copied = append(copied, &Version{
+			Version: v.Version,
+			Default: v.Default,
+		})
+	}
+
+	return copied
+}
+
 // AutomaticNodeUpdate returns an automatic node update or nil.
 func (m *Manager) AutomaticNodeUpdate(fromVersionRaw, controlPlaneVersion string) (*Version, error) {
 	version, err := m.automaticUpdate(fromVersionRaw, true)
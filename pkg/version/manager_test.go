@@ -19,9 +19,11 @@ package version
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	semverlib "github.com/Masterminds/semver/v3"
 
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
 )
 
@@ -85,3 +87,57 @@ func TestAutomaticNodeUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVersionsForProviderCache(t *testing.T) {
+	m := New([]*Version{
+		{Version: semverlib.MustParse("1.22.0")},
+		{Version: semverlib.MustParse("1.23.0"), Default: true},
+	}, nil, nil)
+
+	versions, err := m.GetVersionsForProvider(kubermaticv1.AWSCloudProvider)
+	if err != nil {
+		t.Fatalf("failed to get versions: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(versions))
+	}
+
+	// modifying the result must not affect the cache
+	versions[1].Default = false
+
+	// the manager cannot be reconfigured, this is only done to detect cache hits
+	m.versions = append(m.versions, &Version{Version: semverlib.MustParse("1.24.0")})
+
+	versions, err = m.GetVersionsForProvider(kubermaticv1.AWSCloudProvider)
+	if err != nil {
+		t.Fatalf("failed to get versions: %v", err)
+	}
+	if len(versions) != 2 {
+		t.Fatalf("expected cached result with 2 versions, got %d", len(versions))
+	}
+	if !versions[1].Default {
+		t.Error("expected cached result to be unaffected by modifications of a previous result")
+	}
+
+	versions, err = m.GetVersionsForProvider(kubermaticv1.GCPCloudProvider)
+	if err != nil {
+		t.Fatalf("failed to get versions: %v", err)
+	}
+	if len(versions) != 3 {
+		t.Fatalf("expected 3 versions for another provider, got %d", len(versions))
+	}
+
+	// expire the cache
+	for key, cached := range m.providerVersionsCache {
+		cached.expires = time.Now().Add(-time.Second)
+		m.providerVersionsCache[key] = cached
+	}
+
+	versions, err = m.GetVersionsForProvider(kubermaticv1.AWSCloudProvider)
+	if err != nil {
+		t.Fatalf("failed to get versions: %v", err)
+	}
+	if len(versions) != 3 {
+		t.Fatalf("expected 3 versions after the cache expired, got %d", len(versions))
+	}
+}
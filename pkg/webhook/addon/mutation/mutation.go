@@ -27,6 +27,7 @@ import (
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/validation"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -75,6 +76,10 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 
+		if errs := validation.ValidateAddon(addon); len(errs) > 0 {
+			return webhook.Denied(fmt.Sprintf("addon validation request %s denied: %v", req.UID, errs.ToAggregate()))
+		}
+
 		// apply defaults to the existing addon
 		err := h.ensureClusterReference(ctx, addon)
 		if err != nil {
@@ -93,6 +98,10 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 
+		if errs := validation.ValidateAddon(addon); len(errs) > 0 {
+			return webhook.Denied(fmt.Sprintf("addon validation request %s denied: %v", req.UID, errs.ToAggregate()))
+		}
+
 		err := h.validateUpdate(ctx, oldAddon, addon)
 		if err != nil {
 			h.log.Info("addon mutation failed", "error", err)
@@ -19,7 +19,6 @@ package mutation
 import (
 	"bytes"
 	"context"
-	"net/http"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -161,6 +160,31 @@ func TestHandle(t *testing.T) {
 			},
 			wantError: true,
 		},
+		{
+			name:     "Reject addons with incomplete required resource types",
+			clusters: []ctrlruntimeclient.Object{cluster},
+			req: webhook.AdmissionRequest{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					RequestKind: &metav1.GroupVersionKind{
+						Group:   kubermaticv1.GroupName,
+						Version: kubermaticv1.GroupVersion,
+						Kind:    "Addon",
+					},
+					Name: "foo",
+					Object: runtime.RawExtension{
+						Raw: rawAddonGen{
+							Name:      "my-addon",
+							Namespace: cluster.Status.NamespaceName,
+							RequiredResourceTypes: []kubermaticv1.GroupVersionKind{
+								{Version: "v1alpha1", Kind: "MachineDeployment"},
+							},
+						}.Do(),
+					},
+				},
+			},
+			wantError: true,
+		},
 		{
 			name: "Reject new addons in deleted clusters",
 			clusters: []ctrlruntimeclient.Object{
@@ -275,7 +299,7 @@ func TestHandle(t *testing.T) {
 				},
 			}
 			res := handler.Handle(context.Background(), tt.req)
-			if res.AdmissionResponse.Result != nil && res.AdmissionResponse.Result.Code == http.StatusInternalServerError {
+			if !res.Allowed {
 				if tt.wantError {
 					return
 				}
@@ -299,10 +323,11 @@ func TestHandle(t *testing.T) {
 }
 
 type rawAddonGen struct {
-	Name       string
-	Namespace  string
-	Finalizers []string
-	Cluster    *corev1.ObjectReference
+	Name                  string
+	Namespace             string
+	Finalizers            []string
+	Cluster               *corev1.ObjectReference
+	RequiredResourceTypes []kubermaticv1.GroupVersionKind
 }
 
 func (r rawAddonGen) Do() []byte {
@@ -317,7 +342,8 @@ func (r rawAddonGen) Do() []byte {
 			Finalizers: r.Finalizers,
 		},
 		Spec: kubermaticv1.AddonSpec{
-			Name: r.Name,
+			Name:                  r.Name,
+			RequiredResourceTypes: r.RequiredResourceTypes,
 		},
 	}
 
@@ -31,6 +31,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/defaulting"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	"k8c.io/kubermatic/v2/pkg/validation"
 	"k8c.io/kubermatic/v2/pkg/version/cni"
 
 	admissionv1 "k8s.io/api/admission/v1"
@@ -85,6 +86,8 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 	cluster := &kubermaticv1.Cluster{}
 	oldCluster := &kubermaticv1.Cluster{}
 
+	var warnings []string
+
 	switch req.Operation {
 	case admissionv1.Create:
 		if err := h.decoder.Decode(req, cluster); err != nil {
@@ -102,6 +105,10 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 			return webhook.Errored(http.StatusInternalServerError, fmt.Errorf("cluster mutation request %s failed: %w", req.UID, err))
 		}
 
+		// the validating webhook cannot return warnings, so incomplete but valid
+		// configurations are reported here
+		warnings = validation.ClusterSpecWarnings(&cluster.Spec)
+
 	case admissionv1.Update:
 		if err := h.decoder.Decode(req, cluster); err != nil {
 			return admission.Errored(http.StatusBadRequest, err)
@@ -122,6 +129,8 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 			return webhook.Errored(http.StatusInternalServerError, fmt.Errorf("cluster mutation request %s failed: %w", req.UID, err))
 		}
 
+		warnings = validation.ClusterUpdateWarnings(cluster, oldCluster)
+
 	case admissionv1.Delete:
 		return webhook.Allowed(fmt.Sprintf("no mutation done for request %s", req.UID))
 
@@ -134,7 +143,7 @@ func (h *AdmissionHandler) Handle(ctx context.Context, req webhook.AdmissionRequ
 		return webhook.Errored(http.StatusInternalServerError, fmt.Errorf("marshaling cluster object failed: %w", err))
 	}
 
-	return admission.PatchResponseFromRaw(req.Object.Raw, mutatedCluster)
+	return admission.PatchResponseFromRaw(req.Object.Raw, mutatedCluster).WithWarnings(warnings...)
 }
 
 func (h *AdmissionHandler) applyDefaults(ctx context.Context, c *kubermaticv1.Cluster) error {
@@ -21,6 +21,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"sync"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/defaulting"
@@ -30,6 +31,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/validation"
 	"k8c.io/kubermatic/v2/pkg/version"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -46,6 +48,12 @@ type validator struct {
 	configGetter provider.KubermaticConfigurationGetter
 	caBundle     *x509.CertPool
 
+	// versionManager is reused for as long as the versioning configuration does
+	// not change, so that its cached version lists remain available.
+	versionManager       *version.Manager
+	versionManagerConfig *kubermaticv1.KubermaticVersioningConfiguration
+	versionManagerLock   sync.Mutex
+
 	// disableProviderValidation is only for unit tests, to ensure no
 	// provide would phone home to validate dummy test credentials
 	disableProviderValidation bool
@@ -80,9 +88,11 @@ func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) erro
 		return configErr
 	}
 
-	versionManager := version.NewFromConfiguration(config)
+	versionManager := v.getVersionManager(config)
 
 	errs := validation.ValidateNewClusterSpec(ctx, &cluster.Spec, datacenter, cloudProvider, versionManager, v.features, nil)
+	errs = append(errs, validation.ValidateCloudSpecCredentialValues(ctx, v.client, cluster.Spec.Cloud, field.NewPath("spec", "cloud"))...)
+	errs = append(errs, validation.ValidateNodeCapacity(&cluster.Spec.ClusterNetwork, config.Spec.UserCluster.MinNodeCapacity, field.NewPath("spec", "clusterNetwork"))...)
 
 	if err := v.validateProjectRelation(ctx, cluster, nil); err != nil {
 		errs = append(errs, err)
@@ -112,9 +122,22 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 		return configErr
 	}
 
-	updateManager := version.NewFromConfiguration(config)
+	updateManager := v.getVersionManager(config)
 
 	errs := validation.ValidateClusterUpdate(ctx, newCluster, oldCluster, datacenter, cloudProvider, updateManager, v.features)
+	errs = append(errs, validation.ValidateEncryptionConfigurationSecretRefs(ctx, v.client, newCluster)...)
+
+	// the credentials secret is removed during cluster deletion before the last finalizers,
+	// so it is only looked up when the cloud spec (and with it the reference) changes
+	if cloudCredentialsNeedValidation(oldCluster, newCluster) {
+		errs = append(errs, validation.ValidateCloudSpecCredentialValues(ctx, v.client, newCluster.Spec.Cloud, field.NewPath("spec", "cloud"))...)
+	}
+
+	// existing clusters are only checked when their node CIDR mask sizes change, so raising
+	// the minimum does not block updates of clusters that were created before
+	if nodeCIDRMaskSizesChanged(&oldCluster.Spec.ClusterNetwork, &newCluster.Spec.ClusterNetwork) {
+		errs = append(errs, validation.ValidateNodeCapacity(&newCluster.Spec.ClusterNetwork, config.Spec.UserCluster.MinNodeCapacity, field.NewPath("spec", "clusterNetwork"))...)
+	}
 
 	if err := v.validateProjectRelation(ctx, newCluster, oldCluster); err != nil {
 		errs = append(errs, err)
@@ -123,6 +146,29 @@ func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 	return errs.ToAggregate()
 }
 
+// getVersionManager returns a version manager for the given configuration. A new
+// manager is only created if the versioning configuration has changed.
+func (v *validator) getVersionManager(config *kubermaticv1.KubermaticConfiguration) *version.Manager {
+	v.versionManagerLock.Lock()
+	defer v.versionManagerLock.Unlock()
+
+	if v.versionManager == nil || !equality.Semantic.DeepEqual(v.versionManagerConfig, &config.Spec.Versions) {
+		v.versionManager = version.NewFromConfiguration(config)
+		v.versionManagerConfig = config.Spec.Versions.DeepCopy()
+	}
+
+	return v.versionManager
+}
+
+func cloudCredentialsNeedValidation(oldCluster, newCluster *kubermaticv1.Cluster) bool {
+	return newCluster.DeletionTimestamp == nil && !equality.Semantic.DeepEqual(oldCluster.Spec.Cloud, newCluster.Spec.Cloud)
+}
+
+func nodeCIDRMaskSizesChanged(oldNetwork, newNetwork *kubermaticv1.ClusterNetworkingConfig) bool {
+	return !equality.Semantic.DeepEqual(oldNetwork.NodeCIDRMaskSizeIPv4, newNetwork.NodeCIDRMaskSizeIPv4) ||
+		!equality.Semantic.DeepEqual(oldNetwork.NodeCIDRMaskSizeIPv6, newNetwork.NodeCIDRMaskSizeIPv6)
+}
+
 func (v *validator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
 	return nil
 }
@@ -21,6 +21,8 @@ import (
 	"context"
 	"testing"
 
+	providerconfig "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
+
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/controller/operator/defaults"
 	"k8c.io/kubermatic/v2/pkg/features"
@@ -31,6 +33,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/version/cni"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
@@ -96,6 +99,14 @@ func TestHandle(t *testing.T) {
 		},
 	}
 
+	missingCredentials := &providerconfig.GlobalSecretKeySelector{
+		ObjectReference: corev1.ObjectReference{Name: "does-not-exist", Namespace: "kubermatic"},
+	}
+
+	otherMissingCredentials := &providerconfig.GlobalSecretKeySelector{
+		ObjectReference: corev1.ObjectReference{Name: "does-not-exist-either", Namespace: "kubermatic"},
+	}
+
 	project2 := kubermaticv1.Project{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "wxyz0987",
@@ -1647,6 +1658,148 @@ func TestHandle(t *testing.T) {
 			}.BuildPtr(),
 			wantAllowed: false,
 		},
+		{
+			name: "Accept updates of a cluster whose credentials secret is gone if the reference is unchanged",
+			op:   admissionv1.Update,
+			cluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "NodePort",
+				NetworkConfig: kubermaticv1.ClusterNetworkingConfig{
+					Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+					Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+					DNSDomain:                "cluster.local",
+					ProxyMode:                resources.IPVSProxyMode,
+					NodeLocalDNSCacheEnabled: pointer.BoolPtr(true),
+				},
+				ComponentSettings: kubermaticv1.ComponentSettings{
+					Apiserver: kubermaticv1.APIServerSettings{
+						NodePortRange: "30000-32768",
+					},
+				},
+				CredentialsReference: missingCredentials,
+			}.Build(),
+			oldCluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "NodePort",
+				NetworkConfig: kubermaticv1.ClusterNetworkingConfig{
+					Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+					Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+					DNSDomain:                "cluster.local",
+					ProxyMode:                resources.IPVSProxyMode,
+					NodeLocalDNSCacheEnabled: pointer.BoolPtr(true),
+				},
+				ComponentSettings: kubermaticv1.ComponentSettings{
+					Apiserver: kubermaticv1.APIServerSettings{
+						NodePortRange: "30000-32768",
+					},
+				},
+				CredentialsReference: missingCredentials,
+			}.BuildPtr(),
+			wantAllowed: true,
+		},
+		{
+			name: "Accept updates of a cluster in deletion whose credentials secret is gone",
+			op:   admissionv1.Update,
+			cluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "NodePort",
+				NetworkConfig: kubermaticv1.ClusterNetworkingConfig{
+					Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+					Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+					DNSDomain:                "cluster.local",
+					ProxyMode:                resources.IPVSProxyMode,
+					NodeLocalDNSCacheEnabled: pointer.BoolPtr(true),
+				},
+				ComponentSettings: kubermaticv1.ComponentSettings{
+					Apiserver: kubermaticv1.APIServerSettings{
+						NodePortRange: "30000-32768",
+					},
+				},
+				CredentialsReference: missingCredentials,
+				Deleting:             true,
+			}.Build(),
+			oldCluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "NodePort",
+				NetworkConfig: kubermaticv1.ClusterNetworkingConfig{
+					Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+					Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+					DNSDomain:                "cluster.local",
+					ProxyMode:                resources.IPVSProxyMode,
+					NodeLocalDNSCacheEnabled: pointer.BoolPtr(true),
+				},
+				ComponentSettings: kubermaticv1.ComponentSettings{
+					Apiserver: kubermaticv1.APIServerSettings{
+						NodePortRange: "30000-32768",
+					},
+				},
+				CredentialsReference: otherMissingCredentials,
+			}.BuildPtr(),
+			wantAllowed: true,
+		},
+		{
+			name: "Reject changing the credentials reference to a missing secret",
+			op:   admissionv1.Update,
+			cluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "NodePort",
+				NetworkConfig: kubermaticv1.ClusterNetworkingConfig{
+					Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+					Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+					DNSDomain:                "cluster.local",
+					ProxyMode:                resources.IPVSProxyMode,
+					NodeLocalDNSCacheEnabled: pointer.BoolPtr(true),
+				},
+				ComponentSettings: kubermaticv1.ComponentSettings{
+					Apiserver: kubermaticv1.APIServerSettings{
+						NodePortRange: "30000-32768",
+					},
+				},
+				CredentialsReference: missingCredentials,
+			}.Build(),
+			oldCluster: rawClusterGen{
+				Name:      "foo",
+				Namespace: "kubermatic",
+				Labels: map[string]string{
+					kubermaticv1.ProjectIDLabelKey: project1.Name,
+				},
+				ExposeStrategy: "NodePort",
+				NetworkConfig: kubermaticv1.ClusterNetworkingConfig{
+					Pods:                     kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.241.0.0/16"}},
+					Services:                 kubermaticv1.NetworkRanges{CIDRBlocks: []string{"10.240.32.0/20"}},
+					DNSDomain:                "cluster.local",
+					ProxyMode:                resources.IPVSProxyMode,
+					NodeLocalDNSCacheEnabled: pointer.BoolPtr(true),
+				},
+				ComponentSettings: kubermaticv1.ComponentSettings{
+					Apiserver: kubermaticv1.APIServerSettings{
+						NodePortRange: "30000-32768",
+					},
+				},
+				CredentialsReference: otherMissingCredentials,
+			}.BuildPtr(),
+			wantAllowed: false,
+		},
 	}
 
 	seedClient := ctrlruntimefakeclient.
@@ -1700,6 +1853,8 @@ type rawClusterGen struct {
 	ComponentSettings     kubermaticv1.ComponentSettings
 	CNIPlugin             *kubermaticv1.CNIPluginSettings
 	Version               *semver.Semver
+	CredentialsReference  *providerconfig.GlobalSecretKeySelector
+	Deleting              bool
 }
 
 func (r rawClusterGen) BuildPtr() *kubermaticv1.Cluster {
@@ -1743,6 +1898,17 @@ func (r rawClusterGen) Build() kubermaticv1.Cluster {
 		},
 	}
 
+	if r.CredentialsReference != nil {
+		c.Spec.Cloud.Digitalocean = &kubermaticv1.DigitaloceanCloudSpec{
+			CredentialsReference: r.CredentialsReference,
+		}
+	}
+
+	if r.Deleting {
+		now := metav1.Now()
+		c.DeletionTimestamp = &now
+	}
+
 	return c
 }
 
@@ -1753,3 +1919,61 @@ func (r rawClusterGen) Do() []byte {
 	_ = s.Encode(&c, buff)
 	return buff.Bytes()
 }
+
+func TestCloudCredentialsNeedValidation(t *testing.T) {
+	hetznerCluster := func(secretName string, deleting bool) *kubermaticv1.Cluster {
+		c := &kubermaticv1.Cluster{
+			Spec: kubermaticv1.ClusterSpec{
+				Cloud: kubermaticv1.CloudSpec{
+					DatacenterName: datacenterName,
+					Hetzner: &kubermaticv1.HetznerCloudSpec{
+						CredentialsReference: &providerconfig.GlobalSecretKeySelector{
+							ObjectReference: corev1.ObjectReference{Name: secretName, Namespace: "kubermatic"},
+						},
+					},
+				},
+			},
+		}
+
+		if deleting {
+			now := metav1.Now()
+			c.DeletionTimestamp = &now
+		}
+
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		oldCluster *kubermaticv1.Cluster
+		newCluster *kubermaticv1.Cluster
+		expected   bool
+	}{
+		{
+			name:       "unchanged cloud spec",
+			oldCluster: hetznerCluster("credentials", false),
+			newCluster: hetznerCluster("credentials", false),
+			expected:   false,
+		},
+		{
+			name:       "changed credentials reference",
+			oldCluster: hetznerCluster("credentials", false),
+			newCluster: hetznerCluster("new-credentials", false),
+			expected:   true,
+		},
+		{
+			name:       "changed credentials reference during deletion",
+			oldCluster: hetznerCluster("credentials", false),
+			newCluster: hetznerCluster("new-credentials", true),
+			expected:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := cloudCredentialsNeedValidation(test.oldCluster, test.newCluster); result != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
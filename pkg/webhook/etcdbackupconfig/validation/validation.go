@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/validation"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// validator for validating Kubermatic EtcdBackupConfig CRD.
+type validator struct {
+	seedGetter provider.SeedGetter
+}
+
+// NewValidator returns a new EtcdBackupConfig validator.
+func NewValidator(seedGetter provider.SeedGetter) *validator {
+	return &validator{
+		seedGetter: seedGetter,
+	}
+}
+
+var _ admission.CustomValidator = &validator{}
+
+func (v *validator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	config, ok := obj.(*kubermaticv1.EtcdBackupConfig)
+	if !ok {
+		return errors.New("object is not an EtcdBackupConfig")
+	}
+
+	return v.validateSpec(&config.Spec, true)
+}
+
+func (v *validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	oldConfig, ok := oldObj.(*kubermaticv1.EtcdBackupConfig)
+	if !ok {
+		return errors.New("old object is not an EtcdBackupConfig")
+	}
+
+	newConfig, ok := newObj.(*kubermaticv1.EtcdBackupConfig)
+	if !ok {
+		return errors.New("new object is not an EtcdBackupConfig")
+	}
+
+	// Only validate spec changes, so that existing configs can still be cleaned
+	// up (i.e. have their finalizers removed) after their destination has been
+	// removed from the Seed.
+	if equality.Semantic.DeepEqual(oldConfig.Spec, newConfig.Spec) {
+		return nil
+	}
+
+	return v.validateSpec(&newConfig.Spec, oldConfig.Spec.Destination != newConfig.Spec.Destination)
+}
+
+func (v *validator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+func (v *validator) validateSpec(spec *kubermaticv1.EtcdBackupConfigSpec, validateDestination bool) error {
+	specPath := field.NewPath("spec")
+	errs := validation.ValidateEtcdBackupConfigSpec(spec, specPath)
+
+	if validateDestination {
+		seed, err := v.seedGetter()
+		if err != nil {
+			return fmt.Errorf("failed to get current Seed: %w", err)
+		}
+		if seed == nil {
+			return errors.New("webhook not configured for a Seed cluster, cannot validate EtcdBackupConfig resources")
+		}
+
+		errs = append(errs, validation.ValidateEtcdBackupConfigDestination(spec.Destination, seed, specPath.Child("destination"))...)
+	}
+
+	return errs.ToAggregate()
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestHandle(t *testing.T) {
+	seed := &kubermaticv1.Seed{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "europe-west3-c",
+			Namespace: "kubermatic",
+		},
+		Spec: kubermaticv1.SeedSpec{
+			EtcdBackupRestore: &kubermaticv1.EtcdBackupRestore{
+				Destinations: map[string]*kubermaticv1.BackupDestination{
+					"s3": {
+						Endpoint:   "https://s3.example.com",
+						BucketName: "backups",
+					},
+				},
+				DefaultDestination: "s3",
+			},
+		},
+	}
+
+	genConfig := func(schedule string, keep *int, destination string) *kubermaticv1.EtcdBackupConfig {
+		return &kubermaticv1.EtcdBackupConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-backup",
+				Namespace: "cluster-test",
+			},
+			Spec: kubermaticv1.EtcdBackupConfigSpec{
+				Name:        "test-backup",
+				Schedule:    schedule,
+				Keep:        keep,
+				Destination: destination,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		op          admissionv1.Operation
+		config      *kubermaticv1.EtcdBackupConfig
+		oldConfig   *kubermaticv1.EtcdBackupConfig
+		wantAllowed bool
+	}{
+		{
+			name:        "Create valid config",
+			op:          admissionv1.Create,
+			config:      genConfig("@every 20m", pointer.Int(20), "s3"),
+			wantAllowed: true,
+		},
+		{
+			name:        "Create config with invalid schedule",
+			op:          admissionv1.Create,
+			config:      genConfig("every 20 minutes", pointer.Int(20), "s3"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Create config keeping no backups",
+			op:          admissionv1.Create,
+			config:      genConfig("@every 20m", pointer.Int(0), "s3"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Create config with unknown destination",
+			op:          admissionv1.Create,
+			config:      genConfig("@every 20m", pointer.Int(20), "minio"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Update config to an unknown destination",
+			op:          admissionv1.Update,
+			oldConfig:   genConfig("@every 20m", pointer.Int(20), "s3"),
+			config:      genConfig("@every 20m", pointer.Int(20), "minio"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Update config with an invalid keep count",
+			op:          admissionv1.Update,
+			oldConfig:   genConfig("@every 20m", pointer.Int(20), "s3"),
+			config:      genConfig("@every 20m", pointer.Int(-1), "s3"),
+			wantAllowed: false,
+		},
+		{
+			name:        "Update config with a removed destination but unchanged spec",
+			op:          admissionv1.Update,
+			oldConfig:   genConfig("@every 20m", pointer.Int(20), "removed"),
+			config:      genConfig("@every 20m", pointer.Int(20), "removed"),
+			wantAllowed: true,
+		},
+		{
+			name:        "Update schedule of config with a removed destination",
+			op:          admissionv1.Update,
+			oldConfig:   genConfig("@every 20m", pointer.Int(20), "removed"),
+			config:      genConfig("@every 30m", pointer.Int(20), "removed"),
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewValidator(test.NewSeedGetter(seed))
+
+			ctx := context.Background()
+			var err error
+
+			switch tt.op {
+			case admissionv1.Create:
+				err = v.ValidateCreate(ctx, tt.config)
+			case admissionv1.Update:
+				err = v.ValidateUpdate(ctx, tt.oldConfig, tt.config)
+			case admissionv1.Delete:
+				err = v.ValidateDelete(ctx, tt.config)
+			}
+
+			allowed := err == nil
+
+			if allowed != tt.wantAllowed {
+				t.Errorf("Allowed %t, but wanted %t: %v", allowed, tt.wantAllowed, err)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/apis/kubermatic/v1"
@@ -29,9 +30,12 @@ import (
 	"k8c.io/kubermatic/v2/pkg/validation"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -132,12 +136,14 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object, isDelete b
 		subjectDatacenters = sets.StringKeySet(subject.Spec.Datacenters)
 	}
 
-	// check if the subject introduces a datacenter that already exists
+	// check if the subject introduces a datacenter that already exists; names are
+	// compared case-insensitively, as they are used in DNS-like contexts downstream
+	lowercaseSubjectDatacenters := lowercaseSet(subjectDatacenters)
 	for _, existing := range existingSeeds {
 		datacenters := sets.StringKeySet(existing.Spec.Datacenters)
 
-		if duplicates := subjectDatacenters.Intersection(datacenters); duplicates.Len() > 0 {
-			return fmt.Errorf("Seed redefines existing datacenters %v from Seed %q; datacenter names must be globally unique", duplicates.List(), existing.Name)
+		if duplicates := lowercaseSubjectDatacenters.Intersection(lowercaseSet(datacenters)); duplicates.Len() > 0 {
+			return fmt.Errorf("Seed redefines existing datacenters %v from Seed %q; datacenter names must be globally unique (case-insensitive)", duplicates.List(), existing.Name)
 		}
 
 		existingDatacenters = existingDatacenters.Union(datacenters)
@@ -146,6 +152,14 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object, isDelete b
 	// check if all DCs have exactly one provider and that the provider
 	// is never changed after it has been set once
 	for dcName, dc := range subject.Spec.Datacenters {
+		// datacenter names end up in generated resource names, so new datacenters
+		// must use valid DNS labels; existing ones are left alone to not block updates
+		if !isDelete && !datacenterExists(existingSeed, dcName) {
+			if errs := utilvalidation.IsDNS1123Label(dcName); len(errs) > 0 {
+				return fmt.Errorf("datacenter name %q is invalid: %s", dcName, strings.Join(errs, ", "))
+			}
+		}
+
 		providerName, err := provider.DatacenterCloudProviderName(&dc.Spec)
 		if err != nil {
 			return fmt.Errorf("datacenter %q is invalid: %w", dcName, err)
@@ -154,6 +168,14 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object, isDelete b
 			return fmt.Errorf("datacenter %q has no provider defined", dcName)
 		}
 
+		// only new or changed datacenters are validated, so that Seeds with
+		// datacenters created before this validation existed can still be updated
+		if !isDelete && !datacenterUnchanged(existingSeed, dcName, dc) {
+			if errs := validation.ValidateDatacenter(&dc.Spec, field.NewPath("spec", "datacenters").Key(dcName).Child("spec")); len(errs) > 0 {
+				return fmt.Errorf("datacenter %q is invalid: %w", dcName, errs.ToAggregate())
+			}
+		}
+
 		if existingSeed == nil {
 			continue
 		}
@@ -212,9 +234,41 @@ func (v *validator) validate(ctx context.Context, obj runtime.Object, isDelete b
 		}
 	}
 
-	if err := validation.ValidateMeteringConfiguration(subject.Spec.Metering); err != nil {
+	var existingMetering *kubermaticv1.MeteringConfiguration
+	if existingSeed != nil {
+		existingMetering = existingSeed.Spec.Metering
+	}
+
+	if err := validation.ValidateMeteringConfiguration(subject.Spec.Metering, existingMetering); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func lowercaseSet(s sets.String) sets.String {
+	result := sets.NewString()
+	for _, item := range s.UnsortedList() {
+		result.Insert(strings.ToLower(item))
+	}
+
+	return result
+}
+
+func datacenterExists(seed *kubermaticv1.Seed, dcName string) bool {
+	if seed == nil {
+		return false
+	}
+
+	_, exists := seed.Spec.Datacenters[dcName]
+	return exists
+}
+
+func datacenterUnchanged(seed *kubermaticv1.Seed, dcName string, dc kubermaticv1.Datacenter) bool {
+	if seed == nil {
+		return false
+	}
+
+	existing, exists := seed.Spec.Datacenters[dcName]
+	return exists && equality.Semantic.DeepEqual(existing.Spec, dc.Spec)
+}
@@ -36,6 +36,9 @@ func TestValidate(t *testing.T) {
 		Fake: &kubermaticv1.DatacenterSpecFake{},
 	}
 
+	retention := uint32(30)
+	noRetention := uint32(0)
+
 	testCases := []struct {
 		name             string
 		seedToValidate   *kubermaticv1.Seed
@@ -292,7 +295,9 @@ func TestValidate(t *testing.T) {
 					Datacenters: map[string]kubermaticv1.Datacenter{
 						"dc1": {
 							Spec: kubermaticv1.DatacenterSpec{
-								AWS: &kubermaticv1.DatacenterSpecAWS{},
+								AWS: &kubermaticv1.DatacenterSpecAWS{
+									Region: "eu-central-1",
+								},
 							},
 						},
 					},
@@ -342,6 +347,95 @@ func TestValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Datacenter names are unique across all seeds regardless of case",
+			existingSeeds: []*kubermaticv1.Seed{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "existing-seed",
+					},
+					Spec: kubermaticv1.SeedSpec{
+						Datacenters: map[string]kubermaticv1.Datacenter{
+							"dc1": {
+								Spec: fakeProviderSpec,
+							},
+						},
+					},
+				},
+			},
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"DC1": {
+							Spec: fakeProviderSpec,
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Datacenter names are unique across all seeds regardless of case for existing uppercase names",
+			existingSeeds: []*kubermaticv1.Seed{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "existing-seed",
+					},
+					Spec: kubermaticv1.SeedSpec{
+						Datacenters: map[string]kubermaticv1.Datacenter{
+							"DC1": {
+								Spec: fakeProviderSpec,
+							},
+						},
+					},
+				},
+			},
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: fakeProviderSpec,
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Revalidating a seed with an uppercase datacenter name against itself should succeed",
+			existingSeeds: []*kubermaticv1.Seed{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "existing-seed",
+					},
+					Spec: kubermaticv1.SeedSpec{
+						Datacenters: map[string]kubermaticv1.Datacenter{
+							"DC1": {
+								Spec: fakeProviderSpec,
+							},
+						},
+					},
+				},
+			},
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "existing-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"DC1": {
+							Spec: fakeProviderSpec,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Cannot remove datacenters that are used by clusters",
 			existingSeeds: []*kubermaticv1.Seed{
@@ -454,6 +548,293 @@ func TestValidate(t *testing.T) {
 			features:    features.FeatureGate{},
 			errExpected: true,
 		},
+		{
+			name: "Adding a datacenter with an uppercase name should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"Europe-West": {
+							Spec: fakeProviderSpec,
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Adding a datacenter with an underscore in its name should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"europe_west": {
+							Spec: fakeProviderSpec,
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Adding a datacenter with a valid DNS label name should succeed",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"europe-west3-c": {
+							Spec: fakeProviderSpec,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Keeping an existing datacenter with an invalid name should succeed",
+			existingSeeds: []*kubermaticv1.Seed{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "existing-seed",
+					},
+					Spec: kubermaticv1.SeedSpec{
+						Datacenters: map[string]kubermaticv1.Datacenter{
+							"legacy_dc": {
+								Spec: fakeProviderSpec,
+							},
+						},
+					},
+				},
+			},
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "existing-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"legacy_dc": {
+							Spec: fakeProviderSpec,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Adding an OpenStack datacenter with auth URL and region should succeed",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								Openstack: &kubermaticv1.DatacenterSpecOpenstack{
+									AuthURL: "https://keystone.example.com:5000/v3",
+									Region:  "RegionOne",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Adding an OpenStack datacenter without auth URL should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								Openstack: &kubermaticv1.DatacenterSpecOpenstack{
+									Region: "RegionOne",
+								},
+							},
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Adding an OpenStack datacenter with an invalid auth URL should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								Openstack: &kubermaticv1.DatacenterSpecOpenstack{
+									AuthURL: "keystone.example.com",
+									Region:  "RegionOne",
+								},
+							},
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Adding an OpenStack datacenter without region should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								Openstack: &kubermaticv1.DatacenterSpecOpenstack{
+									AuthURL: "https://keystone.example.com:5000/v3",
+								},
+							},
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Adding an AWS datacenter without region should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								AWS: &kubermaticv1.DatacenterSpecAWS{},
+							},
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Adding an Azure datacenter with a location should succeed",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								Azure: &kubermaticv1.DatacenterSpecAzure{
+									Location: "westeurope",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Adding an Azure datacenter without location should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								Azure: &kubermaticv1.DatacenterSpecAzure{},
+							},
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Adding a regional GCP datacenter with zone suffixes should succeed",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								GCP: &kubermaticv1.DatacenterSpecGCP{
+									Region:       "europe-west3",
+									ZoneSuffixes: []string{"a", "b", "c"},
+									Regional:     true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Adding a regional GCP datacenter without zone suffixes should fail",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								GCP: &kubermaticv1.DatacenterSpecGCP{
+									Region:   "europe-west3",
+									Regional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Keeping an existing incomplete datacenter should succeed",
+			existingSeeds: []*kubermaticv1.Seed{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "existing-seed",
+					},
+					Spec: kubermaticv1.SeedSpec{
+						Datacenters: map[string]kubermaticv1.Datacenter{
+							"dc1": {
+								Spec: kubermaticv1.DatacenterSpec{
+									AWS: &kubermaticv1.DatacenterSpecAWS{},
+								},
+							},
+						},
+					},
+				},
+			},
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "existing-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Datacenters: map[string]kubermaticv1.Datacenter{
+						"dc1": {
+							Spec: kubermaticv1.DatacenterSpec{
+								AWS: &kubermaticv1.DatacenterSpecAWS{},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Adding a seed with invalid cron expression",
 			seedToValidate: &kubermaticv1.Seed{
@@ -473,6 +854,197 @@ func TestValidate(t *testing.T) {
 			features:    features.FeatureGate{},
 			errExpected: true,
 		},
+		{
+			name: "Adding a seed with a valid metering report configuration",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"weekly": {
+								Schedule:  "0 1 * * 6",
+								Interval:  7,
+								Retention: &retention,
+							},
+						},
+					},
+				},
+			},
+			features: features.FeatureGate{},
+		},
+		{
+			name: "Adding a seed with a metering report without interval",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"weekly": {
+								Schedule: "0 1 * * 6",
+							},
+						},
+					},
+				},
+			},
+			features:    features.FeatureGate{},
+			errExpected: true,
+		},
+		{
+			name: "Adding a seed with a metering report with zero retention",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"weekly": {
+								Schedule:  "0 1 * * 6",
+								Interval:  7,
+								Retention: &noRetention,
+							},
+						},
+					},
+				},
+			},
+			features: features.FeatureGate{},
+		},
+		{
+			name: "Adding a seed with a monthly metering report",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"monthly": {
+								Schedule: "1 1 1 * *",
+								Interval: 30,
+							},
+						},
+					},
+				},
+			},
+			features: features.FeatureGate{},
+		},
+		{
+			name: "Updating a seed with an existing metering report whose schedule runs less often than its interval",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "existing-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Country: "DE",
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"weekly": {
+								Schedule: "0 1 1 * *",
+								Interval: 7,
+							},
+						},
+					},
+				},
+			},
+			existingSeeds: []*kubermaticv1.Seed{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "existing-seed",
+					},
+					Spec: kubermaticv1.SeedSpec{
+						Metering: &kubermaticv1.MeteringConfiguration{
+							ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+								"weekly": {
+									Schedule: "0 1 1 * *",
+									Interval: 7,
+								},
+							},
+						},
+					},
+				},
+			},
+			features: features.FeatureGate{},
+		},
+		{
+			name: "Updating a seed with a changed metering report whose schedule runs less often than its interval",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "existing-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"weekly": {
+								Schedule: "0 1 1 * *",
+								Interval: 7,
+							},
+						},
+					},
+				},
+			},
+			existingSeeds: []*kubermaticv1.Seed{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "existing-seed",
+					},
+					Spec: kubermaticv1.SeedSpec{
+						Metering: &kubermaticv1.MeteringConfiguration{
+							ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+								"weekly": {
+									Schedule: "0 1 * * 6",
+									Interval: 7,
+								},
+							},
+						},
+					},
+				},
+			},
+			features:    features.FeatureGate{},
+			errExpected: true,
+		},
+		{
+			name: "Adding a seed with a metering report whose schedule runs less often than its interval",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"weekly": {
+								Schedule: "0 1 1 * *",
+								Interval: 7,
+							},
+						},
+					},
+				},
+			},
+			features:    features.FeatureGate{},
+			errExpected: true,
+		},
+		{
+			name: "Adding a seed with a metering report whose interval overlaps its schedule",
+			seedToValidate: &kubermaticv1.Seed{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "new-seed",
+				},
+				Spec: kubermaticv1.SeedSpec{
+					Metering: &kubermaticv1.MeteringConfiguration{
+						ReportConfigurations: map[string]*kubermaticv1.MeteringReportConfiguration{
+							"weekly": {
+								Schedule: "0 1 * * *",
+								Interval: 7,
+							},
+						},
+					},
+				},
+			},
+			features: features.FeatureGate{},
+		},
 	}
 
 	for _, tc := range testCases {